@@ -1,16 +1,27 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
 	"github.com/ilyakaznacheev/cleanenv"
 	_ "locpack-backend/docs/swagger"
 	"locpack-backend/internal/cfg"
 	"locpack-backend/internal/server/controller"
+	"locpack-backend/internal/server/middleware"
 	"locpack-backend/internal/server/router"
 	"locpack-backend/internal/service/domain"
+	"locpack-backend/internal/storage/migration"
 	"locpack-backend/internal/storage/repository"
 	"locpack-backend/pkg/adapter/api"
 	"locpack-backend/pkg/adapter/auth"
+	"locpack-backend/pkg/adapter/blob"
 	"locpack-backend/pkg/adapter/database"
+	"locpack-backend/pkg/utils/signature"
 )
 
 // @title Locpack API
@@ -35,28 +46,157 @@ func main() {
 		panic(err)
 	}
 
-	authAdapter := auth.New(&config.Auth)
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err = runMigrate(db, os.Args[2:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	migrator, err := migration.New(db)
+	if err != nil {
+		panic(err)
+	}
+	if config.Database.AutoMigrate {
+		_, err = migrator.Up()
+		if err != nil {
+			panic(err)
+		}
+	} else {
+		// The server does not work against an outdated schema, so it refuses
+		// to start rather than fail on the first request that needs it.
+		pending, err := migrator.Pending()
+		if err != nil {
+			panic(err)
+		}
+		if len(pending) != 0 {
+			panic(fmt.Sprintf("%d pending migrations, starting with %s: run `migrate up` or set LP_DATABASE_AUTO_MIGRATE=true", len(pending), pending[0]))
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	authAdapter, err := auth.New(ctx, &config.Auth, db)
+	if err != nil {
+		panic(err)
+	}
+
+	blobStorage, err := blob.New(&config.Blob)
+	if err != nil {
+		panic(err)
+	}
 
 	placeRepository := repository.NewPlaceRepository(db)
 	packRepository := repository.NewPackRepository(db)
 	userRepository := repository.NewUserRepository(db)
+	collaboratorRepository := repository.NewPackCollaboratorRepository(db)
+	shareLinkRepository := repository.NewShareLinkRepository(db)
+	userFollowRepository := repository.NewUserFollowRepository(db)
+	activityRepository := repository.NewActivityRepository(db)
+	mediaRepository := repository.NewMediaRepository(db)
+	reviewRepository := repository.NewReviewRepository(db)
+	visitRepository := repository.NewVisitRepository(db)
+	moderationRepository := repository.NewModerationRepository(db)
+	healthRepository := repository.NewHealthRepository(db)
+	unitOfWork := repository.NewUnitOfWork(db)
+
+	go repository.RunPurger(
+		ctx,
+		config.Database.PurgeInterval,
+		config.Database.DeletedRetention,
+		blobStorage,
+		packRepository,
+		placeRepository,
+	)
+
+	shareLinkSecret := []byte(config.API.ShareLinkSecret)
+	if len(shareLinkSecret) == 0 {
+		log.Println("LP_API_SHARE_LINK_SECRET is not set, share links will stop working after restart")
+		shareLinkSecret = signature.RandomKey()
+	}
+	shareLinkSigner, err := signature.New(shareLinkSecret)
+	if err != nil {
+		panic(err)
+	}
 
-	placeService := domain.NewPlaceService(placeRepository, userRepository)
-	packService := domain.NewPackService(packRepository, placeRepository, userRepository)
-	userService := domain.NewUserService(userRepository)
+	placeService := domain.NewPlaceService(placeRepository, userRepository, activityRepository, visitRepository, unitOfWork)
+	packService := domain.NewPackService(
+		packRepository,
+		placeRepository,
+		userRepository,
+		collaboratorRepository,
+		shareLinkRepository,
+		activityRepository,
+		shareLinkSigner,
+		unitOfWork,
+	)
+	userService := domain.NewUserService(userRepository, userFollowRepository, activityRepository)
+	mediaService := domain.NewMediaService(
+		mediaRepository,
+		placeRepository,
+		packRepository,
+		userRepository,
+		blobStorage,
+		unitOfWork,
+	)
+	reviewService := domain.NewReviewService(reviewRepository, placeRepository, userRepository)
+	visitService := domain.NewVisitService(
+		visitRepository,
+		placeRepository,
+		packRepository,
+		userRepository,
+		activityRepository,
+		unitOfWork,
+	)
 	authService := domain.NewAuthService(authAdapter, userRepository)
+	moderationService := domain.NewModerationService(
+		moderationRepository,
+		placeRepository,
+		packRepository,
+		userRepository,
+		reviewRepository,
+		unitOfWork,
+	)
+	healthService := domain.NewHealthService(ctx, healthRepository, authAdapter)
 
 	placeController := controller.NewPlaceController(placeService)
 	packController := controller.NewPackController(packService)
 	userController := controller.NewUserController(userService)
+	mediaController := controller.NewMediaController(mediaService)
+	reviewController := controller.NewReviewController(reviewService)
+	visitController := controller.NewVisitController(visitService)
 	authController := controller.NewAuthController(authService)
+	moderationController := controller.NewModerationController(moderationService)
+	healthController := controller.NewHealthController(healthService)
 
 	server := api.New(&config.API)
+	server.Use(middleware.TimeoutMiddleware(config.API.RequestTimeout))
+	if config.Blob.Provider == blob.ProviderLocal {
+		server.Static(config.Blob.ServePath, config.Blob.Dir)
+	}
 
-	router.New(server, authAdapter, packController, placeController, userController, authController)
+	router.New(
+		server,
+		authAdapter,
+		userService,
+		packController,
+		placeController,
+		userController,
+		mediaController,
+		reviewController,
+		visitController,
+		authController,
+		moderationController,
+		healthController,
+	)
 
-	err = server.Run(config.API.Address)
+	err = api.Serve(ctx, &config.API, server)
 	if err != nil {
 		panic(err)
 	}
+
+	log.Println("server stopped")
 }
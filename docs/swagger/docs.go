@@ -18,21 +18,3109 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/api/v1/admin/log": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get moderation actions, newest first",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get moderation log",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.ModerationAction"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/packs/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete any pack. Restoring it by the author keeps it hidden",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete pack by ID as moderator",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/packs/{id}/hide": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hide any pack from search and reads",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Hide pack by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/packs/{id}/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Make another user the author of a pack, e.g. when it is abandoned",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Transfer pack ownership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New author",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PackTransfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/packs/{id}/unhide": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Make a hidden pack visible again",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Unhide pack by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/places/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete any place. Restoring it by the author keeps it hidden",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete place by ID as moderator",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/places/{id}/hide": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hide any place from search and reads",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Hide place by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/places/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Merge a duplicate place into another place. Packs and visitors of the place are moved to the other place and the place is deleted",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Merge place into another place",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Surviving place",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PlaceMerge"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/places/{id}/unhide": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Make a hidden place visible again",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Unhide place by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/reviews/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a review of any user",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete review by ID as moderator",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/{id}/ban": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Prevent a user from logging in and using authenticated endpoints",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Ban user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/{id}/unban": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lift the ban of a user",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Unban user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation reason",
+                        "name": "moderation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Moderation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/auth/login": {
             "post": {
-                "description": "Authenticate user and return token",
+                "description": "Authenticate user and return token",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "Login details",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Login"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/refresh": {
+            "post": {
+                "description": "Refresh user token and return it",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "User refresh token",
+                "parameters": [
+                    {
+                        "description": "Refresh details",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Refresh"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/register": {
+            "post": {
+                "description": "Register new user account",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "User registration",
+                "parameters": [
+                    {
+                        "description": "Registration details",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Register"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/feed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get packs created, places added to packs and places visited by the users followed by the current user, newest first",
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get activity feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Activity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs": {
+            "get": {
+                "description": "Get public packs matching name or author, along with matching packs the current user created or collaborates on. Results are ranked by relevance and matched words are highlighted in name_highlight",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Search packs by query",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a new pack to the database. Visibility is PRIVATE, UNLISTED or PUBLIC and defaults to PUBLIC",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Create a new pack",
+                "parameters": [
+                    {
+                        "description": "Pack data",
+                        "name": "pack",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PackCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the pack"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/created": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get packs created by the current user",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Get created packs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/followed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get packs followed by the current user with the progress of the user through their places",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Get followed packs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "in_progress or completed",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}": {
+            "get": {
+                "description": "Get a specific pack by its ID. Private packs are found only by their author and collaborators",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Get pack by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the pack"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a specific pack by its ID. Only the author can change its visibility",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Update pack by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pack data",
+                        "name": "pack",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PackUpdate"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETags of the versions to update",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the pack"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a specific pack created by the current user. It can be restored during the retention period",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Delete pack by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}/collaborators": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invite a user to a pack created by the current user as EDITOR or VIEWER. Inviting an existing collaborator changes their role",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Invite collaborator to pack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Collaborator data",
+                        "name": "collaborator",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.CollaboratorInvite"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Collaborator"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}/collaborators/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a collaborator from a pack created by the current user, or leave a pack the current user collaborates on",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Remove collaborator from pack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Collaborator user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}/cover": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a JPEG or PNG image of at most 10 MB as the cover of a pack, replacing the previous one. Allowed to the author and editors. Location and other metadata are removed and a thumbnail is generated",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Set cover of pack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Cover image",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Media"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the cover of a pack. Allowed to the author and editors",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Delete cover of pack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}/invitation/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accept an invitation of the current user to collaborate on a pack",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Accept pack invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the pack"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}/places/{placeId}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a place to a new position inside a pack the current user can edit or change its note and planned duration (in minutes)",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Update place inside pack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "placeId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pack place data",
+                        "name": "place",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PackPlaceUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the pack"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore a pack deleted by the current user during the retention period",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Restore deleted pack by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the pack"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}/share-links": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get active share links of a pack created by the current user",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Get share links of pack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.ShareLink"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a revocable link that grants access to a pack created by the current user, even if it is private. The link never expires unless expires_at is set",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Create share link for pack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Share link data",
+                        "name": "link",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ShareLinkCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ShareLink"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/packs/{id}/share-links/{linkId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke a share link of a pack created by the current user",
+                "tags": [
+                    "Packs"
+                ],
+                "summary": "Revoke share link of pack",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pack ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share link ID",
+                        "name": "linkId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/places": {
+            "get": {
+                "description": "Get places matching name or address, ranked by relevance or, with sort=RATING, by average rating. Matched words are highlighted in name_highlight and address_highlight",
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Search places by query",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RELEVANCE (default) or RATING",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a new place to the database. Fails with possible_duplicate when similar places exist, see GET /api/v1/places/duplicates, unless ignore_duplicates is set",
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Register a new place",
+                "parameters": [
+                    {
+                        "description": "Place data",
+                        "name": "place",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PlaceCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the place"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/places/duplicates": {
+            "get": {
+                "description": "Get existing places that are likely the same as a place to register, based on name and address similarity and, when coordinates are given, distance",
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Find duplicates of a place",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place name",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Place address",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude",
+                        "name": "lng",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/places/nearby": {
+            "get": {
+                "description": "Get places within a radius around a point, sorted by distance",
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Search places nearby",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Radius in meters",
+                        "name": "radius",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/places/{id}": {
+            "get": {
+                "description": "Get a specific place by its ID",
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Get place by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the place"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a specific place by its ID. Allowed only to its author, visits are tracked with POST /api/v1/places/{id}/visit and DELETE /api/v1/visits/{id}",
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Update place by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Place data",
+                        "name": "place",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PlaceUpdate"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETags of the versions to update",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the place"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a specific place created by the current user. It can be restored during the retention period",
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Delete place by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/places/{id}/media": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a JPEG or PNG photo of at most 10 MB to the gallery of a place. Location and other metadata are removed and a thumbnail is generated",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Add photo to place",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Photo",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Media"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/places/{id}/media/{mediaId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a photo from the gallery of a place. Allowed to the user who added the photo and to the author of the place",
+                "tags": [
+                    "Places"
+                ],
+                "summary": "Delete photo of place",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Media ID",
+                        "name": "mediaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/places/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Merge a duplicate place created by the current user into another place. Packs and visitors of the place are moved to the other place and the place is deleted",
                 "tags": [
-                    "Auth"
+                    "Places"
                 ],
-                "summary": "User login",
+                "summary": "Merge place into another place",
                 "parameters": [
                     {
-                        "description": "Login details",
-                        "name": "login",
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Surviving place",
+                        "name": "merge",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Login"
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PlaceMerge"
                         }
                     }
                 ],
@@ -48,11 +3136,17 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
                                         }
                                     }
                                 }
                             ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the place"
+                            }
                         }
                     },
                     "400": {
@@ -66,32 +3160,59 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/auth/register": {
+        "/api/v1/places/{id}/restore": {
             "post": {
-                "description": "Register new user account",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore a place deleted by the current user during the retention period",
                 "tags": [
-                    "Auth"
+                    "Places"
                 ],
-                "summary": "User registration",
+                "summary": "Restore deleted place by ID",
                 "parameters": [
                     {
-                        "description": "Registration details",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Register"
-                        }
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -106,11 +3227,17 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
                                         }
                                     }
                                 }
                             ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the place"
+                            }
                         }
                     },
                     "400": {
@@ -124,30 +3251,60 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.AccessToken"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/packs": {
+        "/api/v1/places/{id}/reviews": {
             "get": {
-                "description": "Get packs matching name or author",
+                "description": "Get the reviews of a place, newest first",
                 "tags": [
-                    "Packs"
+                    "Reviews"
                 ],
-                "summary": "Search packs by query",
+                "summary": "Get reviews of place",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -164,7 +3321,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Review"
                                             }
                                         }
                                     }
@@ -174,6 +3331,145 @@ const docTemplate = `{
                     },
                     "400": {
                         "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rate a place from 1 to 5 with an optional text of at most 2000 characters. Users can review a place once",
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Review place",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review data",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ReviewCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Review"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/places/{id}/reviews/{reviewId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the rating and the text of a review. Allowed only to its author",
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Update review of place",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review ID",
+                        "name": "reviewId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review data",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ReviewUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -183,37 +3479,132 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
-                                            }
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Review"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a review. Allowed only to its author, moderators use DELETE /api/v1/admin/reviews/{id}",
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Delete review of place",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review ID",
+                        "name": "reviewId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/places/{id}/visit": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add a new pack to the database",
+                "description": "Check the current user in at a place. Any authenticated user can visit a place, and visiting it again records another check-in. The body is optional: visited_at defaults to now and must not be in the future, the note is at most 2000 characters and the rating is from 1 to 5",
                 "tags": [
-                    "Packs"
+                    "Visits"
                 ],
-                "summary": "Create a new pack",
+                "summary": "Visit place",
                 "parameters": [
                     {
-                        "description": "Pack data",
-                        "name": "pack",
+                        "type": "string",
+                        "description": "Place ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Visit data",
+                        "name": "visit",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PackCreate"
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.VisitCreate"
                         }
                     }
                 ],
@@ -229,7 +3620,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Visit"
                                         }
                                     }
                                 }
@@ -239,36 +3630,46 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     }
                 }
             }
         },
-        "/api/v1/packs/created": {
+        "/api/v1/shared/{token}": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get packs created by the current user",
+                "description": "Get the pack of an active share link, even if it is private",
                 "tags": [
                     "Packs"
                 ],
-                "summary": "Get created packs",
+                "summary": "Get pack by share link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -281,52 +3682,55 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
-                                            }
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
                                         }
                                     }
                                 }
                             ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the pack"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     }
                 }
             }
         },
-        "/api/v1/packs/followed": {
-            "get": {
+        "/api/v1/shared/{token}/follow": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get packs followed by the current user",
+                "description": "Follow the pack of an active share link. Private packs are joined as VIEWER instead, because followers cannot see them",
                 "tags": [
                     "Packs"
                 ],
-                "summary": "Get followed packs",
+                "summary": "Follow pack by share link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -339,56 +3743,52 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
-                                            }
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
                                         }
                                     }
                                 }
                             ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the pack"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     }
                 }
             }
         },
-        "/api/v1/packs/{id}": {
+        "/api/v1/users/my": {
             "get": {
-                "description": "Get a specific pack by its ID",
-                "tags": [
-                    "Packs"
-                ],
-                "summary": "Get pack by ID",
-                "parameters": [
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Pack ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "BearerAuth": []
                     }
                 ],
+                "description": "Get information about the currently authenticated user, including profile and stats",
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get current user info",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -401,7 +3801,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
                                         }
                                     }
                                 }
@@ -419,12 +3819,24 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
                     }
                 }
             },
@@ -434,26 +3846,19 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a specific pack by its ID",
+                "description": "Replace the display name, bio, home city and avatar URL of the currently authenticated user. Empty fields are cleared",
                 "tags": [
-                    "Packs"
+                    "Users"
                 ],
-                "summary": "Update pack by ID",
+                "summary": "Update current user profile",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Pack ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Pack data",
-                        "name": "pack",
+                        "description": "Profile data",
+                        "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PackUpdate"
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.UserUpdate"
                         }
                     }
                 ],
@@ -469,7 +3874,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
                                         }
                                     }
                                 }
@@ -479,105 +3884,66 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
-                    }
-                }
-            }
-        },
-        "/api/v1/places": {
-            "get": {
-                "description": "Get places matching name or address",
-                "tags": [
-                    "Places"
-                ],
-                "summary": "Search places by query",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/users/my/visits": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add a new place to the database",
+                "description": "Get the check-ins of the currently authenticated user, latest first. From and to are dates (UTC) or RFC 3339 times; a date given as to includes that whole day",
                 "tags": [
-                    "Places"
+                    "Visits"
                 ],
-                "summary": "Register a new place",
+                "summary": "Get visits of current user",
                 "parameters": [
                     {
-                        "description": "Place data",
-                        "name": "place",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PlaceCreate"
-                        }
+                        "type": "string",
+                        "description": "Earliest visit date",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest visit date",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -592,7 +3958,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/locpack-backend_internal_server_dto.Visit"
+                                            }
                                         }
                                     }
                                 }
@@ -602,35 +3971,41 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     }
                 }
             }
         },
-        "/api/v1/places/{id}": {
+        "/api/v1/users/{id}": {
             "get": {
-                "description": "Get a specific place by its ID",
+                "description": "Get information about any user by their ID, including profile and stats",
                 "tags": [
-                    "Places"
+                    "Users"
                 ],
-                "summary": "Get place by ID",
+                "summary": "Get user by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Place ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -648,7 +4023,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
                                         }
                                     }
                                 }
@@ -666,147 +4041,146 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/v1/users/{id}/follow": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a specific place by its ID",
+                "description": "Follow a user to see their activities in the feed. Following a user twice has no effect",
                 "tags": [
-                    "Places"
+                    "Users"
                 ],
-                "summary": "Update place by ID",
+                "summary": "Follow user by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Place ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Place data",
-                        "name": "place",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/locpack-backend_internal_server_dto.PlaceUpdate"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/users/my": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get information about the currently authenticated user",
+                "description": "Stop following a user",
                 "tags": [
                     "Users"
                 ],
-                "summary": "Get current user info",
+                "summary": "Unfollow user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     }
                 }
             }
         },
-        "/api/v1/users/{id}": {
-            "get": {
-                "description": "Get information about any user by their ID",
+        "/api/v1/visits/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a single check-in of the current user. The place stays visited as long as the user has other visits to it",
                 "tags": [
-                    "Users"
+                    "Visits"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Delete visit",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Visit ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -816,54 +4190,134 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "locpack-backend_internal_server_dto.AccessToken": {
+        },
+        "/healthz": {
+            "get": {
+                "description": "Respond while the process is able to serve requests",
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Check the database and the authentication provider. Fails while the server shuts down",
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/locpack-backend_internal_server_dto.ResponseWrapper"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "locpack-backend_internal_server_dto.AccessToken": {
+            "type": "object",
+            "properties": {
+                "expires_in": {
+                    "type": "number"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.Activity": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pack": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.Pack"
+                },
+                "place": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.Collaborator": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.CollaboratorInvite": {
             "type": "object",
+            "required": [
+                "role",
+                "user_id"
+            ],
             "properties": {
-                "expiresIn": {
-                    "type": "number"
-                },
-                "refreshToken": {
+                "role": {
                     "type": "string"
                 },
-                "value": {
+                "user_id": {
                     "type": "string"
                 }
             }
@@ -890,11 +4344,74 @@ const docTemplate = `{
                 }
             }
         },
+        "locpack-backend_internal_server_dto.Media": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
         "locpack-backend_internal_server_dto.Meta": {
             "type": "object",
             "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
                 "success": {
                     "type": "boolean"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.Moderation": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.ModerationAction": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "moderator": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "type": "string"
                 }
             }
         },
@@ -904,20 +4421,41 @@ const docTemplate = `{
                 "author": {
                     "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
                 },
+                "collaborators": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/locpack-backend_internal_server_dto.Collaborator"
+                    }
+                },
+                "cover": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.Media"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
+                "name_highlight": {
+                    "type": "string"
+                },
                 "places": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
                     }
                 },
+                "progress": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.PackProgress"
+                },
                 "status": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "visibility": {
+                    "type": "string"
                 }
             }
         },
@@ -926,6 +4464,54 @@ const docTemplate = `{
             "properties": {
                 "name": {
                     "type": "string"
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.PackPlaceUpdate": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "planned_duration": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.PackProgress": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "percent": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "visited": {
+                    "type": "integer"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.PackTransfer": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
@@ -943,6 +4529,9 @@ const docTemplate = `{
                 },
                 "status": {
                     "type": "string"
+                },
+                "visibility": {
+                    "type": "string"
                 }
             }
         },
@@ -952,12 +4541,57 @@ const docTemplate = `{
                 "address": {
                     "type": "string"
                 },
+                "address_highlight": {
+                    "type": "string"
+                },
+                "country_code": {
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
                 "id": {
                     "type": "string"
                 },
+                "last_visited_at": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/locpack-backend_internal_server_dto.Media"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
+                "name_highlight": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "planned_duration": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "rating_average": {
+                    "type": "number"
+                },
+                "rating_count": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
+                },
                 "visited": {
                     "type": "boolean"
                 }
@@ -969,25 +4603,68 @@ const docTemplate = `{
                 "address": {
                     "type": "string"
                 },
+                "country_code": {
+                    "type": "string"
+                },
+                "ignore_duplicates": {
+                    "type": "boolean"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
+                "visit": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.VisitCreate"
+                },
                 "visited": {
                     "type": "string"
                 }
             }
         },
+        "locpack-backend_internal_server_dto.PlaceMerge": {
+            "type": "object",
+            "required": [
+                "into_id"
+            ],
+            "properties": {
+                "into_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
         "locpack-backend_internal_server_dto.PlaceUpdate": {
             "type": "object",
             "properties": {
                 "address": {
                     "type": "string"
                 },
-                "name": {
+                "country_code": {
                     "type": "string"
                 },
-                "visited": {
-                    "type": "boolean"
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.Refresh": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "type": "string"
                 }
             }
         },
@@ -1020,16 +4697,175 @@ const docTemplate = `{
                 }
             }
         },
+        "locpack-backend_internal_server_dto.Review": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.User"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.ReviewCreate": {
+            "type": "object",
+            "properties": {
+                "rating": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.ReviewUpdate": {
+            "type": "object",
+            "properties": {
+                "rating": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.ShareLink": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.ShareLinkCreate": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                }
+            }
+        },
         "locpack-backend_internal_server_dto.User": {
             "type": "object",
             "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "home_city": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "stats": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.UserStats"
+                },
                 "username": {
                     "type": "string"
                 }
             }
+        },
+        "locpack-backend_internal_server_dto.UserStats": {
+            "type": "object",
+            "properties": {
+                "countries": {
+                    "type": "integer"
+                },
+                "created_packs": {
+                    "type": "integer"
+                },
+                "followers": {
+                    "type": "integer"
+                },
+                "following": {
+                    "type": "integer"
+                },
+                "unknown_country_places": {
+                    "type": "integer"
+                },
+                "visited_places": {
+                    "type": "integer"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.UserUpdate": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "home_city": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.Visit": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "place": {
+                    "$ref": "#/definitions/locpack-backend_internal_server_dto.Place"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "visited_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "locpack-backend_internal_server_dto.VisitCreate": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "visited_at": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
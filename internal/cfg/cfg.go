@@ -8,4 +8,5 @@ type Cfg struct {
 	Database cfg.Database `env-prefix:"LP_DATABASE_"`
 	API      cfg.API      `env-prefix:"LP_API_"`
 	Auth     cfg.Auth     `env-prefix:"LP_AUTH_"`
+	Blob     cfg.Blob     `env-prefix:"LP_BLOB_"`
 }
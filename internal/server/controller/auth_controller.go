@@ -6,6 +6,7 @@ import (
 	"github.com/jinzhu/copier"
 	"locpack-backend/internal/server"
 	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/pkg/adapter"
@@ -26,48 +27,34 @@ func NewAuthController(authService service.AuthService) server.AuthController {
 // @Param register body dto.Register true "Registration details"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.AccessToken}
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.AccessToken}
+// @Failure 409 {object} dto.ResponseWrapper
+// @Failure 502 {object} dto.ResponseWrapper
 // @Router /api/v1/auth/register [post]
 func (c *authControllerImpl) Register(ctx adapter.APIContext) {
 	var registerDTO dto.Register
 	err := ctx.ShouldBindJSON(&registerDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Request body is invalid")
 		return
 	}
 
 	register := model.Register{}
 	err = copier.Copy(&register, &registerDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
-	accessToken, err := c.service.Register(register)
+	accessToken, err := c.service.Register(ctx.Request.Context(), register)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	accessTokenDTO := dto.AccessToken{}
 	err = copier.Copy(&accessTokenDTO, &accessToken)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
@@ -84,48 +71,34 @@ func (c *authControllerImpl) Register(ctx adapter.APIContext) {
 // @Param login body dto.Login true "Login details"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.AccessToken}
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.AccessToken}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 502 {object} dto.ResponseWrapper
 // @Router /api/v1/auth/login [post]
 func (c *authControllerImpl) Login(ctx adapter.APIContext) {
 	var loginDTO dto.Login
 	err := ctx.ShouldBindJSON(&loginDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Request body is invalid")
 		return
 	}
 
 	login := model.Login{}
 	err = copier.Copy(&login, &loginDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
-	accessToken, err := c.service.Login(login)
+	accessToken, err := c.service.Login(ctx.Request.Context(), login)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	accessTokenDTO := dto.AccessToken{}
 	err = copier.Copy(&accessTokenDTO, &accessToken)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
@@ -142,48 +115,34 @@ func (c *authControllerImpl) Login(ctx adapter.APIContext) {
 // @Param refresh body dto.Refresh true "Refresh details"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.AccessToken}
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.AccessToken}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 502 {object} dto.ResponseWrapper
 // @Router /api/v1/auth/refresh [post]
 func (c *authControllerImpl) Refresh(ctx adapter.APIContext) {
 	var refreshDTO dto.Refresh
 	err := ctx.ShouldBindJSON(&refreshDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Request body is invalid")
 		return
 	}
 
 	refresh := model.Refresh{}
 	err = copier.Copy(&refresh, &refreshDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
-	accessToken, err := c.service.Refresh(refresh)
+	accessToken, err := c.service.Refresh(ctx.Request.Context(), refresh)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	accessTokenDTO := dto.AccessToken{}
 	err = copier.Copy(&accessTokenDTO, &accessToken)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
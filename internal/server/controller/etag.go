@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"locpack-backend/pkg/adapter"
+)
+
+var errInvalidETag = errors.New("invalid entity tag")
+
+// setETag exposes the version of the returned resource, so that clients can
+// send it back in If-Match when they update the resource.
+func setETag(ctx adapter.APIContext, version int64) {
+	ctx.Header("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// parseIfMatch returns the versions listed in the If-Match header, any of
+// which lets the update proceed. They are nil when the header is missing or
+// "*", because then any current version matches. Only strong entity tags are
+// accepted, and tags that are not versions never match.
+func parseIfMatch(ctx adapter.APIContext) ([]int64, error) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if len(header) == 0 || header == "*" {
+		return nil, nil
+	}
+
+	versions := []int64{}
+	tags := 0
+	for {
+		header = strings.TrimLeft(header, " \t,")
+		if len(header) == 0 {
+			break
+		}
+
+		// Entity tags may contain commas, so the list is split on the quotes.
+		if header[0] != '"' {
+			return nil, errInvalidETag
+		}
+		end := strings.IndexByte(header[1:], '"') + 1
+		if end == 0 {
+			return nil, errInvalidETag
+		}
+		tag := header[1:end]
+		tags++
+		header = strings.TrimLeft(header[end+1:], " \t")
+		if len(header) != 0 && header[0] != ',' {
+			return nil, errInvalidETag
+		}
+
+		version, err := strconv.ParseInt(tag, 10, 64)
+		if err == nil && strconv.FormatInt(version, 10) == tag {
+			versions = append(versions, version)
+		}
+	}
+	if tags == 0 {
+		return nil, errInvalidETag
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		expected []int64
+		wantErr  bool
+	}{
+		{name: "missing", header: "", expected: nil},
+		{name: "any version", header: "*", expected: nil},
+		{name: "single tag", header: `"3"`, expected: []int64{3}},
+		{name: "list", header: `"1", "3"`, expected: []int64{1, 3}},
+		{name: "list without spaces", header: `"1","3"`, expected: []int64{1, 3}},
+		{name: "empty list elements", header: `, "1",, "3" ,`, expected: []int64{1, 3}},
+		{name: "tag with comma", header: `"1,3", "4"`, expected: []int64{4}},
+		{name: "no version tag", header: `"abc"`, expected: []int64{}},
+		{name: "non-canonical version", header: `"03"`, expected: []int64{}},
+		{name: "weak tag", header: `W/"3"`, wantErr: true},
+		{name: "any version in list", header: `"3", *`, wantErr: true},
+		{name: "unquoted tag", header: `3`, wantErr: true},
+		{name: "unterminated tag", header: `"3`, wantErr: true},
+		{name: "missing comma", header: `"1" "3"`, wantErr: true},
+		{name: "only commas", header: `,`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _ := setupControllerTest(t, http.MethodPut, "/api/v1/places/place1", nil)
+			ctx.Request.Header.Set("If-Match", tt.header)
+
+			versions, err := parseIfMatch(ctx)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidETag)
+				assert.Nil(t, versions)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, versions)
+			}
+		})
+	}
+}
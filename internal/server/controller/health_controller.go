@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"net/http"
+
+	"locpack-backend/internal/server"
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
+	"locpack-backend/internal/service"
+	"locpack-backend/pkg/adapter"
+)
+
+type healthControllerImpl struct {
+	service service.HealthService
+}
+
+func NewHealthController(service service.HealthService) server.HealthController {
+	return &healthControllerImpl{service}
+}
+
+// GetLiveness
+// @Summary Liveness probe
+// @Description Respond while the process is able to serve requests
+// @Tags Health
+// @Success 200 {object} dto.ResponseWrapper
+// @Router /healthz [get]
+func (c *healthControllerImpl) GetLiveness(ctx adapter.APIContext) {
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// GetReadiness
+// @Summary Readiness probe
+// @Description Check the database and the authentication provider. Fails while the server shuts down
+// @Tags Health
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 503 {object} dto.ResponseWrapper
+// @Router /readyz [get]
+func (c *healthControllerImpl) GetReadiness(ctx adapter.APIContext) {
+	err := c.service.Ready(ctx.Request.Context())
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
@@ -0,0 +1,212 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"locpack-backend/internal/server"
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/adapter"
+
+	"github.com/jinzhu/copier"
+)
+
+// maxUploadBodySize leaves room for the multipart envelope around a file of
+// the largest accepted size.
+const maxUploadBodySize = model.MaxMediaSize + 1<<20
+
+type mediaControllerImpl struct {
+	service service.MediaService
+}
+
+func NewMediaController(service service.MediaService) server.MediaController {
+	return &mediaControllerImpl{service}
+}
+
+// PostPlaceMedia
+// @Summary Add photo to place
+// @Description Upload a JPEG or PNG photo of at most 10 MB to the gallery of a place. Location and other metadata are removed and a thumbnail is generated
+// @Tags Places
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Param id path string true "Place ID"
+// @Param file formData file true "Photo"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Media}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 409 {object} dto.ResponseWrapper
+// @Failure 413 {object} dto.ResponseWrapper
+// @Failure 415 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/media [post]
+func (c *mediaControllerImpl) PostPlaceMedia(ctx adapter.APIContext) {
+	c.upload(ctx, "Place ID is required", c.service.AddToPlace)
+}
+
+// DeletePlaceMedia
+// @Summary Delete photo of place
+// @Description Remove a photo from the gallery of a place. Allowed to the user who added the photo and to the author of the place
+// @Tags Places
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param mediaId path string true "Media ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/media/{mediaId} [delete]
+func (c *mediaControllerImpl) DeletePlaceMedia(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	mediaID := ctx.Param("mediaId")
+	if len(placeID) == 0 || len(mediaID) == 0 {
+		response.BadRequest(ctx, "Place ID and media ID are required")
+		return
+	}
+
+	err := c.service.DeleteFromPlace(ctx.Request.Context(), placeID, mediaID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// PutPackCover
+// @Summary Set cover of pack
+// @Description Upload a JPEG or PNG image of at most 10 MB as the cover of a pack, replacing the previous one. Allowed to the author and editors. Location and other metadata are removed and a thumbnail is generated
+// @Tags Packs
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Param id path string true "Pack ID"
+// @Param file formData file true "Cover image"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Media}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 413 {object} dto.ResponseWrapper
+// @Failure 415 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/cover [put]
+func (c *mediaControllerImpl) PutPackCover(ctx adapter.APIContext) {
+	c.upload(ctx, "Pack ID is required", c.service.SetPackCover)
+}
+
+// DeletePackCover
+// @Summary Delete cover of pack
+// @Description Remove the cover of a pack. Allowed to the author and editors
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/cover [delete]
+func (c *mediaControllerImpl) DeletePackCover(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	if len(packID) == 0 {
+		response.BadRequest(ctx, "Pack ID is required")
+		return
+	}
+
+	err := c.service.DeletePackCover(ctx.Request.Context(), packID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// upload reads the file of a multipart request and attaches it to the
+// resource in the id path parameter with attach.
+func (c *mediaControllerImpl) upload(
+	ctx adapter.APIContext,
+	missingID string,
+	attach func(ctx context.Context, id string, userID string, mu model.MediaUpload) (model.Media, error),
+) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		response.BadRequest(ctx, missingID)
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxUploadBodySize)
+	fileHeader, err := ctx.FormFile("file")
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		response.Error(ctx, service.ErrMediaTooLarge)
+		return
+	}
+	if err != nil {
+		response.BadRequest(ctx, "File is required")
+		return
+	}
+	if fileHeader.Size > model.MaxMediaSize {
+		response.Error(ctx, service.ErrMediaTooLarge)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	media, err := attach(ctx.Request.Context(), id, myUserID, model.MediaUpload{Data: data})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	mediaDTO := dto.Media{}
+	err = copier.Copy(&mediaDTO, &media)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: mediaDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// multipartBody builds a form with the file under field, or an empty form
+// when field is empty.
+func multipartBody(t *testing.T, field string, data []byte) (io.Reader, string) {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	if field != "" {
+		part, err := writer.CreateFormFile(field, "photo.png")
+		assert.NoError(t, err)
+		_, err = part.Write(data)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, writer.Close())
+
+	return &buffer, writer.FormDataContentType()
+}
+
+func TestMediaController_PostPlaceMedia(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		userID       string
+		field        string
+		data         []byte
+		mockSetup    func(s *service.MockMediaService)
+		expectedData any
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing userID",
+			field:        "file",
+			data:         []byte("image"),
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "missing file",
+			userID:       "123",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "File is required", Code: "bad_request"}},
+			},
+		},
+		{
+			name:         "file too large",
+			userID:       "123",
+			field:        "file",
+			data:         make([]byte, model.MaxMediaSize+1),
+			expectedCode: http.StatusRequestEntityTooLarge,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "File must not be larger than 10 MB", Code: "media_too_large"}},
+			},
+		},
+		{
+			name:   "unsupported media",
+			userID: "123",
+			field:  "file",
+			data:   []byte("GIF89a"),
+			mockSetup: func(s *service.MockMediaService) {
+				s.On("AddToPlace", mock.Anything, "place1", "123", model.MediaUpload{Data: []byte("GIF89a")}).
+					Return(model.Media{}, service.ErrUnsupportedMedia)
+			},
+			expectedCode: http.StatusUnsupportedMediaType,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "File must be a JPEG or PNG image", Code: "unsupported_media"}},
+			},
+		},
+		{
+			name:   "success",
+			userID: "123",
+			field:  "file",
+			data:   []byte("image"),
+			mockSetup: func(s *service.MockMediaService) {
+				s.On("AddToPlace", mock.Anything, "place1", "123", model.MediaUpload{Data: []byte("image")}).
+					Return(model.Media{ID: "media1", URL: "https://cdn.example.com/media1.png", ThumbnailURL: "https://cdn.example.com/media1_thumb.png", ContentType: "image/png", Width: 640, Height: 480}, nil)
+			},
+			expectedData: map[string]any{
+				"id":            "media1",
+				"url":           "https://cdn.example.com/media1.png",
+				"thumbnail_url": "https://cdn.example.com/media1_thumb.png",
+				"content_type":  "image/png",
+				"width":         float64(640),
+				"height":        float64(480),
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockMediaService)
+			controller := NewMediaController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/places/place1/media", nil)
+			body, contentType := multipartBody(t, tt.field, tt.data)
+			ctx.Request.Body = io.NopCloser(body)
+			ctx.Request.Header.Set("Content-Type", contentType)
+
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "place1"}}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.PostPlaceMedia(ctx)
+
+			var responseBody dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&responseBody)
+			assert.NoError(t, err)
+
+			if tt.expectedData != nil {
+				assert.Equal(t, tt.expectedData, responseBody.Data)
+			} else {
+				assert.Nil(t, responseBody.Data)
+			}
+			assert.Equal(t, tt.expectedBody.Meta, responseBody.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, responseBody.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMediaController_DeletePackCover(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		mockErr      error
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "not editor",
+			mockErr:      service.ErrNotEditor,
+			expectedCode: http.StatusForbidden,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "User cannot edit this pack", Code: "not_editor"}},
+			},
+		},
+		{
+			name:         "success",
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockMediaService)
+			controller := NewMediaController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodDelete, "/api/v1/packs/pack1/cover", nil)
+			ctx.Set("myUserID", "123")
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "pack1"}}
+			mockService.On("DeletePackCover", mock.Anything, "pack1", "123").Return(tt.mockErr)
+
+			controller.DeletePackCover(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"locpack-backend/internal/server"
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/adapter"
+
+	"github.com/jinzhu/copier"
+)
+
+type moderationControllerImpl struct {
+	service service.ModerationService
+}
+
+func NewModerationController(service service.ModerationService) server.ModerationController {
+	return &moderationControllerImpl{service}
+}
+
+// GetModerationLog
+// @Summary Get moderation log
+// @Description Get moderation actions, newest first
+// @Tags Admin
+// @Security BearerAuth
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Page cursor"
+// @Success 200 {object} dto.ResponseWrapper{data=[]dto.ModerationAction}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/log [get]
+func (c *moderationControllerImpl) GetModerationLog(ctx adapter.APIContext) {
+	page, err := parsePage(ctx)
+	if err != nil {
+		response.BadRequest(ctx, "Page parameters are invalid")
+		return
+	}
+
+	actions, pageInfo, err := c.service.GetLog(ctx.Request.Context(), page)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	actionsDTOs := []dto.ModerationAction{}
+	err = copier.Copy(&actionsDTOs, &actions)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: actionsDTOs,
+		Meta: dto.Meta{
+			Success:    true,
+			NextCursor: pageInfo.NextCursor,
+			Total:      &pageInfo.Total,
+		},
+	})
+}
+
+// HidePlaceByID
+// @Summary Hide place by ID
+// @Description Hide any place from search and reads
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/places/{id}/hide [post]
+func (c *moderationControllerImpl) HidePlaceByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "Place ID is required", c.service.HidePlace)
+}
+
+// UnhidePlaceByID
+// @Summary Unhide place by ID
+// @Description Make a hidden place visible again
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/places/{id}/unhide [post]
+func (c *moderationControllerImpl) UnhidePlaceByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "Place ID is required", c.service.UnhidePlace)
+}
+
+// DeletePlaceByID
+// @Summary Delete place by ID as moderator
+// @Description Delete any place. Restoring it by the author keeps it hidden
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/places/{id} [delete]
+func (c *moderationControllerImpl) DeletePlaceByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "Place ID is required", c.service.DeletePlace)
+}
+
+// HidePackByID
+// @Summary Hide pack by ID
+// @Description Hide any pack from search and reads
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/packs/{id}/hide [post]
+func (c *moderationControllerImpl) HidePackByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "Pack ID is required", c.service.HidePack)
+}
+
+// UnhidePackByID
+// @Summary Unhide pack by ID
+// @Description Make a hidden pack visible again
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/packs/{id}/unhide [post]
+func (c *moderationControllerImpl) UnhidePackByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "Pack ID is required", c.service.UnhidePack)
+}
+
+// DeletePackByID
+// @Summary Delete pack by ID as moderator
+// @Description Delete any pack. Restoring it by the author keeps it hidden
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/packs/{id} [delete]
+func (c *moderationControllerImpl) DeletePackByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "Pack ID is required", c.service.DeletePack)
+}
+
+// TransferPackByID
+// @Summary Transfer pack ownership
+// @Description Make another user the author of a pack, e.g. when it is abandoned
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param transfer body dto.PackTransfer true "New author"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/packs/{id}/transfer [post]
+func (c *moderationControllerImpl) TransferPackByID(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	if len(packID) == 0 {
+		response.BadRequest(ctx, "Pack ID is required")
+		return
+	}
+
+	var packTransferDTO dto.PackTransfer
+	err := ctx.ShouldBindJSON(&packTransferDTO)
+	if err != nil {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	packTransfer := model.PackTransfer{}
+	err = copier.Copy(&packTransfer, &packTransferDTO)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	err = c.service.TransferPack(ctx.Request.Context(), packID, myUserID, packTransfer)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// MergePlaceByID
+// @Summary Merge place into another place
+// @Description Merge a duplicate place into another place. Packs and visitors of the place are moved to the other place and the place is deleted
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param merge body dto.PlaceMerge true "Surviving place"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/places/{id}/merge [post]
+func (c *moderationControllerImpl) MergePlaceByID(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	if len(placeID) == 0 {
+		response.BadRequest(ctx, "Place ID is required")
+		return
+	}
+
+	var placeMergeDTO dto.PlaceMerge
+	err := ctx.ShouldBindJSON(&placeMergeDTO)
+	if err != nil {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	placeMerge := model.PlaceMerge{}
+	err = copier.Copy(&placeMerge, &placeMergeDTO)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	err = c.service.MergePlace(ctx.Request.Context(), placeID, myUserID, placeMerge)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// DeleteReviewByID
+// @Summary Delete review by ID as moderator
+// @Description Delete a review of any user
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "Review ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/reviews/{id} [delete]
+func (c *moderationControllerImpl) DeleteReviewByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "Review ID is required", c.service.DeleteReview)
+}
+
+// BanUserByID
+// @Summary Ban user by ID
+// @Description Prevent a user from logging in and using authenticated endpoints
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/users/{id}/ban [post]
+func (c *moderationControllerImpl) BanUserByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "User ID is required", c.service.BanUser)
+}
+
+// UnbanUserByID
+// @Summary Unban user by ID
+// @Description Lift the ban of a user
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param moderation body dto.Moderation false "Moderation reason"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/admin/users/{id}/unban [post]
+func (c *moderationControllerImpl) UnbanUserByID(ctx adapter.APIContext) {
+	c.moderate(ctx, "User ID is required", c.service.UnbanUser)
+}
+
+// moderate runs a moderation action on the target given by the id path
+// parameter. The request body with the reason is optional.
+func (c *moderationControllerImpl) moderate(
+	ctx adapter.APIContext,
+	missingIDMessage string,
+	action func(ctx context.Context, targetID string, moderatorID string, m model.Moderation) error,
+) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	targetID := ctx.Param("id")
+	if len(targetID) == 0 {
+		response.BadRequest(ctx, missingIDMessage)
+		return
+	}
+
+	var moderationDTO dto.Moderation
+	err := ctx.ShouldBindJSON(&moderationDTO)
+	if err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	err = action(ctx.Request.Context(), targetID, myUserID, model.Moderation{Reason: moderationDTO.Reason})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
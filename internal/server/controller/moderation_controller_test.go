@@ -0,0 +1,265 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestModerationController_BanUserByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		userID       string
+		targetID     string
+		body         string
+		mockSetup    func(s *service.MockModerationService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing userID",
+			targetID:     "456",
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "invalid body",
+			userID:       "123",
+			targetID:     "456",
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:     "self moderation",
+			userID:   "123",
+			targetID: "123",
+			mockSetup: func(s *service.MockModerationService) {
+				s.On("BanUser", mock.Anything, "123", "123", model.Moderation{}).Return(service.ErrSelfModeration)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Moderators cannot ban themselves", Code: "self_moderation"}},
+			},
+		},
+		{
+			name:     "success without body",
+			userID:   "123",
+			targetID: "456",
+			mockSetup: func(s *service.MockModerationService) {
+				s.On("BanUser", mock.Anything, "456", "123", model.Moderation{}).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+		{
+			name:     "success with reason",
+			userID:   "123",
+			targetID: "456",
+			body:     `{"reason":"abuse"}`,
+			mockSetup: func(s *service.MockModerationService) {
+				s.On("BanUser", mock.Anything, "456", "123", model.Moderation{Reason: "abuse"}).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockModerationService)
+			controller := NewModerationController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/admin/users/"+tt.targetID+"/ban", nil)
+			ctx.Request.Body = io.NopCloser(strings.NewReader(tt.body))
+
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: tt.targetID}}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.BanUserByID(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Nil(t, body.Data)
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationController_TransferPackByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		body         string
+		mockSetup    func(s *service.MockModerationService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing new author",
+			body:         `{"reason":"abandoned"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name: "new author not found",
+			body: `{"user_id":"john"}`,
+			mockSetup: func(s *service.MockModerationService) {
+				s.On("TransferPack", mock.Anything, "456", "123", model.PackTransfer{UserID: "john"}).Return(service.ErrUserNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "User not found", Code: "user_not_found"}},
+			},
+		},
+		{
+			name: "success",
+			body: `{"user_id":"john","reason":"abandoned"}`,
+			mockSetup: func(s *service.MockModerationService) {
+				s.On("TransferPack", mock.Anything, "456", "123", model.PackTransfer{UserID: "john", Reason: "abandoned"}).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockModerationService)
+			controller := NewModerationController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/admin/packs/456/transfer", nil)
+			ctx.Request.Body = io.NopCloser(strings.NewReader(tt.body))
+			ctx.Set("myUserID", "123")
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "456"}}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.TransferPackByID(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationController_MergePlaceByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		body         string
+		mockSetup    func(s *service.MockModerationService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing surviving place",
+			body:         `{"reason":"duplicate"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name: "surviving place not found",
+			body: `{"into_id":"789"}`,
+			mockSetup: func(s *service.MockModerationService) {
+				s.On("MergePlace", mock.Anything, "456", "123", model.PlaceMerge{IntoID: "789"}).Return(service.ErrPlaceNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Place not found", Code: "place_not_found"}},
+			},
+		},
+		{
+			name: "success",
+			body: `{"into_id":"789","reason":"duplicate"}`,
+			mockSetup: func(s *service.MockModerationService) {
+				s.On("MergePlace", mock.Anything, "456", "123", model.PlaceMerge{IntoID: "789", Reason: "duplicate"}).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockModerationService)
+			controller := NewModerationController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/admin/places/456/merge", nil)
+			ctx.Request.Body = io.NopCloser(strings.NewReader(tt.body))
+			ctx.Set("myUserID", "123")
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "456"}}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.MergePlaceByID(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
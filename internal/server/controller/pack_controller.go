@@ -1,10 +1,14 @@
 package controller
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"strings"
 
 	"locpack-backend/internal/server"
 	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/pkg/adapter"
@@ -22,115 +26,99 @@ func NewPackController(service service.PackService) server.PackController {
 
 // GetPacksByQuery
 // @Summary Search packs by query
-// @Description Get packs matching name or author
+// @Description Get public packs matching name or author, along with matching packs the current user created or collaborates on. Results are ranked by relevance and matched words are highlighted in name_highlight
 // @Tags Packs
 // @Param query query string true "Search query"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Page cursor"
 // @Success 200 {object} dto.ResponseWrapper{data=[]dto.Pack}
 // @Failure 400 {object} dto.ResponseWrapper{data=[]dto.Pack}
+// @Failure 422 {object} dto.ResponseWrapper
 // @Router /api/v1/packs [get]
 func (c *packControllerImpl) GetPacksByQuery(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 
 	query := ctx.Query("query")
 	if len(query) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Query is required")
 		return
 	}
 
-	packs, err := c.service.GetByNameOrAuthor(query, myUserID)
+	page, err := parsePage(ctx)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Page parameters are invalid")
+		return
+	}
+
+	packs, pageInfo, err := c.service.GetByNameOrAuthor(ctx.Request.Context(), query, myUserID, page)
+	if err != nil {
+		response.Error(ctx, err)
 		return
 	}
 
 	var packsDTOs []dto.Pack
 	err = copier.Copy(&packsDTOs, &packs)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
 		Data: packsDTOs,
-		Meta: dto.Meta{Success: true},
+		Meta: dto.Meta{
+			Success:    true,
+			NextCursor: pageInfo.NextCursor,
+			Total:      &pageInfo.Total,
+		},
 	})
 }
 
 // PostPack
 // @Summary Create a new pack
-// @Description Add a new pack to the database
+// @Description Add a new pack to the database. Visibility is PRIVATE, UNLISTED or PUBLIC and defaults to PUBLIC
 // @Tags Packs
 // @Security BearerAuth
 // @Param pack body dto.PackCreate true "Pack data"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Header 200 {string} ETag "Version of the pack"
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Failure 401 {object} dto.ResponseWrapper
 // @Router /api/v1/packs [post]
 func (c *packControllerImpl) PostPack(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 	if len(myUserID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, service.ErrUnauthenticated)
 		return
 	}
 
 	var packCreateDTO dto.PackCreate
 	err := ctx.ShouldBindJSON(&packCreateDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Request body is invalid")
 		return
 	}
 
 	packCreate := model.PackCreate{}
 	err = copier.Copy(&packCreate, &packCreateDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
-	pack, err := c.service.Create(myUserID, packCreate)
+	pack, err := c.service.Create(ctx.Request.Context(), myUserID, packCreate)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	packDTO := dto.Pack{}
 	err = copier.Copy(&packDTO, &pack)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
+	setETag(ctx, pack.Version)
 	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
 		Data: packDTO,
 		Meta: dto.Meta{Success: true},
@@ -139,41 +127,34 @@ func (c *packControllerImpl) PostPack(ctx adapter.APIContext) {
 
 // GetPacksFollowed
 // @Summary Get followed packs
-// @Description Get packs followed by the current user
+// @Description Get packs followed by the current user with the progress of the user through their places
 // @Tags Packs
 // @Security BearerAuth
+// @Param status query string false "in_progress or completed"
 // @Success 200 {object} dto.ResponseWrapper{data=[]dto.Pack}
 // @Failure 400 {object} dto.ResponseWrapper{data=[]dto.Pack}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
 // @Router /api/v1/packs/followed [get]
 func (c *packControllerImpl) GetPacksFollowed(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 	if len(myUserID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, service.ErrUnauthenticated)
 		return
 	}
 
-	packs, err := c.service.GetFollowedByUserID(myUserID)
+	progress := strings.ToUpper(ctx.Query("status"))
+
+	packs, err := c.service.GetFollowedByUserID(ctx.Request.Context(), myUserID, progress)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	var packsDTOs []dto.Pack
 	err = copier.Copy(&packsDTOs, &packs)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
@@ -190,36 +171,25 @@ func (c *packControllerImpl) GetPacksFollowed(ctx adapter.APIContext) {
 // @Security BearerAuth
 // @Success 200 {object} dto.ResponseWrapper{data=[]dto.Pack}
 // @Failure 400 {object} dto.ResponseWrapper{data=[]dto.Pack}
+// @Failure 401 {object} dto.ResponseWrapper
 // @Router /api/v1/packs/created [get]
 func (c *packControllerImpl) GetPacksCreated(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 	if len(myUserID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, service.ErrUnauthenticated)
 		return
 	}
 
-	packs, err := c.service.GetCreatedByUserID(myUserID)
+	packs, err := c.service.GetCreatedByUserID(ctx.Request.Context(), myUserID)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	var packsDTOs []dto.Pack
 	err = copier.Copy(&packsDTOs, &packs)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
@@ -231,46 +201,37 @@ func (c *packControllerImpl) GetPacksCreated(ctx adapter.APIContext) {
 
 // GetPackByID
 // @Summary Get pack by ID
-// @Description Get a specific pack by its ID
+// @Description Get a specific pack by its ID. Private packs are found only by their author and collaborators
 // @Tags Packs
 // @Param id path string true "Pack ID"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Header 200 {string} ETag "Version of the pack"
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Failure 404 {object} dto.ResponseWrapper
 // @Router /api/v1/packs/{id} [get]
 func (c *packControllerImpl) GetPackByID(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 
 	packID := ctx.Param("id")
 	if len(packID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Pack ID is required")
 		return
 	}
 
-	pack, err := c.service.GetByID(packID, myUserID)
+	pack, err := c.service.GetByID(ctx.Request.Context(), packID, myUserID)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	packDTO := dto.Pack{}
 	err = copier.Copy(&packDTO, &pack)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
+	setETag(ctx, pack.Version)
 	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
 		Data: packDTO,
 		Meta: dto.Meta{Success: true},
@@ -279,78 +240,578 @@ func (c *packControllerImpl) GetPackByID(ctx adapter.APIContext) {
 
 // PutPackByID
 // @Summary Update pack by ID
-// @Description Update a specific pack by its ID
+// @Description Update a specific pack by its ID. Only the author can change its visibility
 // @Tags Packs
 // @Security BearerAuth
 // @Param id path string true "Pack ID"
 // @Param pack body dto.PackUpdate true "Pack data"
+// @Param If-Match header string false "ETags of the versions to update"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Header 200 {string} ETag "Version of the pack"
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 409 {object} dto.ResponseWrapper
+// @Failure 412 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
 // @Router /api/v1/packs/{id} [put]
 func (c *packControllerImpl) PutPackByID(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 	if len(myUserID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, service.ErrUnauthenticated)
 		return
 	}
 
 	packID := ctx.Param("id")
 	if len(packID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Pack ID is required")
 		return
 	}
 
 	var packUpdateDTO dto.PackUpdate
 	err := ctx.ShouldBindJSON(&packUpdateDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Request body is invalid")
 		return
 	}
 
 	packUpdate := model.PackUpdate{}
 	err = copier.Copy(&packUpdate, &packUpdateDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
+		return
+	}
+
+	packUpdate.Versions, err = parseIfMatch(ctx)
+	if err != nil {
+		response.BadRequest(ctx, "If-Match header is invalid")
+		return
+	}
+
+	pack, err := c.service.UpdateByID(ctx.Request.Context(), packID, myUserID, packUpdate)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	packDTO := dto.Pack{}
+	err = copier.Copy(&packDTO, &pack)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	setETag(ctx, pack.Version)
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: packDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// PatchPackPlaceByID
+// @Summary Update place inside pack
+// @Description Move a place to a new position inside a pack the current user can edit or change its note and planned duration (in minutes)
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param placeId path string true "Place ID"
+// @Param place body dto.PackPlaceUpdate true "Pack place data"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Header 200 {string} ETag "Version of the pack"
+// @Failure 400 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/places/{placeId} [patch]
+func (c *packControllerImpl) PatchPackPlaceByID(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	placeID := ctx.Param("placeId")
+	if len(packID) == 0 || len(placeID) == 0 {
+		response.BadRequest(ctx, "Pack ID and place ID are required")
+		return
+	}
+
+	var packPlaceUpdateDTO dto.PackPlaceUpdate
+	err := ctx.ShouldBindJSON(&packPlaceUpdateDTO)
+	if err != nil {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	packPlaceUpdate := model.PackPlaceUpdate{
+		Position:        packPlaceUpdateDTO.Position,
+		Note:            packPlaceUpdateDTO.Note,
+		PlannedDuration: packPlaceUpdateDTO.PlannedDuration,
+	}
+
+	pack, err := c.service.UpdatePlaceByID(ctx.Request.Context(), packID, placeID, myUserID, packPlaceUpdate)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	packDTO := dto.Pack{}
+	err = copier.Copy(&packDTO, &pack)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	setETag(ctx, pack.Version)
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: packDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// DeletePackByID
+// @Summary Delete pack by ID
+// @Description Delete a specific pack created by the current user. It can be restored during the retention period
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id} [delete]
+func (c *packControllerImpl) DeletePackByID(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	if len(packID) == 0 {
+		response.BadRequest(ctx, "Pack ID is required")
+		return
+	}
+
+	err := c.service.DeleteByID(ctx.Request.Context(), packID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// RestorePackByID
+// @Summary Restore deleted pack by ID
+// @Description Restore a pack deleted by the current user during the retention period
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Header 200 {string} ETag "Version of the pack"
+// @Failure 400 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/restore [post]
+func (c *packControllerImpl) RestorePackByID(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	if len(packID) == 0 {
+		response.BadRequest(ctx, "Pack ID is required")
+		return
+	}
+
+	pack, err := c.service.RestoreByID(ctx.Request.Context(), packID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	packDTO := dto.Pack{}
+	err = copier.Copy(&packDTO, &pack)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	setETag(ctx, pack.Version)
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: packDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// PostPackCollaborator
+// @Summary Invite collaborator to pack
+// @Description Invite a user to a pack created by the current user as EDITOR or VIEWER. Inviting an existing collaborator changes their role
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param collaborator body dto.CollaboratorInvite true "Collaborator data"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Collaborator}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/collaborators [post]
+func (c *packControllerImpl) PostPackCollaborator(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	if len(packID) == 0 {
+		response.BadRequest(ctx, "Pack ID is required")
+		return
+	}
+
+	var collaboratorInviteDTO dto.CollaboratorInvite
+	err := ctx.ShouldBindJSON(&collaboratorInviteDTO)
+	if err != nil {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	collaboratorInvite := model.CollaboratorInvite{
+		UserID: collaboratorInviteDTO.UserID,
+		Role:   collaboratorInviteDTO.Role,
+	}
+
+	collaborator, err := c.service.InviteCollaborator(ctx.Request.Context(), packID, myUserID, collaboratorInvite)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	collaboratorDTO := dto.Collaborator{}
+	err = copier.Copy(&collaboratorDTO, &collaborator)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: collaboratorDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// AcceptPackInvitation
+// @Summary Accept pack invitation
+// @Description Accept an invitation of the current user to collaborate on a pack
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Header 200 {string} ETag "Version of the pack"
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/invitation/accept [post]
+func (c *packControllerImpl) AcceptPackInvitation(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	if len(packID) == 0 {
+		response.BadRequest(ctx, "Pack ID is required")
+		return
+	}
+
+	pack, err := c.service.AcceptInvitation(ctx.Request.Context(), packID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	packDTO := dto.Pack{}
+	err = copier.Copy(&packDTO, &pack)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	setETag(ctx, pack.Version)
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: packDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// DeletePackCollaborator
+// @Summary Remove collaborator from pack
+// @Description Remove a collaborator from a pack created by the current user, or leave a pack the current user collaborates on
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param userId path string true "Collaborator user ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/collaborators/{userId} [delete]
+func (c *packControllerImpl) DeletePackCollaborator(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	collaboratorID := ctx.Param("userId")
+	if len(packID) == 0 || len(collaboratorID) == 0 {
+		response.BadRequest(ctx, "Pack ID and user ID are required")
+		return
+	}
+
+	err := c.service.RemoveCollaborator(ctx.Request.Context(), packID, collaboratorID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// PostPackShareLink
+// @Summary Create share link for pack
+// @Description Create a revocable link that grants access to a pack created by the current user, even if it is private. The link never expires unless expires_at is set
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param link body dto.ShareLinkCreate false "Share link data"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.ShareLink}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/share-links [post]
+func (c *packControllerImpl) PostPackShareLink(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	if len(packID) == 0 {
+		response.BadRequest(ctx, "Pack ID is required")
+		return
+	}
+
+	var shareLinkCreateDTO dto.ShareLinkCreate
+	err := ctx.ShouldBindJSON(&shareLinkCreateDTO)
+	if err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	shareLinkCreate := model.ShareLinkCreate{
+		ExpiresAt: shareLinkCreateDTO.ExpiresAt,
+	}
+
+	shareLink, err := c.service.CreateShareLink(ctx.Request.Context(), packID, myUserID, shareLinkCreate)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	shareLinkDTO := dto.ShareLink{}
+	err = copier.Copy(&shareLinkDTO, &shareLink)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: shareLinkDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// GetPackShareLinks
+// @Summary Get share links of pack
+// @Description Get active share links of a pack created by the current user
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Success 200 {object} dto.ResponseWrapper{data=[]dto.ShareLink}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/share-links [get]
+func (c *packControllerImpl) GetPackShareLinks(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	if len(packID) == 0 {
+		response.BadRequest(ctx, "Pack ID is required")
+		return
+	}
+
+	shareLinks, err := c.service.GetShareLinks(ctx.Request.Context(), packID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	shareLinksDTOs := []dto.ShareLink{}
+	err = copier.Copy(&shareLinksDTOs, &shareLinks)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: shareLinksDTOs,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// DeletePackShareLink
+// @Summary Revoke share link of pack
+// @Description Revoke a share link of a pack created by the current user
+// @Tags Packs
+// @Security BearerAuth
+// @Param id path string true "Pack ID"
+// @Param linkId path string true "Share link ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/packs/{id}/share-links/{linkId} [delete]
+func (c *packControllerImpl) DeletePackShareLink(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	packID := ctx.Param("id")
+	linkID := ctx.Param("linkId")
+	if len(packID) == 0 || len(linkID) == 0 {
+		response.BadRequest(ctx, "Pack ID and share link ID are required")
+		return
+	}
+
+	err := c.service.RevokeShareLink(ctx.Request.Context(), packID, linkID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// GetSharedPack
+// @Summary Get pack by share link
+// @Description Get the pack of an active share link, even if it is private
+// @Tags Packs
+// @Param token path string true "Share link token"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Header 200 {string} ETag "Version of the pack"
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/shared/{token} [get]
+func (c *packControllerImpl) GetSharedPack(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+
+	token := ctx.Param("token")
+	if len(token) == 0 {
+		response.BadRequest(ctx, "Share link token is required")
+		return
+	}
+
+	pack, err := c.service.GetByShareToken(ctx.Request.Context(), token, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	packDTO := dto.Pack{}
+	err = copier.Copy(&packDTO, &pack)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	setETag(ctx, pack.Version)
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: packDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// FollowSharedPack
+// @Summary Follow pack by share link
+// @Description Follow the pack of an active share link. Private packs are joined as VIEWER instead, because followers cannot see them
+// @Tags Packs
+// @Security BearerAuth
+// @Param token path string true "Share link token"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Pack}
+// @Header 200 {string} ETag "Version of the pack"
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/shared/{token}/follow [post]
+func (c *packControllerImpl) FollowSharedPack(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	token := ctx.Param("token")
+	if len(token) == 0 {
+		response.BadRequest(ctx, "Share link token is required")
 		return
 	}
 
-	pack, err := c.service.UpdateByID(packID, myUserID, packUpdate)
+	pack, err := c.service.FollowByShareToken(ctx.Request.Context(), token, myUserID)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	packDTO := dto.Pack{}
 	err = copier.Copy(&packDTO, &pack)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
+	setETag(ctx, pack.Version)
 	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
 		Data: packDTO,
 		Meta: dto.Meta{Success: true},
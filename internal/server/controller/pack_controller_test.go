@@ -2,11 +2,13 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -14,9 +16,12 @@ import (
 	"locpack-backend/internal/server/dto"
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/enum/pack_progress"
 )
 
 func TestPackController_GetPacksByQuery(t *testing.T) {
+	total := int64(1)
+
 	tests := []struct {
 		name           string
 		userID         string
@@ -31,7 +36,7 @@ func TestPackController_GetPacksByQuery(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Query is required", Code: "bad_request"}},
 			},
 		},
 		{
@@ -41,44 +46,59 @@ func TestPackController_GetPacksByQuery(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Query is required", Code: "bad_request"}},
 			},
 		},
 		{
 			name:   "service returns error",
 			userID: "user1",
 			query:  "test",
-			mockSetup: func(mock *service.MockPackService) {
-				mock.On("GetByNameOrAuthor", "test", "user1").Return(nil, errors.New("service error"))
+			mockSetup: func(m *service.MockPackService) {
+				m.On("GetByNameOrAuthor", mock.Anything, "test", "user1", model.Page{}).Return(nil, model.PageInfo{}, errors.New("service error"))
 			},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Internal error", Code: "internal_error"}},
+			},
+		},
+		{
+			name:   "request deadline exceeded",
+			userID: "user1",
+			query:  "test",
+			mockSetup: func(m *service.MockPackService) {
+				m.On("GetByNameOrAuthor", mock.Anything, "test", "user1", model.Page{}).Return(nil, model.PageInfo{}, service.ErrInternal.Wrap(context.DeadlineExceeded))
+			},
+			expectedStatus: http.StatusGatewayTimeout,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Request took too long", Code: "timeout"}},
 			},
 		},
 		{
 			name:   "success",
 			userID: "user1",
 			query:  "test",
-			mockSetup: func(mock *service.MockPackService) {
+			mockSetup: func(m *service.MockPackService) {
 				packs := []model.Pack{
 					{
 						Name: "test",
 					},
 				}
 
-				mock.On("GetByNameOrAuthor", "test", "user1").Return(packs, nil)
+				m.On("GetByNameOrAuthor", mock.Anything, "test", "user1", model.Page{}).Return(packs, model.PageInfo{Total: 1}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
 				Data: []dto.Pack{
 					{
-						Name: "test",
+						Name:   "test",
+						Places: []dto.Place{},
 					},
 				},
-				Meta:   dto.Meta{Success: true},
+				Meta:   dto.Meta{Success: true, Total: &total},
 				Errors: nil,
 			},
 		},
@@ -92,7 +112,7 @@ func TestPackController_GetPacksByQuery(t *testing.T) {
 			}
 
 			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/packs?query="+tt.query, nil)
-			ctx.Set("userID", tt.userID)
+			ctx.Set("myUserID", tt.userID)
 
 			controller := NewPackController(&mockService)
 
@@ -143,11 +163,11 @@ func TestPackController_PostPack(t *testing.T) {
 			name:         "missing userID",
 			userID:       "",
 			requestBody:  validInput,
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusUnauthorized,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
 			},
 		},
 		{
@@ -158,7 +178,7 @@ func TestPackController_PostPack(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
 			},
 		},
 		{
@@ -166,13 +186,13 @@ func TestPackController_PostPack(t *testing.T) {
 			userID:      "123",
 			requestBody: validInput,
 			mockSetup: func(s *service.MockPackService) {
-				s.On("Register", "123", mock.Anything).Return(model.Pack{}, errors.New("service error"))
+				s.On("Create", mock.Anything, "123", mock.Anything).Return(model.Pack{}, errors.New("service error"))
 			},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusInternalServerError,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Internal error", Code: "internal_error"}},
 			},
 		},
 		{
@@ -180,7 +200,7 @@ func TestPackController_PostPack(t *testing.T) {
 			userID:      "123",
 			requestBody: validInput,
 			mockSetup: func(s *service.MockPackService) {
-				s.On("Register", "123", mock.Anything).Return(model.Pack{Name: "Test Pack"}, nil)
+				s.On("Create", mock.Anything, "123", mock.Anything).Return(model.Pack{Name: "Test Pack"}, nil)
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
@@ -208,7 +228,7 @@ func TestPackController_PostPack(t *testing.T) {
 			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/packs", requestBody)
 
 			if tt.userID != "" {
-				ctx.Set("userID", tt.userID)
+				ctx.Set("myUserID", tt.userID)
 			}
 
 			if tt.overrideBindJSON {
@@ -249,58 +269,103 @@ func TestPackController_PostPack(t *testing.T) {
 func TestPackController_GetPacksFollowed(t *testing.T) {
 	t.Parallel()
 
+	completedAt := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name           string
 		userID         string
+		query          string
 		mockSetup      func(*service.MockPackService)
 		expectedStatus int
 		expectedBody   dto.ResponseWrapper
 	}{
 		{
 			name:           "missing user id in context",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusUnauthorized,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
 			},
 		},
 		{
 			name:   "service returns error",
 			userID: "user1",
-			mockSetup: func(mock *service.MockPackService) {
-				mock.On("GetFollowedByUserID", "user1").Return(nil, errors.New("service error"))
+			mockSetup: func(m *service.MockPackService) {
+				m.On("GetFollowedByUserID", mock.Anything, "user1", "").Return(nil, errors.New("service error"))
 			},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Internal error", Code: "internal_error"}},
 			},
 		},
 		{
 			name:   "success",
 			userID: "user1",
-			mockSetup: func(mock *service.MockPackService) {
+			mockSetup: func(m *service.MockPackService) {
 				packs := []model.Pack{
 					{
 						Name: "test",
 					},
 				}
 
-				mock.On("GetFollowedByUserID", "user1").Return(packs, nil)
+				m.On("GetFollowedByUserID", mock.Anything, "user1", "").Return(packs, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
 				Data: []dto.Pack{
 					{
-						Name: "test",
+						Name:   "test",
+						Places: []dto.Place{},
 					},
 				},
 				Meta:   dto.Meta{Success: true},
 				Errors: nil,
 			},
 		},
+		{
+			name:   "success - completed",
+			userID: "user1",
+			query:  "?status=completed",
+			mockSetup: func(m *service.MockPackService) {
+				packs := []model.Pack{
+					{
+						Name:     "test",
+						Progress: &model.PackProgress{Visited: 2, Total: 2, Percent: 100, CompletedAt: &completedAt},
+					},
+				}
+
+				m.On("GetFollowedByUserID", mock.Anything, "user1", pack_progress.Completed).Return(packs, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: []dto.Pack{
+					{
+						Name:     "test",
+						Places:   []dto.Place{},
+						Progress: &dto.PackProgress{Visited: 2, Total: 2, Percent: 100, CompletedAt: &completedAt},
+					},
+				},
+				Meta:   dto.Meta{Success: true},
+				Errors: nil,
+			},
+		},
+		{
+			name:   "invalid status",
+			userID: "user1",
+			query:  "?status=done",
+			mockSetup: func(m *service.MockPackService) {
+				m.On("GetFollowedByUserID", mock.Anything, "user1", "DONE").Return(nil, service.ErrInvalidProgress)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Status must be in_progress or completed", Code: "invalid_progress"}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -310,8 +375,8 @@ func TestPackController_GetPacksFollowed(t *testing.T) {
 				tt.mockSetup(&mockService)
 			}
 
-			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/packs/followed", nil)
-			ctx.Set("userID", tt.userID)
+			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/packs/followed"+tt.query, nil)
+			ctx.Set("myUserID", tt.userID)
 
 			controller := NewPackController(&mockService)
 
@@ -354,43 +419,44 @@ func TestPackController_GetPacksCreated(t *testing.T) {
 	}{
 		{
 			name:           "missing user id in context",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusUnauthorized,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
 			},
 		},
 		{
 			name:   "service returns error",
 			userID: "user1",
-			mockSetup: func(mock *service.MockPackService) {
-				mock.On("GetCreatedByUserID", "user1").Return(nil, errors.New("service error"))
+			mockSetup: func(m *service.MockPackService) {
+				m.On("GetCreatedByUserID", mock.Anything, "user1").Return(nil, errors.New("service error"))
 			},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Internal error", Code: "internal_error"}},
 			},
 		},
 		{
 			name:   "success",
 			userID: "user1",
-			mockSetup: func(mock *service.MockPackService) {
+			mockSetup: func(m *service.MockPackService) {
 				packs := []model.Pack{
 					{
 						Name: "test",
 					},
 				}
 
-				mock.On("GetCreatedByUserID", "user1").Return(packs, nil)
+				m.On("GetCreatedByUserID", mock.Anything, "user1").Return(packs, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
 				Data: []dto.Pack{
 					{
-						Name: "test",
+						Name:   "test",
+						Places: []dto.Place{},
 					},
 				},
 				Meta:   dto.Meta{Success: true},
@@ -407,7 +473,7 @@ func TestPackController_GetPacksCreated(t *testing.T) {
 			}
 
 			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/packs/created", nil)
-			ctx.Set("userID", tt.userID)
+			ctx.Set("myUserID", tt.userID)
 
 			controller := NewPackController(&mockService)
 
@@ -450,14 +516,16 @@ func TestPackController_GetPackByID(t *testing.T) {
 		expectedCode int
 	}{
 		{
-			name:         "missing userID",
-			userID:       "",
-			packID:       "456",
-			expectedCode: http.StatusBadRequest,
+			name:   "anonymous user",
+			userID: "",
+			packID: "456",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("GetByID", mock.Anything, "456", "").Return(model.Pack{Name: "Test Pack"}, nil)
+			},
+			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
-				Data:   nil,
-				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Data: dto.Pack{Name: "Test Pack"},
+				Meta: dto.Meta{Success: true},
 			},
 		},
 		{
@@ -468,7 +536,7 @@ func TestPackController_GetPackByID(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Pack ID is required", Code: "bad_request"}},
 			},
 		},
 		{
@@ -476,13 +544,13 @@ func TestPackController_GetPackByID(t *testing.T) {
 			userID: "123",
 			packID: "456",
 			mockSetup: func(s *service.MockPackService) {
-				s.On("GetByID", "456", "123").Return(model.Pack{}, errors.New("service error"))
+				s.On("GetByID", mock.Anything, "456", "123").Return(model.Pack{}, service.ErrPackNotFound)
 			},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusNotFound,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Pack not found", Code: "pack_not_found"}},
 			},
 		},
 		{
@@ -490,7 +558,7 @@ func TestPackController_GetPackByID(t *testing.T) {
 			userID: "123",
 			packID: "456",
 			mockSetup: func(s *service.MockPackService) {
-				s.On("GetByID", "456", "123").Return(model.Pack{Name: "My Pack"}, nil)
+				s.On("GetByID", mock.Anything, "456", "123").Return(model.Pack{Name: "My Pack"}, nil)
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
@@ -508,7 +576,7 @@ func TestPackController_GetPackByID(t *testing.T) {
 			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/packs/"+tt.packID, nil)
 
 			if tt.userID != "" {
-				ctx.Set("userID", tt.userID)
+				ctx.Set("myUserID", tt.userID)
 			}
 			if tt.packID != "" {
 				ctx.Params = gin.Params{gin.Param{Key: "id", Value: tt.packID}}
@@ -560,11 +628,11 @@ func TestPackController_PutPackByID(t *testing.T) {
 			userID:       "",
 			packID:       "123",
 			requestBody:  dto.PackUpdate{Name: "Updated"},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusUnauthorized,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
 			},
 		},
 		{
@@ -576,7 +644,7 @@ func TestPackController_PutPackByID(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Pack ID is required", Code: "bad_request"}},
 			},
 		},
 		{
@@ -588,7 +656,7 @@ func TestPackController_PutPackByID(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
 			},
 		},
 		{
@@ -597,13 +665,13 @@ func TestPackController_PutPackByID(t *testing.T) {
 			packID:      "123",
 			requestBody: dto.PackUpdate{Name: "Updated"},
 			mockSetup: func(s *service.MockPackService) {
-				s.On("UpdateByID", "123", "456", mock.Anything).Return(model.Pack{}, errors.New("update failed"))
+				s.On("UpdateByID", mock.Anything, "123", "456", mock.Anything).Return(model.Pack{}, service.ErrPackFollowOnly)
 			},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusConflict,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "It is possible only to follow this pack", Code: "pack_follow_only"}},
 			},
 		},
 		{
@@ -612,7 +680,7 @@ func TestPackController_PutPackByID(t *testing.T) {
 			packID:      "123",
 			requestBody: dto.PackUpdate{Name: "Updated"},
 			mockSetup: func(s *service.MockPackService) {
-				s.On("UpdateByID", "123", "456", mock.Anything).Return(model.Pack{Name: "Updated"}, nil)
+				s.On("UpdateByID", mock.Anything, "123", "456", mock.Anything).Return(model.Pack{Name: "Updated"}, nil)
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
@@ -641,7 +709,7 @@ func TestPackController_PutPackByID(t *testing.T) {
 			}
 
 			if tt.userID != "" {
-				ctx.Set("userID", tt.userID)
+				ctx.Set("myUserID", tt.userID)
 			}
 			if tt.packID != "" {
 				ctx.Params = gin.Params{gin.Param{Key: "id", Value: tt.packID}}
@@ -674,3 +742,590 @@ func TestPackController_PutPackByID(t *testing.T) {
 		})
 	}
 }
+
+func TestPackController_DeletePackByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		userID       string
+		packID       string
+		mockSetup    func(s *service.MockPackService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing userID",
+			userID:       "",
+			packID:       "456",
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "missing packID",
+			userID:       "123",
+			packID:       "",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Pack ID is required", Code: "bad_request"}},
+			},
+		},
+		{
+			name:   "service error",
+			userID: "123",
+			packID: "456",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("DeleteByID", mock.Anything, "456", "123").Return(service.ErrNotAuthor)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "User is not author", Code: "not_author"}},
+			},
+		},
+		{
+			name:   "success",
+			userID: "123",
+			packID: "456",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("DeleteByID", mock.Anything, "456", "123").Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: nil,
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockPackService)
+			controller := NewPackController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodDelete, "/api/v1/packs/"+tt.packID, nil)
+
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			if tt.packID != "" {
+				ctx.Params = gin.Params{gin.Param{Key: "id", Value: tt.packID}}
+			}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.DeletePackByID(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Nil(t, body.Data)
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackController_PatchPackPlaceByID(t *testing.T) {
+	t.Parallel()
+
+	position := 2
+
+	testCases := []struct {
+		name         string
+		userID       string
+		packID       string
+		placeID      string
+		requestBody  string
+		mockSetup    func(s *service.MockPackService)
+		expectedCode int
+		expectedBody dto.ResponseWrapper
+	}{
+		{
+			name:         "missing userID",
+			packID:       "123",
+			placeID:      "789",
+			requestBody:  `{"position": 2}`,
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "missing placeID",
+			userID:       "456",
+			packID:       "123",
+			requestBody:  `{"position": 2}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Pack ID and place ID are required", Code: "bad_request"}},
+			},
+		},
+		{
+			name:         "invalid JSON",
+			userID:       "456",
+			packID:       "123",
+			placeID:      "789",
+			requestBody:  `{invalid-json`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:        "service error",
+			userID:      "456",
+			packID:      "123",
+			placeID:     "789",
+			requestBody: `{"position": 2}`,
+			mockSetup: func(s *service.MockPackService) {
+				s.On("UpdatePlaceByID", mock.Anything, "123", "789", "456", model.PackPlaceUpdate{Position: &position}).
+					Return(model.Pack{}, service.ErrInvalidPosition)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Position is out of range", Code: "invalid_position"}},
+			},
+		},
+		{
+			name:        "success",
+			userID:      "456",
+			packID:      "123",
+			placeID:     "789",
+			requestBody: `{"position": 2}`,
+			mockSetup: func(s *service.MockPackService) {
+				s.On("UpdatePlaceByID", mock.Anything, "123", "789", "456", model.PackPlaceUpdate{Position: &position}).
+					Return(model.Pack{
+						Name: "Trip",
+						Places: []model.Place{
+							{Name: "First", Position: 1},
+							{Name: "Moved", Position: 2, Note: "Lunch", PlannedDuration: 60},
+						},
+					}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: dto.Pack{
+					Name: "Trip",
+					Places: []dto.Place{
+						{Name: "First", Position: 1},
+						{Name: "Moved", Position: 2, Note: "Lunch", PlannedDuration: 60},
+					},
+				},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockPackService)
+			controller := NewPackController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPatch, "/api/v1/packs/"+tt.packID+"/places/"+tt.placeID, nil)
+			ctx.Request.Body = io.NopCloser(bytes.NewBufferString(tt.requestBody))
+
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			ctx.Params = gin.Params{
+				gin.Param{Key: "id", Value: tt.packID},
+				gin.Param{Key: "placeId", Value: tt.placeID},
+			}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.PatchPackPlaceByID(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			if tt.expectedBody.Data != nil {
+				expected := tt.expectedBody.Data.(dto.Pack)
+				dataBytes, _ := json.Marshal(body.Data)
+				var actual dto.Pack
+				_ = json.Unmarshal(dataBytes, &actual)
+				assert.Equal(t, expected, actual)
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackController_PostPackCollaborator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		userID       string
+		requestBody  string
+		mockSetup    func(s *service.MockPackService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing userID",
+			requestBody:  `{"user_id": "789", "role": "EDITOR"}`,
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "missing role",
+			userID:       "456",
+			requestBody:  `{"user_id": "789"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:        "invalid role",
+			userID:      "456",
+			requestBody: `{"user_id": "789", "role": "OWNER"}`,
+			mockSetup: func(s *service.MockPackService) {
+				s.On("InviteCollaborator", mock.Anything, "123", "456", model.CollaboratorInvite{UserID: "789", Role: "OWNER"}).
+					Return(model.Collaborator{}, service.ErrInvalidRole)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Role must be EDITOR or VIEWER", Code: "invalid_role"}},
+			},
+		},
+		{
+			name:        "success",
+			userID:      "456",
+			requestBody: `{"user_id": "789", "role": "EDITOR"}`,
+			mockSetup: func(s *service.MockPackService) {
+				s.On("InviteCollaborator", mock.Anything, "123", "456", model.CollaboratorInvite{UserID: "789", Role: "EDITOR"}).
+					Return(model.Collaborator{User: model.User{ID: "789", Username: "john"}, Role: "EDITOR"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: dto.Collaborator{User: dto.User{ID: "789", Username: "john"}, Role: "EDITOR"},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockPackService)
+			controller := NewPackController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/packs/123/collaborators", nil)
+			ctx.Request.Body = io.NopCloser(bytes.NewBufferString(tt.requestBody))
+
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.PostPackCollaborator(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			if tt.expectedBody.Data != nil {
+				expected := tt.expectedBody.Data.(dto.Collaborator)
+				dataBytes, _ := json.Marshal(body.Data)
+				var actual dto.Collaborator
+				_ = json.Unmarshal(dataBytes, &actual)
+				assert.Equal(t, expected, actual)
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackController_DeletePackCollaborator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		userID         string
+		collaboratorID string
+		mockSetup      func(s *service.MockPackService)
+		expectedBody   dto.ResponseWrapper
+		expectedCode   int
+	}{
+		{
+			name:         "missing collaboratorID",
+			userID:       "456",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Pack ID and user ID are required", Code: "bad_request"}},
+			},
+		},
+		{
+			name:           "collaborator not found",
+			userID:         "456",
+			collaboratorID: "789",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("RemoveCollaborator", mock.Anything, "123", "789", "456").Return(service.ErrCollaboratorNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Collaborator not found", Code: "collaborator_not_found"}},
+			},
+		},
+		{
+			name:           "success",
+			userID:         "456",
+			collaboratorID: "789",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("RemoveCollaborator", mock.Anything, "123", "789", "456").Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockPackService)
+			controller := NewPackController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodDelete, "/api/v1/packs/123/collaborators/"+tt.collaboratorID, nil)
+
+			ctx.Set("myUserID", tt.userID)
+			ctx.Params = gin.Params{
+				gin.Param{Key: "id", Value: "123"},
+				gin.Param{Key: "userId", Value: tt.collaboratorID},
+			}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.DeletePackCollaborator(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Nil(t, body.Data)
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackController_PostPackShareLink(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		requestBody  string
+		mockSetup    func(s *service.MockPackService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:        "without body",
+			requestBody: "",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("CreateShareLink", mock.Anything, "123", "456", model.ShareLinkCreate{}).
+					Return(model.ShareLink{ID: "link1", Token: "token1"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: dto.ShareLink{ID: "link1", Token: "token1"},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+		{
+			name:        "with expiry",
+			requestBody: `{"expires_at": "2030-01-01T00:00:00Z"}`,
+			mockSetup: func(s *service.MockPackService) {
+				s.On("CreateShareLink", mock.Anything, "123", "456", mock.MatchedBy(func(slc model.ShareLinkCreate) bool {
+					return slc.ExpiresAt != nil && slc.ExpiresAt.Equal(expiresAt)
+				})).Return(model.ShareLink{ID: "link1", Token: "token1", ExpiresAt: &expiresAt}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: dto.ShareLink{ID: "link1", Token: "token1", ExpiresAt: &expiresAt},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+		{
+			name:         "invalid body",
+			requestBody:  `{"expires_at": "tomorrow"}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:        "not author",
+			requestBody: "",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("CreateShareLink", mock.Anything, "123", "456", model.ShareLinkCreate{}).
+					Return(model.ShareLink{}, service.ErrNotAuthor)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "User is not author", Code: "not_author"}},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockPackService)
+			controller := NewPackController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/packs/123/share-links", nil)
+			ctx.Request.Body = io.NopCloser(bytes.NewBufferString(tt.requestBody))
+
+			ctx.Set("myUserID", "456")
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.PostPackShareLink(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			if tt.expectedBody.Data != nil {
+				expected := tt.expectedBody.Data.(dto.ShareLink)
+				dataBytes, _ := json.Marshal(body.Data)
+				var actual dto.ShareLink
+				_ = json.Unmarshal(dataBytes, &actual)
+				assert.Equal(t, expected.ID, actual.ID)
+				assert.Equal(t, expected.Token, actual.Token)
+				assert.Equal(t, expected.ExpiresAt == nil, actual.ExpiresAt == nil)
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackController_GetSharedPack(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		mockSetup    func(s *service.MockPackService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name: "link not found",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("GetByShareToken", mock.Anything, "token1", "").Return(model.Pack{}, service.ErrShareLinkNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Share link not found or expired", Code: "share_link_not_found"}},
+			},
+		},
+		{
+			name: "success",
+			mockSetup: func(s *service.MockPackService) {
+				s.On("GetByShareToken", mock.Anything, "token1", "").
+					Return(model.Pack{ID: "123", Name: "Wishlist", Visibility: "PRIVATE", Version: 2, Places: []model.Place{}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: dto.Pack{ID: "123", Name: "Wishlist", Visibility: "PRIVATE", Version: 2, Places: []dto.Place{}},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockPackService)
+			controller := NewPackController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/shared/token1", nil)
+			ctx.Params = gin.Params{gin.Param{Key: "token", Value: "token1"}}
+			tt.mockSetup(mockService)
+
+			controller.GetSharedPack(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			if tt.expectedBody.Data != nil {
+				expected := tt.expectedBody.Data.(dto.Pack)
+				dataBytes, _ := json.Marshal(body.Data)
+				var actual dto.Pack
+				_ = json.Unmarshal(dataBytes, &actual)
+				assert.Equal(t, expected, actual)
+				assert.Equal(t, `"2"`, recorder.Header().Get("ETag"))
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"strconv"
+
+	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/adapter"
+)
+
+func parsePage(ctx adapter.APIContext) (model.Page, error) {
+	page := model.Page{Cursor: ctx.Query("cursor")}
+
+	limit := ctx.Query("limit")
+	if len(limit) != 0 {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			return model.Page{}, err
+		}
+		page.Limit = value
+	}
+
+	return page, nil
+}
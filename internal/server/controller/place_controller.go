@@ -2,9 +2,12 @@ package controller
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"locpack-backend/internal/server"
 	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/pkg/adapter"
@@ -22,124 +25,203 @@ func NewPlaceController(service service.PlaceService) server.PlaceController {
 
 // GetPlacesByQuery
 // @Summary Search places by query
-// @Description Get places matching name or address
+// @Description Get places matching name or address, ranked by relevance or, with sort=RATING, by average rating. Matched words are highlighted in name_highlight and address_highlight
 // @Tags Places
 // @Param query query string true "Search query"
+// @Param sort query string false "RELEVANCE (default) or RATING"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Page cursor"
 // @Success 200 {object} dto.ResponseWrapper{data=[]dto.Place}
 // @Failure 400 {object} dto.ResponseWrapper{data=[]dto.Place}
+// @Failure 422 {object} dto.ResponseWrapper
 // @Router /api/v1/places [get]
 func (c *placeControllerImpl) GetPlacesByQuery(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 
 	query := ctx.Query("query")
 	if len(query) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Data:   nil,
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Query is required")
 		return
 	}
 
-	places, err := c.service.GetByNameOrAddress(query, myUserID)
+	page, err := parsePage(ctx)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Data:   nil,
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Page parameters are invalid")
+		return
+	}
+
+	sort := strings.ToUpper(ctx.Query("sort"))
+
+	places, pageInfo, err := c.service.GetByNameOrAddress(ctx.Request.Context(), query, sort, myUserID, page)
+	if err != nil {
+		response.Error(ctx, err)
 		return
 	}
 
 	var placesDTOs []dto.Place
 	err = copier.Copy(&placesDTOs, &places)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Data:   nil,
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
-		Data:   placesDTOs,
-		Meta:   dto.Meta{Success: true},
+		Data: placesDTOs,
+		Meta: dto.Meta{
+			Success:    true,
+			NextCursor: pageInfo.NextCursor,
+			Total:      &pageInfo.Total,
+		},
 		Errors: nil,
 	})
 }
 
+// GetPlacesNearby
+// @Summary Search places nearby
+// @Description Get places within a radius around a point, sorted by distance
+// @Tags Places
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius query number true "Radius in meters"
+// @Success 200 {object} dto.ResponseWrapper{data=[]dto.Place}
+// @Failure 400 {object} dto.ResponseWrapper{data=[]dto.Place}
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/places/nearby [get]
+func (c *placeControllerImpl) GetPlacesNearby(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+
+	lat, latErr := strconv.ParseFloat(ctx.Query("lat"), 64)
+	lng, lngErr := strconv.ParseFloat(ctx.Query("lng"), 64)
+	radius, radiusErr := strconv.ParseFloat(ctx.Query("radius"), 64)
+	if latErr != nil || lngErr != nil || radiusErr != nil || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		response.BadRequest(ctx, "Coordinates are invalid")
+		return
+	}
+
+	places, err := c.service.GetNearby(ctx.Request.Context(), lat, lng, radius, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	var placesDTOs []dto.Place
+	err = copier.Copy(&placesDTOs, &places)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: placesDTOs,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// GetPlaceDuplicates
+// @Summary Find duplicates of a place
+// @Description Get existing places that are likely the same as a place to register, based on name and address similarity and, when coordinates are given, distance
+// @Tags Places
+// @Param name query string true "Place name"
+// @Param address query string false "Place address"
+// @Param lat query number false "Latitude"
+// @Param lng query number false "Longitude"
+// @Success 200 {object} dto.ResponseWrapper{data=[]dto.Place}
+// @Failure 400 {object} dto.ResponseWrapper{data=[]dto.Place}
+// @Router /api/v1/places/duplicates [get]
+func (c *placeControllerImpl) GetPlaceDuplicates(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+
+	placeCreate := model.PlaceCreate{
+		Name:    ctx.Query("name"),
+		Address: ctx.Query("address"),
+	}
+	if len(placeCreate.Name) == 0 {
+		response.BadRequest(ctx, "Name is required")
+		return
+	}
+
+	var latErr, lngErr error
+	if lat := ctx.Query("lat"); len(lat) != 0 {
+		var latitude float64
+		latitude, latErr = strconv.ParseFloat(lat, 64)
+		placeCreate.Latitude = &latitude
+	}
+	if lng := ctx.Query("lng"); len(lng) != 0 {
+		var longitude float64
+		longitude, lngErr = strconv.ParseFloat(lng, 64)
+		placeCreate.Longitude = &longitude
+	}
+	if latErr != nil || lngErr != nil {
+		response.BadRequest(ctx, "Coordinates are invalid")
+		return
+	}
+
+	places, err := c.service.GetDuplicates(ctx.Request.Context(), placeCreate, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	var placesDTOs []dto.Place
+	err = copier.Copy(&placesDTOs, &places)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: placesDTOs,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
 // PostPlace
 // @Summary Register a new place
-// @Description Add a new place to the database
+// @Description Add a new place to the database. Fails with possible_duplicate when similar places exist, see GET /api/v1/places/duplicates, unless ignore_duplicates is set
 // @Tags Places
 // @Security BearerAuth
 // @Param place body dto.PlaceCreate true "Place data"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.Place}
+// @Header 200 {string} ETag "Version of the place"
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.Place}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 409 {object} dto.ResponseWrapper
 // @Router /api/v1/places [post]
 func (c *placeControllerImpl) PostPlace(ctx adapter.APIContext) {
-	myUserID := ctx.GetString("userID")
+	myUserID := ctx.GetString("myUserID")
 	if len(myUserID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Data:   nil,
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, service.ErrUnauthenticated)
 		return
 	}
 
 	var placeCreateDTO dto.PlaceCreate
 	err := ctx.ShouldBindJSON(&placeCreateDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Data:   nil,
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Request body is invalid")
 		return
 	}
 
 	placeCreate := model.PlaceCreate{}
 	err = copier.Copy(&placeCreate, &placeCreateDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Data:   nil,
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
-	place, err := c.service.Create(myUserID, placeCreate)
+	place, err := c.service.Create(ctx.Request.Context(), myUserID, placeCreate)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Data:   nil,
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	placeDTO := dto.Place{}
 	err = copier.Copy(&placeDTO, &place)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Data:   nil,
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
+	setETag(ctx, place.Version)
 	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
 		Data:   placeDTO,
 		Meta:   dto.Meta{Success: true},
@@ -153,42 +235,33 @@ func (c *placeControllerImpl) PostPlace(ctx adapter.APIContext) {
 // @Tags Places
 // @Param id path string true "Place ID"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.Place}
+// @Header 200 {string} ETag "Version of the place"
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.Place}
+// @Failure 404 {object} dto.ResponseWrapper
 // @Router /api/v1/places/{id} [get]
 func (c *placeControllerImpl) GetPlaceByID(ctx adapter.APIContext) {
-	myUserID := ctx.GetString("userID")
+	myUserID := ctx.GetString("myUserID")
 
 	placeID := ctx.Param("id")
 	if len(placeID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Place ID is required")
 		return
 	}
 
-	place, err := c.service.GetByID(placeID, myUserID)
+	place, err := c.service.GetByID(ctx.Request.Context(), placeID, myUserID)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	placeDTO := dto.Place{}
 	err = copier.Copy(&placeDTO, &place)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
+	setETag(ctx, place.Version)
 	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
 		Data: placeDTO,
 		Meta: dto.Meta{Success: true},
@@ -197,78 +270,212 @@ func (c *placeControllerImpl) GetPlaceByID(ctx adapter.APIContext) {
 
 // PutPlaceByID
 // @Summary Update place by ID
-// @Description Update a specific place by its ID
+// @Description Update a specific place by its ID. Allowed only to its author, visits are tracked with POST /api/v1/places/{id}/visit and DELETE /api/v1/visits/{id}
 // @Tags Places
 // @Security BearerAuth
 // @Param id path string true "Place ID"
 // @Param place body dto.PlaceUpdate true "Place data"
+// @Param If-Match header string false "ETags of the versions to update"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.Place}
+// @Header 200 {string} ETag "Version of the place"
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.Place}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 412 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
 // @Router /api/v1/places/{id} [put]
 func (c *placeControllerImpl) PutPlaceByID(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 	if len(myUserID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, service.ErrUnauthenticated)
 		return
 	}
 
 	placeID := ctx.Param("id")
 	if len(placeID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Place ID is required")
 		return
 	}
 
 	var placeUpdateDTO dto.PlaceUpdate
 	err := ctx.ShouldBindJSON(&placeUpdateDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.BadRequest(ctx, "Request body is invalid")
 		return
 	}
 
 	placeUpdate := model.PlaceUpdate{}
 	err = copier.Copy(&placeUpdate, &placeUpdateDTO)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
+		return
+	}
+
+	placeUpdate.Versions, err = parseIfMatch(ctx)
+	if err != nil {
+		response.BadRequest(ctx, "If-Match header is invalid")
+		return
+	}
+
+	place, err := c.service.UpdateByID(ctx.Request.Context(), placeID, myUserID, placeUpdate)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	placeDTO := dto.Place{}
+	err = copier.Copy(&placeDTO, &place)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	setETag(ctx, place.Version)
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: placeDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// DeletePlaceByID
+// @Summary Delete place by ID
+// @Description Delete a specific place created by the current user. It can be restored during the retention period
+// @Tags Places
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id} [delete]
+func (c *placeControllerImpl) DeletePlaceByID(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	if len(placeID) == 0 {
+		response.BadRequest(ctx, "Place ID is required")
+		return
+	}
+
+	err := c.service.DeleteByID(ctx.Request.Context(), placeID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// RestorePlaceByID
+// @Summary Restore deleted place by ID
+// @Description Restore a place deleted by the current user during the retention period
+// @Tags Places
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Place}
+// @Header 200 {string} ETag "Version of the place"
+// @Failure 400 {object} dto.ResponseWrapper{data=dto.Place}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/restore [post]
+func (c *placeControllerImpl) RestorePlaceByID(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	if len(placeID) == 0 {
+		response.BadRequest(ctx, "Place ID is required")
+		return
+	}
+
+	place, err := c.service.RestoreByID(ctx.Request.Context(), placeID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	placeDTO := dto.Place{}
+	err = copier.Copy(&placeDTO, &place)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	setETag(ctx, place.Version)
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: placeDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// MergePlaceByID
+// @Summary Merge place into another place
+// @Description Merge a duplicate place created by the current user into another place. Packs and visitors of the place are moved to the other place and the place is deleted
+// @Tags Places
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param merge body dto.PlaceMerge true "Surviving place"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Place}
+// @Header 200 {string} ETag "Version of the place"
+// @Failure 400 {object} dto.ResponseWrapper{data=dto.Place}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/merge [post]
+func (c *placeControllerImpl) MergePlaceByID(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	if len(placeID) == 0 {
+		response.BadRequest(ctx, "Place ID is required")
+		return
+	}
+
+	var placeMergeDTO dto.PlaceMerge
+	err := ctx.ShouldBindJSON(&placeMergeDTO)
+	if err != nil {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	placeMerge := model.PlaceMerge{}
+	err = copier.Copy(&placeMerge, &placeMergeDTO)
+	if err != nil {
+		response.Error(ctx, err)
 		return
 	}
 
-	place, err := c.service.UpdateByID(placeID, myUserID, placeUpdate)
+	place, err := c.service.MergeByID(ctx.Request.Context(), placeID, myUserID, placeMerge)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	placeDTO := dto.Place{}
 	err = copier.Copy(&placeDTO, &place)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
+	setETag(ctx, place.Version)
 	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
 		Data: placeDTO,
 		Meta: dto.Meta{Success: true},
@@ -15,9 +15,12 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"slices"
 )
 
 func TestPlaceController_GetPlacesByQuery(t *testing.T) {
+	total := int64(1)
+
 	tests := []struct {
 		name           string
 		userID         string
@@ -32,7 +35,7 @@ func TestPlaceController_GetPlacesByQuery(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Query is required", Code: "bad_request"}},
 			},
 		},
 		{
@@ -42,28 +45,28 @@ func TestPlaceController_GetPlacesByQuery(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Query is required", Code: "bad_request"}},
 			},
 		},
 		{
 			name:   "service returns error",
 			userID: "user1",
 			query:  "test",
-			mockSetup: func(mock *service.MockPlaceService) {
-				mock.On("GetByNameOrAddress", "test", "user1").Return(nil, errors.New("service error"))
+			mockSetup: func(m *service.MockPlaceService) {
+				m.On("GetByNameOrAddress", mock.Anything, "test", "", "user1", model.Page{}).Return(nil, model.PageInfo{}, errors.New("service error"))
 			},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Internal error", Code: "internal_error"}},
 			},
 		},
 		{
 			name:   "success",
 			userID: "user1",
 			query:  "test",
-			mockSetup: func(mock *service.MockPlaceService) {
+			mockSetup: func(m *service.MockPlaceService) {
 				packs := []model.Place{
 					{
 						ID:   "place1",
@@ -71,7 +74,7 @@ func TestPlaceController_GetPlacesByQuery(t *testing.T) {
 					},
 				}
 
-				mock.On("GetByNameOrAddress", "test", "user1").Return(packs, nil)
+				m.On("GetByNameOrAddress", mock.Anything, "test", "", "user1", model.Page{}).Return(packs, model.PageInfo{Total: 1}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
@@ -81,7 +84,7 @@ func TestPlaceController_GetPlacesByQuery(t *testing.T) {
 						Name: "Test Place",
 					},
 				},
-				Meta:   dto.Meta{Success: true},
+				Meta:   dto.Meta{Success: true, Total: &total},
 				Errors: nil,
 			},
 		},
@@ -95,7 +98,7 @@ func TestPlaceController_GetPlacesByQuery(t *testing.T) {
 			}
 
 			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/places?query="+tt.query, nil)
-			ctx.Set("userID", tt.userID)
+			ctx.Set("myUserID", tt.userID)
 
 			controller := NewPlaceController(&mockService)
 
@@ -126,6 +129,220 @@ func TestPlaceController_GetPlacesByQuery(t *testing.T) {
 	}
 }
 
+func TestPlaceController_GetPlacesNearby(t *testing.T) {
+	t.Parallel()
+
+	lat, lng := 48.8606, 2.3376
+
+	tests := []struct {
+		name           string
+		url            string
+		mockSetup      func(*service.MockPlaceService)
+		expectedStatus int
+		expectedBody   dto.ResponseWrapper
+	}{
+		{
+			name:           "missing coordinates",
+			url:            "/api/v1/places/nearby?radius=500",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Coordinates are invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:           "latitude out of range",
+			url:            "/api/v1/places/nearby?lat=91&lng=2.3376&radius=500",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Coordinates are invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name: "service returns error",
+			url:  "/api/v1/places/nearby?lat=48.8606&lng=2.3376&radius=500",
+			mockSetup: func(m *service.MockPlaceService) {
+				m.On("GetNearby", mock.Anything, 48.8606, 2.3376, float64(500), "user1").Return(nil, service.ErrInvalidRadius)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Radius is out of range", Code: "invalid_radius"}},
+			},
+		},
+		{
+			name: "success",
+			url:  "/api/v1/places/nearby?lat=48.8606&lng=2.3376&radius=500",
+			mockSetup: func(m *service.MockPlaceService) {
+				places := []model.Place{
+					{
+						ID:        "place1",
+						Name:      "Louvre",
+						Latitude:  &lat,
+						Longitude: &lng,
+						Distance:  12.5,
+					},
+				}
+
+				m.On("GetNearby", mock.Anything, 48.8606, 2.3376, float64(500), "user1").Return(places, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: []dto.Place{
+					{
+						ID:        "place1",
+						Name:      "Louvre",
+						Latitude:  &lat,
+						Longitude: &lng,
+						Distance:  12.5,
+					},
+				},
+				Meta:   dto.Meta{Success: true},
+				Errors: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mockService service.MockPlaceService
+			if tt.mockSetup != nil {
+				tt.mockSetup(&mockService)
+			}
+
+			ctx, recorder := setupControllerTest(t, http.MethodGet, tt.url, nil)
+			ctx.Set("myUserID", "user1")
+
+			controller := NewPlaceController(&mockService)
+
+			controller.GetPlacesNearby(ctx)
+
+			var body dto.ResponseWrapper
+			assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+
+			if tt.expectedBody.Data != nil {
+				dataBytes, err := json.Marshal(body.Data)
+				assert.NoError(t, err)
+
+				var actualPlaces []dto.Place
+				err = json.Unmarshal(dataBytes, &actualPlaces)
+				assert.NoError(t, err)
+
+				assert.Equal(t, tt.expectedBody.Data, actualPlaces)
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlaceController_GetPlaceDuplicates(t *testing.T) {
+	t.Parallel()
+
+	lat, lng := 48.8606, 2.3376
+
+	tests := []struct {
+		name           string
+		url            string
+		mockSetup      func(*service.MockPlaceService)
+		expectedStatus int
+		expectedBody   dto.ResponseWrapper
+	}{
+		{
+			name:           "missing name",
+			url:            "/api/v1/places/duplicates?address=Rue+de+Rivoli",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Name is required", Code: "bad_request"}},
+			},
+		},
+		{
+			name:           "invalid coordinates",
+			url:            "/api/v1/places/duplicates?name=Louvre&lat=abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Coordinates are invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name: "success without coordinates",
+			url:  "/api/v1/places/duplicates?name=Louvre&address=Rue+de+Rivoli",
+			mockSetup: func(m *service.MockPlaceService) {
+				pc := model.PlaceCreate{Name: "Louvre", Address: "Rue de Rivoli"}
+				m.On("GetDuplicates", mock.Anything, pc, "user1").Return([]model.Place{{ID: "place1", Name: "Louvre Museum"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: []dto.Place{{ID: "place1", Name: "Louvre Museum"}},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+		{
+			name: "success with coordinates",
+			url:  "/api/v1/places/duplicates?name=Louvre&lat=48.8606&lng=2.3376",
+			mockSetup: func(m *service.MockPlaceService) {
+				pc := model.PlaceCreate{Name: "Louvre", Latitude: &lat, Longitude: &lng}
+				m.On("GetDuplicates", mock.Anything, pc, "user1").Return([]model.Place{{ID: "place1", Name: "Louvre Museum"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: []dto.Place{{ID: "place1", Name: "Louvre Museum"}},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mockService service.MockPlaceService
+			if tt.mockSetup != nil {
+				tt.mockSetup(&mockService)
+			}
+
+			ctx, recorder := setupControllerTest(t, http.MethodGet, tt.url, nil)
+			ctx.Set("myUserID", "user1")
+
+			controller := NewPlaceController(&mockService)
+
+			controller.GetPlaceDuplicates(ctx)
+
+			var body dto.ResponseWrapper
+			assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+
+			if tt.expectedBody.Data != nil {
+				dataBytes, err := json.Marshal(body.Data)
+				assert.NoError(t, err)
+
+				var actualPlaces []dto.Place
+				err = json.Unmarshal(dataBytes, &actualPlaces)
+				assert.NoError(t, err)
+
+				assert.Equal(t, tt.expectedBody.Data, actualPlaces)
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
 func TestPlaceController_PostPlace(t *testing.T) {
 	t.Parallel()
 
@@ -146,11 +363,11 @@ func TestPlaceController_PostPlace(t *testing.T) {
 			name:         "missing userID",
 			userID:       "",
 			requestBody:  validInput,
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusUnauthorized,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
 			},
 		},
 		{
@@ -161,7 +378,7 @@ func TestPlaceController_PostPlace(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
 			},
 		},
 		{
@@ -169,13 +386,27 @@ func TestPlaceController_PostPlace(t *testing.T) {
 			userID:      "123",
 			requestBody: validInput,
 			mockSetup: func(s *service.MockPlaceService) {
-				s.On("Register", "123", mock.Anything).Return(model.Place{}, errors.New("service error"))
+				s.On("Create", mock.Anything, "123", mock.Anything).Return(model.Place{}, errors.New("service error"))
 			},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Internal error", Code: "internal_error"}},
+			},
+		},
+		{
+			name:        "possible duplicate",
+			userID:      "123",
+			requestBody: validInput,
+			mockSetup: func(s *service.MockPlaceService) {
+				s.On("Create", mock.Anything, "123", mock.Anything).Return(model.Place{}, service.ErrPossibleDuplicate)
+			},
+			expectedCode: http.StatusConflict,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Similar places already exist", Code: "possible_duplicate"}},
 			},
 		},
 		{
@@ -183,7 +414,7 @@ func TestPlaceController_PostPlace(t *testing.T) {
 			userID:      "123",
 			requestBody: validInput,
 			mockSetup: func(s *service.MockPlaceService) {
-				s.On("Register", "123", mock.Anything).Return(model.Place{Name: "Test Place"}, nil)
+				s.On("Create", mock.Anything, "123", mock.Anything).Return(model.Place{Name: "Test Place"}, nil)
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
@@ -211,7 +442,7 @@ func TestPlaceController_PostPlace(t *testing.T) {
 			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/places", requestBody)
 
 			if tt.userID != "" {
-				ctx.Set("userID", tt.userID)
+				ctx.Set("myUserID", tt.userID)
 			}
 
 			if tt.overrideBindJSON {
@@ -261,14 +492,16 @@ func TestPlaceController_GetPlaceByID(t *testing.T) {
 		expectedCode int
 	}{
 		{
-			name:         "missing userID",
-			userID:       "",
-			placeID:      "456",
-			expectedCode: http.StatusBadRequest,
+			name:    "anonymous user",
+			userID:  "",
+			placeID: "456",
+			mockSetup: func(s *service.MockPlaceService) {
+				s.On("GetByID", mock.Anything, "456", "").Return(model.Place{Name: "My Place"}, nil)
+			},
+			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
-				Data:   nil,
-				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Data: dto.Place{Name: "My Place"},
+				Meta: dto.Meta{Success: true},
 			},
 		},
 		{
@@ -279,7 +512,7 @@ func TestPlaceController_GetPlaceByID(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Place ID is required", Code: "bad_request"}},
 			},
 		},
 		{
@@ -287,13 +520,13 @@ func TestPlaceController_GetPlaceByID(t *testing.T) {
 			userID:  "123",
 			placeID: "456",
 			mockSetup: func(s *service.MockPlaceService) {
-				s.On("GetByID", "456", "123").Return(model.Place{}, errors.New("service error"))
+				s.On("GetByID", mock.Anything, "456", "123").Return(model.Place{}, service.ErrPlaceNotFound)
 			},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusNotFound,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Place not found", Code: "place_not_found"}},
 			},
 		},
 		{
@@ -301,7 +534,7 @@ func TestPlaceController_GetPlaceByID(t *testing.T) {
 			userID:  "123",
 			placeID: "456",
 			mockSetup: func(s *service.MockPlaceService) {
-				s.On("GetByID", "456", "123").Return(model.Place{Name: "My Place"}, nil)
+				s.On("GetByID", mock.Anything, "456", "123").Return(model.Place{Name: "My Place"}, nil)
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
@@ -319,7 +552,7 @@ func TestPlaceController_GetPlaceByID(t *testing.T) {
 			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/places/"+tt.placeID, nil)
 
 			if tt.userID != "" {
-				ctx.Set("userID", tt.userID)
+				ctx.Set("myUserID", tt.userID)
 			}
 			if tt.placeID != "" {
 				ctx.Params = gin.Params{gin.Param{Key: "id", Value: tt.placeID}}
@@ -356,14 +589,18 @@ func TestPlaceController_GetPlaceByID(t *testing.T) {
 func TestPlaceController_PutPlaceByID(t *testing.T) {
 	t.Parallel()
 
+	version := int64(3)
+
 	testCases := []struct {
 		name             string
 		userID           string
 		placeID          string
+		ifMatch          string
 		requestBody      any
 		mockSetup        func(s *service.MockPlaceService)
 		expectedCode     int
 		expectedBody     dto.ResponseWrapper
+		expectedETag     string
 		overrideBindJSON bool
 	}{
 		{
@@ -371,11 +608,11 @@ func TestPlaceController_PutPlaceByID(t *testing.T) {
 			userID:       "",
 			placeID:      "123",
 			requestBody:  dto.PlaceUpdate{Name: "Updated"},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusUnauthorized,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
 			},
 		},
 		{
@@ -387,7 +624,7 @@ func TestPlaceController_PutPlaceByID(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Place ID is required", Code: "bad_request"}},
 			},
 		},
 		{
@@ -399,7 +636,7 @@ func TestPlaceController_PutPlaceByID(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
 			},
 		},
 		{
@@ -408,13 +645,13 @@ func TestPlaceController_PutPlaceByID(t *testing.T) {
 			placeID:     "123",
 			requestBody: dto.PackUpdate{Name: "Updated"},
 			mockSetup: func(s *service.MockPlaceService) {
-				s.On("UpdateByID", "123", "456", mock.Anything).Return(model.Place{}, errors.New("update failed"))
+				s.On("UpdateByID", mock.Anything, "123", "456", mock.Anything).Return(model.Place{}, service.ErrNotAuthor)
 			},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusForbidden,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "User is not author", Code: "not_author"}},
 			},
 		},
 		{
@@ -423,13 +660,59 @@ func TestPlaceController_PutPlaceByID(t *testing.T) {
 			placeID:     "123",
 			requestBody: dto.PackUpdate{Name: "Updated"},
 			mockSetup: func(s *service.MockPlaceService) {
-				s.On("UpdateByID", "123", "456", mock.Anything).Return(model.Place{Name: "Updated"}, nil)
+				s.On("UpdateByID", mock.Anything, "123", "456", mock.Anything).Return(model.Place{Name: "Updated"}, nil)
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
 				Data: dto.Place{Name: "Updated"},
 				Meta: dto.Meta{Success: true},
 			},
+			expectedETag: `"0"`,
+		},
+		{
+			name:         "invalid If-Match",
+			userID:       "456",
+			placeID:      "123",
+			ifMatch:      `W/"3"`,
+			requestBody:  dto.PlaceUpdate{Name: "Updated"},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "If-Match header is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:        "version mismatch",
+			userID:      "456",
+			placeID:     "123",
+			ifMatch:     `"3"`,
+			requestBody: dto.PlaceUpdate{Name: "Updated"},
+			mockSetup: func(s *service.MockPlaceService) {
+				s.On("UpdateByID", mock.Anything, "123", "456", mock.MatchedBy(func(pu model.PlaceUpdate) bool { return slices.Equal(pu.Versions, []int64{version}) })).
+					Return(model.Place{}, service.ErrVersionMismatch)
+			},
+			expectedCode: http.StatusPreconditionFailed,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Resource was changed by another request", Code: "version_mismatch"}},
+			},
+		},
+		{
+			name:        "success with If-Match",
+			userID:      "456",
+			placeID:     "123",
+			ifMatch:     `"3"`,
+			requestBody: dto.PlaceUpdate{Name: "Updated"},
+			mockSetup: func(s *service.MockPlaceService) {
+				s.On("UpdateByID", mock.Anything, "123", "456", mock.MatchedBy(func(pu model.PlaceUpdate) bool { return slices.Equal(pu.Versions, []int64{version}) })).
+					Return(model.Place{Name: "Updated", Version: 4}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: dto.Place{Name: "Updated"},
+				Meta: dto.Meta{Success: true},
+			},
+			expectedETag: `"4"`,
 		},
 	}
 
@@ -451,8 +734,11 @@ func TestPlaceController_PutPlaceByID(t *testing.T) {
 				ctx.Request.Body = io.NopCloser(bytes.NewBuffer([]byte("{invalid-json")))
 			}
 
+			if tt.ifMatch != "" {
+				ctx.Request.Header.Set("If-Match", tt.ifMatch)
+			}
 			if tt.userID != "" {
-				ctx.Set("userID", tt.userID)
+				ctx.Set("myUserID", tt.userID)
 			}
 			if tt.placeID != "" {
 				ctx.Params = gin.Params{gin.Param{Key: "id", Value: tt.placeID}}
@@ -477,6 +763,200 @@ func TestPlaceController_PutPlaceByID(t *testing.T) {
 				assert.Nil(t, body.Data)
 			}
 
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			assert.Equal(t, tt.expectedETag, recorder.Header().Get("ETag"))
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlaceController_RestorePlaceByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		userID       string
+		placeID      string
+		mockSetup    func(s *service.MockPlaceService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing userID",
+			userID:       "",
+			placeID:      "456",
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:    "service error",
+			userID:  "123",
+			placeID: "456",
+			mockSetup: func(s *service.MockPlaceService) {
+				s.On("RestoreByID", mock.Anything, "456", "123").Return(model.Place{}, service.ErrPlaceNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: dto.ResponseWrapper{
+				Data:   nil,
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Place not found", Code: "place_not_found"}},
+			},
+		},
+		{
+			name:    "success",
+			userID:  "123",
+			placeID: "456",
+			mockSetup: func(s *service.MockPlaceService) {
+				s.On("RestoreByID", mock.Anything, "456", "123").Return(model.Place{Name: "Restored"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: dto.Place{Name: "Restored"},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockPlaceService)
+			controller := NewPlaceController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/places/"+tt.placeID+"/restore", nil)
+
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			if tt.placeID != "" {
+				ctx.Params = gin.Params{gin.Param{Key: "id", Value: tt.placeID}}
+			}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.RestorePlaceByID(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			if tt.expectedBody.Data != nil {
+				expected := tt.expectedBody.Data.(dto.Place)
+				actualBytes, _ := json.Marshal(body.Data)
+				var actual dto.Place
+				_ = json.Unmarshal(actualBytes, &actual)
+				assert.Equal(t, expected, actual)
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlaceController_MergePlaceByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		userID       string
+		body         string
+		mockSetup    func(s *service.MockPlaceService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing userID",
+			body:         `{"into_id":"place456"}`,
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "missing surviving place",
+			userID:       "123",
+			body:         `{}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:   "merge into itself",
+			userID: "123",
+			body:   `{"into_id":"place123"}`,
+			mockSetup: func(s *service.MockPlaceService) {
+				s.On("MergeByID", mock.Anything, "place123", "123", model.PlaceMerge{IntoID: "place123"}).Return(model.Place{}, service.ErrSelfMerge)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Place cannot be merged into itself", Code: "self_merge"}},
+			},
+		},
+		{
+			name:   "success",
+			userID: "123",
+			body:   `{"into_id":"place456"}`,
+			mockSetup: func(s *service.MockPlaceService) {
+				s.On("MergeByID", mock.Anything, "place123", "123", model.PlaceMerge{IntoID: "place456"}).Return(model.Place{ID: "place456", Name: "Louvre"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Data: dto.Place{ID: "place456", Name: "Louvre"},
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockPlaceService)
+			controller := NewPlaceController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/places/place123/merge", nil)
+			ctx.Request.Body = io.NopCloser(bytes.NewBufferString(tt.body))
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "place123"}}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.MergePlaceByID(ctx)
+
+			var body dto.ResponseWrapper
+			assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+
+			if tt.expectedBody.Data != nil {
+				dataBytes, err := json.Marshal(body.Data)
+				assert.NoError(t, err)
+
+				var actualPlace dto.Place
+				err = json.Unmarshal(dataBytes, &actualPlace)
+				assert.NoError(t, err)
+
+				assert.Equal(t, tt.expectedBody.Data.(dto.Place).Name, actualPlace.Name)
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
 			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
 			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
 			assert.Equal(t, tt.expectedCode, recorder.Code)
@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"net/http"
+
+	"locpack-backend/internal/server"
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/adapter"
+
+	"github.com/jinzhu/copier"
+)
+
+type reviewControllerImpl struct {
+	service service.ReviewService
+}
+
+func NewReviewController(service service.ReviewService) server.ReviewController {
+	return &reviewControllerImpl{service}
+}
+
+// GetPlaceReviews
+// @Summary Get reviews of place
+// @Description Get the reviews of a place, newest first
+// @Tags Reviews
+// @Param id path string true "Place ID"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Page cursor"
+// @Success 200 {object} dto.ResponseWrapper{data=[]dto.Review}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/reviews [get]
+func (c *reviewControllerImpl) GetPlaceReviews(ctx adapter.APIContext) {
+	placeID := ctx.Param("id")
+	if len(placeID) == 0 {
+		response.BadRequest(ctx, "Place ID is required")
+		return
+	}
+
+	page, err := parsePage(ctx)
+	if err != nil {
+		response.BadRequest(ctx, "Page parameters are invalid")
+		return
+	}
+
+	reviews, pageInfo, err := c.service.GetByPlaceID(ctx.Request.Context(), placeID, page)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	reviewsDTOs := []dto.Review{}
+	err = copier.Copy(&reviewsDTOs, &reviews)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: reviewsDTOs,
+		Meta: dto.Meta{
+			Success:    true,
+			NextCursor: pageInfo.NextCursor,
+			Total:      &pageInfo.Total,
+		},
+	})
+}
+
+// PostPlaceReview
+// @Summary Review place
+// @Description Rate a place from 1 to 5 with an optional text of at most 2000 characters. Users can review a place once
+// @Tags Reviews
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param review body dto.ReviewCreate true "Review data"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Review}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 409 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/reviews [post]
+func (c *reviewControllerImpl) PostPlaceReview(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	if len(placeID) == 0 {
+		response.BadRequest(ctx, "Place ID is required")
+		return
+	}
+
+	var reviewCreateDTO dto.ReviewCreate
+	err := ctx.ShouldBindJSON(&reviewCreateDTO)
+	if err != nil {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	reviewCreate := model.ReviewCreate{}
+	err = copier.Copy(&reviewCreate, &reviewCreateDTO)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	review, err := c.service.Create(ctx.Request.Context(), placeID, myUserID, reviewCreate)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	reviewDTO := dto.Review{}
+	err = copier.Copy(&reviewDTO, &review)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: reviewDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// PutPlaceReview
+// @Summary Update review of place
+// @Description Replace the rating and the text of a review. Allowed only to its author
+// @Tags Reviews
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param reviewId path string true "Review ID"
+// @Param review body dto.ReviewUpdate true "Review data"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Review}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/reviews/{reviewId} [put]
+func (c *reviewControllerImpl) PutPlaceReview(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	reviewID := ctx.Param("reviewId")
+	if len(placeID) == 0 || len(reviewID) == 0 {
+		response.BadRequest(ctx, "Place ID and review ID are required")
+		return
+	}
+
+	var reviewUpdateDTO dto.ReviewUpdate
+	err := ctx.ShouldBindJSON(&reviewUpdateDTO)
+	if err != nil {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	reviewUpdate := model.ReviewUpdate{}
+	err = copier.Copy(&reviewUpdate, &reviewUpdateDTO)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	review, err := c.service.UpdateByID(ctx.Request.Context(), placeID, reviewID, myUserID, reviewUpdate)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	reviewDTO := dto.Review{}
+	err = copier.Copy(&reviewDTO, &review)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: reviewDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// DeletePlaceReview
+// @Summary Delete review of place
+// @Description Delete a review. Allowed only to its author, moderators use DELETE /api/v1/admin/reviews/{id}
+// @Tags Reviews
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param reviewId path string true "Review ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 403 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/reviews/{reviewId} [delete]
+func (c *reviewControllerImpl) DeletePlaceReview(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	reviewID := ctx.Param("reviewId")
+	if len(placeID) == 0 || len(reviewID) == 0 {
+		response.BadRequest(ctx, "Place ID and review ID are required")
+		return
+	}
+
+	err := c.service.DeleteByID(ctx.Request.Context(), placeID, reviewID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
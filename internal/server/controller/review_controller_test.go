@@ -0,0 +1,213 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReviewController_GetPlaceReviews(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		mockSetup    func(s *service.MockReviewService)
+		expectedLen  int
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name: "place not found",
+			mockSetup: func(s *service.MockReviewService) {
+				s.On("GetByPlaceID", mock.Anything, "place1", model.Page{}).Return(nil, model.PageInfo{}, service.ErrPlaceNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Place not found", Code: "place_not_found"}},
+			},
+		},
+		{
+			name: "success",
+			mockSetup: func(s *service.MockReviewService) {
+				reviews := []model.Review{{ID: "review1", Author: model.User{ID: "user1"}, Rating: 4, Text: "Nice"}}
+				s.On("GetByPlaceID", mock.Anything, "place1", model.Page{}).Return(reviews, model.PageInfo{Total: 1}, nil)
+			},
+			expectedLen:  1,
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true, Total: func() *int64 { total := int64(1); return &total }()},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockReviewService)
+			controller := NewReviewController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/places/place1/reviews", nil)
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "place1"}}
+			tt.mockSetup(mockService)
+
+			controller.GetPlaceReviews(ctx)
+
+			var body struct {
+				Data   []dto.Review `json:"data"`
+				Meta   dto.Meta     `json:"meta"`
+				Errors []dto.Error  `json:"errors"`
+			}
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Len(t, body.Data, tt.expectedLen)
+			if tt.expectedLen != 0 {
+				assert.Equal(t, "review1", body.Data[0].ID)
+				assert.Equal(t, "user1", body.Data[0].Author.ID)
+			}
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewController_PostPlaceReview(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		userID       string
+		body         string
+		mockSetup    func(s *service.MockReviewService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "missing userID",
+			body:         `{"rating":5}`,
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "invalid body",
+			userID:       "user1",
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:   "invalid rating",
+			userID: "user1",
+			body:   `{"rating":6}`,
+			mockSetup: func(s *service.MockReviewService) {
+				s.On("Create", mock.Anything, "place1", "user1", model.ReviewCreate{Rating: 6}).Return(model.Review{}, service.ErrInvalidRating)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Rating must be between 1 and 5", Code: "invalid_rating"}},
+			},
+		},
+		{
+			name:   "already reviewed",
+			userID: "user1",
+			body:   `{"rating":5,"text":"Again"}`,
+			mockSetup: func(s *service.MockReviewService) {
+				s.On("Create", mock.Anything, "place1", "user1", model.ReviewCreate{Rating: 5, Text: "Again"}).
+					Return(model.Review{}, service.ErrReviewExists.Wrap(errors.New("duplicate key")))
+			},
+			expectedCode: http.StatusConflict,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "User has already reviewed this place", Code: "review_exists"}},
+			},
+		},
+		{
+			name:   "success",
+			userID: "user1",
+			body:   `{"rating":5,"text":"Great"}`,
+			mockSetup: func(s *service.MockReviewService) {
+				s.On("Create", mock.Anything, "place1", "user1", model.ReviewCreate{Rating: 5, Text: "Great"}).
+					Return(model.Review{ID: "review1", Rating: 5, Text: "Great"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockReviewService)
+			controller := NewReviewController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/places/place1/reviews", nil)
+			ctx.Request.Body = io.NopCloser(strings.NewReader(tt.body))
+
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "place1"}}
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			controller.PostPlaceReview(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewController_PutPlaceReview(t *testing.T) {
+	t.Parallel()
+
+	mockService := new(service.MockReviewService)
+	controller := NewReviewController(mockService)
+
+	ctx, recorder := setupControllerTest(t, http.MethodPut, "/api/v1/places/place1/reviews/review1", nil)
+	ctx.Request.Body = io.NopCloser(strings.NewReader(`{"rating":2}`))
+	ctx.Set("myUserID", "user2")
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "place1"}, gin.Param{Key: "reviewId", Value: "review1"}}
+	mockService.On("UpdateByID", mock.Anything, "place1", "review1", "user2", model.ReviewUpdate{Rating: 2}).
+		Return(model.Review{}, service.ErrNotAuthor)
+
+	controller.PutPlaceReview(ctx)
+
+	var body dto.ResponseWrapper
+	err := json.NewDecoder(recorder.Body).Decode(&body)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []dto.Error{{Message: "User is not author", Code: "not_author"}}, body.Errors)
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	mockService.AssertExpectations(t)
+}
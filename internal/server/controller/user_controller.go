@@ -1,12 +1,15 @@
 package controller
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/jinzhu/copier"
 	"locpack-backend/internal/server"
 	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
 	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
 	"locpack-backend/pkg/adapter"
 )
 
@@ -20,41 +23,83 @@ func NewUserController(service service.UserService) server.UserController {
 
 // GetUserMy
 // @Summary Get current user info
-// @Description Get information about the currently authenticated user
+// @Description Get information about the currently authenticated user, including profile and stats
 // @Tags Users
 // @Security BearerAuth
 // @Success 200 {object} dto.ResponseWrapper{data=dto.User}
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.User}
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
 // @Router /api/v1/users/my [get]
 func (c *userControllerImpl) GetUserMy(ctx adapter.APIContext) {
 	myUserID := ctx.GetString("myUserID")
 	if len(myUserID) == 0 {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, service.ErrUnauthenticated)
 		return
 	}
 
-	user, err := c.service.GetByID(myUserID)
+	user, err := c.service.GetByID(ctx.Request.Context(), myUserID)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	userDTO := dto.User{}
 	err = copier.Copy(&userDTO, &user)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: userDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// PutUserMy
+// @Summary Update current user profile
+// @Description Replace the display name, bio, home city and avatar URL of the currently authenticated user. Empty fields are cleared
+// @Tags Users
+// @Security BearerAuth
+// @Param user body dto.UserUpdate true "Profile data"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.User}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/users/my [put]
+func (c *userControllerImpl) PutUserMy(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	var userUpdateDTO dto.UserUpdate
+	err := ctx.ShouldBindJSON(&userUpdateDTO)
+	if err != nil {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	userUpdate := model.UserUpdate{}
+	err = copier.Copy(&userUpdate, &userUpdateDTO)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	user, err := c.service.UpdateByID(ctx.Request.Context(), myUserID, userUpdate)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	userDTO := dto.User{}
+	err = copier.Copy(&userDTO, &user)
+	if err != nil {
+		response.Error(ctx, err)
 		return
 	}
 
@@ -66,33 +111,26 @@ func (c *userControllerImpl) GetUserMy(ctx adapter.APIContext) {
 
 // GetUserByID
 // @Summary Get user by ID
-// @Description Get information about any user by their ID
+// @Description Get information about any user by their ID, including profile and stats
 // @Tags Users
 // @Param id path string true "User ID"
 // @Success 200 {object} dto.ResponseWrapper{data=dto.User}
 // @Failure 400 {object} dto.ResponseWrapper{data=dto.User}
+// @Failure 404 {object} dto.ResponseWrapper
 // @Router /api/v1/users/{id} [get]
 func (c *userControllerImpl) GetUserByID(ctx adapter.APIContext) {
 	userID := ctx.Param("id")
 
-	user, err := c.service.GetByID(userID)
+	user, err := c.service.GetByID(ctx.Request.Context(), userID)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
 	userDTO := dto.User{}
 	err = copier.Copy(&userDTO, &user)
 	if err != nil {
-		errors := []dto.Error{{Message: "Some error", Code: "000"}}
-		ctx.JSON(http.StatusBadRequest, dto.ResponseWrapper{
-			Meta:   dto.Meta{Success: false},
-			Errors: errors,
-		})
+		response.Error(ctx, err)
 		return
 	}
 
@@ -101,3 +139,110 @@ func (c *userControllerImpl) GetUserByID(ctx adapter.APIContext) {
 		Meta: dto.Meta{Success: true},
 	})
 }
+
+// FollowUserByID
+// @Summary Follow user by ID
+// @Description Follow a user to see their activities in the feed. Following a user twice has no effect
+// @Tags Users
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/users/{id}/follow [post]
+func (c *userControllerImpl) FollowUserByID(ctx adapter.APIContext) {
+	c.follow(ctx, c.service.Follow)
+}
+
+// UnfollowUserByID
+// @Summary Unfollow user by ID
+// @Description Stop following a user
+// @Tags Users
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/users/{id}/follow [delete]
+func (c *userControllerImpl) UnfollowUserByID(ctx adapter.APIContext) {
+	c.follow(ctx, c.service.Unfollow)
+}
+
+// GetFeed
+// @Summary Get activity feed
+// @Description Get packs created, places added to packs and places visited by the users followed by the current user, newest first
+// @Tags Users
+// @Security BearerAuth
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Page cursor"
+// @Success 200 {object} dto.ResponseWrapper{data=[]dto.Activity}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/feed [get]
+func (c *userControllerImpl) GetFeed(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	page, err := parsePage(ctx)
+	if err != nil {
+		response.BadRequest(ctx, "Page parameters are invalid")
+		return
+	}
+
+	activities, pageInfo, err := c.service.GetFeed(ctx.Request.Context(), myUserID, page)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	activitiesDTOs := []dto.Activity{}
+	err = copier.Copy(&activitiesDTOs, &activities)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: activitiesDTOs,
+		Meta: dto.Meta{
+			Success:    true,
+			NextCursor: pageInfo.NextCursor,
+			Total:      &pageInfo.Total,
+		},
+	})
+}
+
+func (c *userControllerImpl) follow(
+	ctx adapter.APIContext,
+	follow func(ctx context.Context, id string, userID string) error,
+) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	userID := ctx.Param("id")
+	if len(userID) == 0 {
+		response.BadRequest(ctx, "User ID is required")
+		return
+	}
+
+	err := follow(ctx.Request.Context(), userID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
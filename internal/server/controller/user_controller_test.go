@@ -7,11 +7,14 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"locpack-backend/internal/server/dto"
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/enum/activity_type"
 
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -28,35 +31,35 @@ func TestUserController_GetUserMy(t *testing.T) {
 	}{
 		{
 			name:           "missing user id in context",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusUnauthorized,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
 			},
 		},
 		{
 			name:   "service returns error",
 			userID: "user1",
-			mockSetup: func(mock *service.MockUserService) {
-				mock.On("GetByID", "user1").Return(model.User{}, errors.New("service error"))
+			mockSetup: func(m *service.MockUserService) {
+				m.On("GetByID", mock.Anything, "user1").Return(model.User{}, errors.New("service error"))
 			},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Internal error", Code: "internal_error"}},
 			},
 		},
 		{
 			name:   "success",
 			userID: "user1",
-			mockSetup: func(mock *service.MockUserService) {
+			mockSetup: func(m *service.MockUserService) {
 				user := model.User{
 					ID: "pack1",
 				}
 
-				mock.On("GetByID", "user1").Return(user, nil)
+				m.On("GetByID", mock.Anything, "user1").Return(user, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
@@ -77,7 +80,7 @@ func TestUserController_GetUserMy(t *testing.T) {
 			}
 
 			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/users/my", nil)
-			ctx.Set("userID", tt.userID)
+			ctx.Set("myUserID", tt.userID)
 
 			controller := NewUserController(&mockService)
 
@@ -122,13 +125,13 @@ func TestUserController_GetUserByID(t *testing.T) {
 			name:   "service returns error",
 			userID: "user1",
 			mockSetup: func(m *service.MockUserService) {
-				m.On("GetByID", mock.Anything).Return(model.User{}, errors.New("service error"))
+				m.On("GetByID", mock.Anything, mock.Anything).Return(model.User{}, service.ErrUserNotFound)
 			},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusNotFound,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "User not found", Code: "user_not_found"}},
 			},
 		},
 		{
@@ -136,15 +139,17 @@ func TestUserController_GetUserByID(t *testing.T) {
 			userID: "user1",
 			mockSetup: func(m *service.MockUserService) {
 				user := model.User{
-					ID: "pack1",
+					ID:    "pack1",
+					Stats: &model.UserStats{Followers: 3, Following: 1, Countries: 2, UnknownCountryPlaces: 1},
 				}
 
-				m.On("GetByID", mock.Anything).Return(user, nil)
+				m.On("GetByID", mock.Anything, mock.Anything).Return(user, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
 				Data: dto.User{
-					ID: "pack1",
+					ID:    "pack1",
+					Stats: &dto.UserStats{Followers: 3, Following: 1, Countries: 2, UnknownCountryPlaces: 1},
 				},
 				Meta:   dto.Meta{Success: true},
 				Errors: nil,
@@ -160,7 +165,7 @@ func TestUserController_GetUserByID(t *testing.T) {
 			}
 
 			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/users/"+tt.userID, nil)
-			ctx.Set("userID", tt.userID)
+			ctx.Set("myUserID", tt.userID)
 
 			controller := NewUserController(&mockService)
 
@@ -191,7 +196,7 @@ func TestUserController_GetUserByID(t *testing.T) {
 	}
 }
 
-func TestUserController_PutUserByID(t *testing.T) {
+func TestUserController_PutUserMy(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -211,33 +216,33 @@ func TestUserController_PutUserByID(t *testing.T) {
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
 			},
 		},
 		{
 			name:        "service error",
 			userID:      "456",
-			requestBody: dto.UserUpdate{Username: "Updated"},
+			requestBody: dto.UserUpdate{DisplayName: "Updated"},
 			mockSetup: func(s *service.MockUserService) {
-				s.On("UpdateByID", mock.Anything, mock.Anything).Return(model.User{}, errors.New("update failed"))
+				s.On("UpdateByID", mock.Anything, "456", mock.Anything).Return(model.User{}, errors.New("update failed"))
 			},
-			expectedCode: http.StatusBadRequest,
+			expectedCode: http.StatusInternalServerError,
 			expectedBody: dto.ResponseWrapper{
 				Data:   nil,
 				Meta:   dto.Meta{Success: false},
-				Errors: []dto.Error{{Message: "Some error", Code: "000"}},
+				Errors: []dto.Error{{Message: "Internal error", Code: "internal_error"}},
 			},
 		},
 		{
 			name:        "success",
 			userID:      "456",
-			requestBody: dto.UserUpdate{Username: "Updated"},
+			requestBody: dto.UserUpdate{DisplayName: "Updated"},
 			mockSetup: func(s *service.MockUserService) {
-				s.On("UpdateByID", mock.Anything, mock.Anything).Return(model.User{Username: "Updated"}, nil)
+				s.On("UpdateByID", mock.Anything, "456", mock.Anything).Return(model.User{ID: "456", Username: "user", DisplayName: "Updated"}, nil)
 			},
 			expectedCode: http.StatusOK,
 			expectedBody: dto.ResponseWrapper{
-				Data: dto.User{Username: "Updated"},
+				Data: dto.User{ID: "456", Username: "user", DisplayName: "Updated"},
 				Meta: dto.Meta{Success: true},
 			},
 		},
@@ -255,20 +260,20 @@ func TestUserController_PutUserByID(t *testing.T) {
 				requestBody = bytes.NewBuffer(bodyBytes)
 			}
 
-			ctx, recorder := setupControllerTest(t, http.MethodPut, "/api/v1/users/"+tt.userID, requestBody)
+			ctx, recorder := setupControllerTest(t, http.MethodPut, "/api/v1/users/my", requestBody)
 
 			if tt.overrideBindJSON {
 				ctx.Request.Body = io.NopCloser(bytes.NewBuffer([]byte("{invalid-json")))
 			}
 
 			if tt.userID != "" {
-				ctx.Set("userID", tt.userID)
+				ctx.Set("myUserID", tt.userID)
 			}
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockService)
 			}
 
-			controller.PutUserByID(ctx)
+			controller.PutUserMy(ctx)
 
 			var body dto.ResponseWrapper
 			err := json.NewDecoder(recorder.Body).Decode(&body)
@@ -292,3 +297,194 @@ func TestUserController_PutUserByID(t *testing.T) {
 		})
 	}
 }
+
+func TestUserController_FollowUserByID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		myUserID       string
+		mockSetup      func(*service.MockUserService)
+		expectedStatus int
+		expectedBody   dto.ResponseWrapper
+	}{
+		{
+			name:           "missing user id in context",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:     "follow themselves",
+			myUserID: "user2",
+			mockSetup: func(m *service.MockUserService) {
+				m.On("Follow", mock.Anything, "user2", "user2").Return(service.ErrSelfFollow)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Users cannot follow themselves", Code: "self_follow"}},
+			},
+		},
+		{
+			name:     "success",
+			myUserID: "user1",
+			mockSetup: func(m *service.MockUserService) {
+				m.On("Follow", mock.Anything, "user2", "user1").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mockService service.MockUserService
+			if tt.mockSetup != nil {
+				tt.mockSetup(&mockService)
+			}
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/users/user2/follow", nil)
+			ctx.Set("myUserID", tt.myUserID)
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "user2"}}
+
+			controller := NewUserController(&mockService)
+
+			controller.FollowUserByID(ctx)
+
+			var body dto.ResponseWrapper
+			assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+
+			assert.Nil(t, body.Data)
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserController_UnfollowUserByID(t *testing.T) {
+	t.Parallel()
+
+	var mockService service.MockUserService
+	mockService.On("Unfollow", mock.Anything, "user2", "user1").Return(nil)
+
+	ctx, recorder := setupControllerTest(t, http.MethodDelete, "/api/v1/users/user2/follow", nil)
+	ctx.Set("myUserID", "user1")
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "user2"}}
+
+	NewUserController(&mockService).UnfollowUserByID(ctx)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestUserController_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	total := int64(2)
+
+	tests := []struct {
+		name           string
+		myUserID       string
+		url            string
+		mockSetup      func(*service.MockUserService)
+		expectedStatus int
+		expectedData   []dto.Activity
+		expectedMeta   dto.Meta
+		expectedErrors []dto.Error
+	}{
+		{
+			name:           "missing user id in context",
+			url:            "/api/v1/feed",
+			expectedStatus: http.StatusUnauthorized,
+			expectedMeta:   dto.Meta{Success: false},
+			expectedErrors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+		},
+		{
+			name:           "invalid limit",
+			myUserID:       "user1",
+			url:            "/api/v1/feed?limit=abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedMeta:   dto.Meta{Success: false},
+			expectedErrors: []dto.Error{{Message: "Page parameters are invalid", Code: "bad_request"}},
+		},
+		{
+			name:     "success",
+			myUserID: "user1",
+			url:      "/api/v1/feed?limit=1",
+			mockSetup: func(m *service.MockUserService) {
+				activities := []model.Activity{
+					{
+						ID:        "activity1",
+						CreatedAt: createdAt,
+						Type:      activity_type.PlaceAdded,
+						User:      model.User{ID: "user2", Username: "john"},
+						Pack:      &model.Pack{ID: "pack1", Name: "Paris"},
+						Place:     &model.Place{ID: "place1", Name: "Louvre"},
+					},
+				}
+				pageInfo := model.PageInfo{NextCursor: "MQ", Total: 2}
+
+				m.On("GetFeed", mock.Anything, "user1", model.Page{Limit: 1}).Return(activities, pageInfo, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedData: []dto.Activity{
+				{
+					ID:        "activity1",
+					CreatedAt: createdAt,
+					Type:      activity_type.PlaceAdded,
+					User:      dto.User{ID: "user2", Username: "john"},
+					Pack:      &dto.Pack{ID: "pack1", Name: "Paris", Places: []dto.Place{}},
+					Place:     &dto.Place{ID: "place1", Name: "Louvre"},
+				},
+			},
+			expectedMeta: dto.Meta{Success: true, NextCursor: "MQ", Total: &total},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mockService service.MockUserService
+			if tt.mockSetup != nil {
+				tt.mockSetup(&mockService)
+			}
+
+			ctx, recorder := setupControllerTest(t, http.MethodGet, tt.url, nil)
+			ctx.Set("myUserID", tt.myUserID)
+
+			controller := NewUserController(&mockService)
+
+			controller.GetFeed(ctx)
+
+			var body dto.ResponseWrapper
+			assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+
+			if tt.expectedData != nil {
+				dataBytes, err := json.Marshal(body.Data)
+				assert.NoError(t, err)
+
+				var actualActivities []dto.Activity
+				err = json.Unmarshal(dataBytes, &actualActivities)
+				assert.NoError(t, err)
+
+				assert.Equal(t, tt.expectedData, actualActivities)
+			} else {
+				assert.Nil(t, body.Data)
+			}
+
+			assert.Equal(t, tt.expectedMeta, body.Meta)
+			assert.Equal(t, tt.expectedErrors, body.Errors)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
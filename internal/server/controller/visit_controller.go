@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"locpack-backend/internal/server"
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/adapter"
+
+	"github.com/jinzhu/copier"
+)
+
+type visitControllerImpl struct {
+	service service.VisitService
+}
+
+func NewVisitController(service service.VisitService) server.VisitController {
+	return &visitControllerImpl{service}
+}
+
+// GetUserMyVisits
+// @Summary Get visits of current user
+// @Description Get the check-ins of the currently authenticated user, latest first. From and to are dates (UTC) or RFC 3339 times; a date given as to includes that whole day
+// @Tags Visits
+// @Security BearerAuth
+// @Param from query string false "Earliest visit date"
+// @Param to query string false "Latest visit date"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Page cursor"
+// @Success 200 {object} dto.ResponseWrapper{data=[]dto.Visit}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/users/my/visits [get]
+func (c *visitControllerImpl) GetUserMyVisits(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	from, fromErr := parseDate(ctx.Query("from"), false)
+	to, toErr := parseDate(ctx.Query("to"), true)
+	if fromErr != nil || toErr != nil {
+		response.BadRequest(ctx, "Dates are invalid")
+		return
+	}
+
+	page, err := parsePage(ctx)
+	if err != nil {
+		response.BadRequest(ctx, "Page parameters are invalid")
+		return
+	}
+
+	visits, pageInfo, err := c.service.GetByUserID(ctx.Request.Context(), myUserID, model.VisitFilter{From: from, To: to}, page)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	visitsDTOs := []dto.Visit{}
+	err = copier.Copy(&visitsDTOs, &visits)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: visitsDTOs,
+		Meta: dto.Meta{
+			Success:    true,
+			NextCursor: pageInfo.NextCursor,
+			Total:      &pageInfo.Total,
+		},
+	})
+}
+
+// PostPlaceVisit
+// @Summary Visit place
+// @Description Check the current user in at a place. Any authenticated user can visit a place, and visiting it again records another check-in. The body is optional: visited_at defaults to now and must not be in the future, the note is at most 2000 characters and the rating is from 1 to 5
+// @Tags Visits
+// @Security BearerAuth
+// @Param id path string true "Place ID"
+// @Param visit body dto.VisitCreate false "Visit data"
+// @Success 200 {object} dto.ResponseWrapper{data=dto.Visit}
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Failure 422 {object} dto.ResponseWrapper
+// @Router /api/v1/places/{id}/visit [post]
+func (c *visitControllerImpl) PostPlaceVisit(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	placeID := ctx.Param("id")
+	if len(placeID) == 0 {
+		response.BadRequest(ctx, "Place ID is required")
+		return
+	}
+
+	var visitCreateDTO dto.VisitCreate
+	err := ctx.ShouldBindJSON(&visitCreateDTO)
+	if err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(ctx, "Request body is invalid")
+		return
+	}
+
+	visitCreate := model.VisitCreate{}
+	err = copier.Copy(&visitCreate, &visitCreateDTO)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	visit, err := c.service.Create(ctx.Request.Context(), placeID, myUserID, visitCreate)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	visitDTO := dto.Visit{}
+	err = copier.Copy(&visitDTO, &visit)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Data: visitDTO,
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// DeleteVisit
+// @Summary Delete visit
+// @Description Delete a single check-in of the current user. The place stays visited as long as the user has other visits to it
+// @Tags Visits
+// @Security BearerAuth
+// @Param id path string true "Visit ID"
+// @Success 200 {object} dto.ResponseWrapper
+// @Failure 400 {object} dto.ResponseWrapper
+// @Failure 401 {object} dto.ResponseWrapper
+// @Failure 404 {object} dto.ResponseWrapper
+// @Router /api/v1/visits/{id} [delete]
+func (c *visitControllerImpl) DeleteVisit(ctx adapter.APIContext) {
+	myUserID := ctx.GetString("myUserID")
+	if len(myUserID) == 0 {
+		response.Error(ctx, service.ErrUnauthenticated)
+		return
+	}
+
+	visitID := ctx.Param("id")
+	if len(visitID) == 0 {
+		response.BadRequest(ctx, "Visit ID is required")
+		return
+	}
+
+	err := c.service.DeleteByID(ctx.Request.Context(), visitID, myUserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ResponseWrapper{
+		Meta: dto.Meta{Success: true},
+	})
+}
+
+// parseDate parses an RFC 3339 time or a date in UTC. When end is set, a date
+// means the start of the next day, so that the bound includes the whole day.
+// An empty value is no bound.
+func parseDate(value string, end bool) (*time.Time, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return &t, nil
+	}
+
+	t, err = time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,292 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVisitController_GetUserMyVisits(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	visitedAt := time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		userID       string
+		query        string
+		mockSetup    func(s *service.MockVisitService)
+		expectedLen  int
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "unauthenticated",
+			mockSetup:    func(*service.MockVisitService) {},
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "invalid date",
+			userID:       "user1",
+			query:        "?from=yesterday",
+			mockSetup:    func(*service.MockVisitService) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Dates are invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:   "invalid range",
+			userID: "user1",
+			query:  "?from=2024-06-01&to=2024-05-01",
+			mockSetup: func(s *service.MockVisitService) {
+				s.On("GetByUserID", mock.Anything, "user1", mock.Anything, model.Page{}).Return(nil, model.PageInfo{}, service.ErrInvalidDateRange)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "From must be before to", Code: "invalid_date_range"}},
+			},
+		},
+		{
+			name:   "success",
+			userID: "user1",
+			query:  "?from=2024-05-01&to=2024-05-31",
+			mockSetup: func(s *service.MockVisitService) {
+				visits := []model.Visit{{ID: "visit1", VisitedAt: visitedAt, Place: model.Place{ID: "place1", Visited: true}}}
+				s.On("GetByUserID", mock.Anything, "user1", model.VisitFilter{From: &from, To: &to}, model.Page{}).Return(visits, model.PageInfo{Total: 1}, nil)
+			},
+			expectedLen:  1,
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{
+				Meta: dto.Meta{Success: true, Total: func() *int64 { total := int64(1); return &total }()},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockVisitService)
+			controller := NewVisitController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodGet, "/api/v1/users/my/visits"+tt.query, nil)
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			tt.mockSetup(mockService)
+
+			controller.GetUserMyVisits(ctx)
+
+			var body struct {
+				Data   []dto.Visit `json:"data"`
+				Meta   dto.Meta    `json:"meta"`
+				Errors []dto.Error `json:"errors"`
+			}
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Len(t, body.Data, tt.expectedLen)
+			if tt.expectedLen != 0 {
+				assert.Equal(t, "visit1", body.Data[0].ID)
+				assert.Equal(t, visitedAt, body.Data[0].VisitedAt)
+				assert.Equal(t, "place1", body.Data[0].Place.ID)
+			}
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVisitController_PostPlaceVisit(t *testing.T) {
+	t.Parallel()
+
+	visitedAt := time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC)
+	rating := 5
+
+	testCases := []struct {
+		name         string
+		userID       string
+		body         string
+		mockSetup    func(s *service.MockVisitService)
+		expectedID   string
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "unauthenticated",
+			mockSetup:    func(*service.MockVisitService) {},
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:         "invalid body",
+			userID:       "user1",
+			body:         `{"rating": "five"}`,
+			mockSetup:    func(*service.MockVisitService) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Request body is invalid", Code: "bad_request"}},
+			},
+		},
+		{
+			name:   "place not found",
+			userID: "user1",
+			mockSetup: func(s *service.MockVisitService) {
+				s.On("Create", mock.Anything, "place1", "user1", model.VisitCreate{}).Return(model.Visit{}, service.ErrPlaceNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Place not found", Code: "place_not_found"}},
+			},
+		},
+		{
+			name:   "success without body",
+			userID: "user1",
+			mockSetup: func(s *service.MockVisitService) {
+				s.On("Create", mock.Anything, "place1", "user1", model.VisitCreate{}).Return(model.Visit{ID: "visit1"}, nil)
+			},
+			expectedID:   "visit1",
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{Meta: dto.Meta{Success: true}},
+		},
+		{
+			name:   "success",
+			userID: "user1",
+			body:   `{"visited_at": "2024-05-10T09:30:00Z", "note": "Sunny", "rating": 5}`,
+			mockSetup: func(s *service.MockVisitService) {
+				vc := model.VisitCreate{VisitedAt: &visitedAt, Note: "Sunny", Rating: &rating}
+				s.On("Create", mock.Anything, "place1", "user1", vc).Return(model.Visit{ID: "visit1", VisitedAt: visitedAt}, nil)
+			},
+			expectedID:   "visit1",
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{Meta: dto.Meta{Success: true}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockVisitService)
+			controller := NewVisitController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodPost, "/api/v1/places/place1/visit", nil)
+			ctx.Request.Body = io.NopCloser(strings.NewReader(tt.body))
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "place1"}}
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			tt.mockSetup(mockService)
+
+			controller.PostPlaceVisit(ctx)
+
+			var body struct {
+				Data   dto.Visit   `json:"data"`
+				Meta   dto.Meta    `json:"meta"`
+				Errors []dto.Error `json:"errors"`
+			}
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expectedID, body.Data.ID)
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVisitController_DeleteVisit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		userID       string
+		mockSetup    func(s *service.MockVisitService)
+		expectedBody dto.ResponseWrapper
+		expectedCode int
+	}{
+		{
+			name:         "unauthenticated",
+			mockSetup:    func(*service.MockVisitService) {},
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Authentication is required", Code: "unauthenticated"}},
+			},
+		},
+		{
+			name:   "visit not found",
+			userID: "user1",
+			mockSetup: func(s *service.MockVisitService) {
+				s.On("DeleteByID", mock.Anything, "visit1", "user1").Return(service.ErrVisitNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: dto.ResponseWrapper{
+				Meta:   dto.Meta{Success: false},
+				Errors: []dto.Error{{Message: "Visit not found", Code: "visit_not_found"}},
+			},
+		},
+		{
+			name:   "success",
+			userID: "user1",
+			mockSetup: func(s *service.MockVisitService) {
+				s.On("DeleteByID", mock.Anything, "visit1", "user1").Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: dto.ResponseWrapper{Meta: dto.Meta{Success: true}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(service.MockVisitService)
+			controller := NewVisitController(mockService)
+
+			ctx, recorder := setupControllerTest(t, http.MethodDelete, "/api/v1/visits/visit1", nil)
+			ctx.Params = gin.Params{gin.Param{Key: "id", Value: "visit1"}}
+			if tt.userID != "" {
+				ctx.Set("myUserID", tt.userID)
+			}
+			tt.mockSetup(mockService)
+
+			controller.DeleteVisit(ctx)
+
+			var body dto.ResponseWrapper
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expectedBody.Meta, body.Meta)
+			assert.Equal(t, tt.expectedBody.Errors, body.Errors)
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package dto
 
 type Meta struct {
-	Success bool `json:"success"`
+	Success    bool   `json:"success"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      *int64 `json:"total,omitempty"`
 }
 
 type Error struct {
@@ -0,0 +1,10 @@
+package dto
+
+type Media struct {
+	ID           string `json:"id" copier:"PublicID"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	ContentType  string `json:"content_type"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"locpack-backend/pkg/types"
+)
+
+type ModerationAction struct {
+	ID         string                 `json:"id"`
+	CreatedAt  time.Time              `json:"created_at"`
+	Moderator  User                   `json:"moderator"`
+	Action     types.ModerationAction `json:"action"`
+	TargetType string                 `json:"target_type"`
+	TargetID   string                 `json:"target_id"`
+	Reason     string                 `json:"reason"`
+}
+
+type Moderation struct {
+	Reason string `json:"reason"`
+}
+
+type PackTransfer struct {
+	UserID string `json:"user_id" binding:"required"`
+	Reason string `json:"reason"`
+}
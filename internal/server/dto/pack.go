@@ -1,21 +1,70 @@
 package dto
 
-import "locpack-backend/pkg/types"
+import (
+	"time"
+
+	"locpack-backend/pkg/types"
+)
 
 type Pack struct {
-	ID     string           `json:"id"`
-	Name   string           `json:"name"`
-	Status types.PackStatus `json:"status"`
-	Author User             `json:"author"`
-	Places []Place          `json:"places"`
+	ID         string               `json:"id"`
+	Name       string               `json:"name"`
+	Visibility types.PackVisibility `json:"visibility"`
+	Version    int64                `json:"version"`
+	Status     types.PackStatus     `json:"status"`
+	Author     User                 `json:"author"`
+	Places     []Place              `json:"places"`
+	Cover      *Media               `json:"cover,omitempty"`
+
+	Collaborators []Collaborator `json:"collaborators,omitempty"`
+	Progress      *PackProgress  `json:"progress,omitempty"`
+	NameHighlight string         `json:"name_highlight,omitempty"`
+}
+
+type PackProgress struct {
+	Visited     int        `json:"visited"`
+	Total       int        `json:"total"`
+	Percent     int        `json:"percent"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
 }
 
 type PackCreate struct {
-	Name string `json:"name"`
+	Name       string               `json:"name"`
+	Visibility types.PackVisibility `json:"visibility"`
 }
 
 type PackUpdate struct {
 	Name      string           `json:"name"`
 	PlacesIDs []string         `json:"places_ids"`
 	Status    types.PackStatus `json:"status"`
+
+	Visibility types.PackVisibility `json:"visibility"`
+}
+
+type Collaborator struct {
+	User     User                   `json:"user"`
+	Role     types.CollaboratorRole `json:"role"`
+	Accepted bool                   `json:"accepted"`
+}
+
+type CollaboratorInvite struct {
+	UserID string                 `json:"user_id" binding:"required"`
+	Role   types.CollaboratorRole `json:"role" binding:"required"`
+}
+
+type ShareLink struct {
+	ID        string     `json:"id"`
+	Token     string     `json:"token"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type ShareLinkCreate struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type PackPlaceUpdate struct {
+	Position        *int    `json:"position"`
+	Note            *string `json:"note"`
+	PlannedDuration *int    `json:"planned_duration"`
 }
@@ -1,20 +1,58 @@
 package dto
 
+import "time"
+
 type Place struct {
-	ID      string `json:"id"`
-	Name    string `json:"name"`
-	Address string `json:"address"`
-	Visited bool   `json:"visited"`
+	ID        string   `json:"id" copier:"PublicID"`
+	Name      string   `json:"name"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Distance  float64  `json:"distance,omitempty"`
+	Visited   bool     `json:"visited"`
+	Version   int64    `json:"version"`
+
+	LastVisitedAt *time.Time `json:"last_visited_at,omitempty"`
+
+	CountryCode string `json:"country_code,omitempty"`
+
+	RatingAverage float64 `json:"rating_average,omitempty"`
+	RatingCount   int64   `json:"rating_count,omitempty"`
+
+	Media []Media `json:"media,omitempty"`
+
+	NameHighlight    string `json:"name_highlight,omitempty"`
+	AddressHighlight string `json:"address_highlight,omitempty"`
+
+	Position        int    `json:"position,omitempty"`
+	Note            string `json:"note,omitempty"`
+	PlannedDuration int    `json:"planned_duration,omitempty"`
 }
 
 type PlaceCreate struct {
-	Name    string `json:"name"`
-	Address string `json:"address"`
-	Visited string `json:"visited"`
+	Name      string   `json:"name"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Visited   string   `json:"visited"`
+
+	CountryCode string `json:"country_code"`
+
+	Visit *VisitCreate `json:"visit"`
+
+	IgnoreDuplicates bool `json:"ignore_duplicates"`
 }
 
 type PlaceUpdate struct {
-	Name    string `json:"name"`
-	Address string `json:"address"`
-	Visited bool   `json:"visited"`
+	Name      string   `json:"name"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+
+	CountryCode string `json:"country_code"`
+}
+
+type PlaceMerge struct {
+	IntoID string `json:"into_id" binding:"required"`
+	Reason string `json:"reason"`
 }
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type Review struct {
+	ID        string    `json:"id" copier:"PublicID"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Author    User      `json:"author"`
+	Rating    int       `json:"rating"`
+	Text      string    `json:"text"`
+}
+
+type ReviewCreate struct {
+	Rating int    `json:"rating"`
+	Text   string `json:"text"`
+}
+
+type ReviewUpdate struct {
+	Rating int    `json:"rating"`
+	Text   string `json:"text"`
+}
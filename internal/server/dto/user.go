@@ -1,6 +1,44 @@
 package dto
 
+import (
+	"time"
+
+	"locpack-backend/pkg/types"
+)
+
 type User struct {
 	ID       string `json:"id" copier:"PublicID"`
 	Username string `json:"username"`
+
+	DisplayName string `json:"display_name,omitempty"`
+	Bio         string `json:"bio,omitempty"`
+	HomeCity    string `json:"home_city,omitempty"`
+	AvatarURL   string `json:"avatar_url,omitempty"`
+
+	Stats *UserStats `json:"stats,omitempty"`
+}
+
+type UserUpdate struct {
+	DisplayName string `json:"display_name"`
+	Bio         string `json:"bio"`
+	HomeCity    string `json:"home_city"`
+	AvatarURL   string `json:"avatar_url"`
+}
+
+type UserStats struct {
+	CreatedPacks         int64 `json:"created_packs"`
+	Followers            int64 `json:"followers"`
+	Following            int64 `json:"following"`
+	VisitedPlaces        int64 `json:"visited_places"`
+	Countries            int64 `json:"countries"`
+	UnknownCountryPlaces int64 `json:"unknown_country_places"`
+}
+
+type Activity struct {
+	ID        string             `json:"id"`
+	CreatedAt time.Time          `json:"created_at"`
+	Type      types.ActivityType `json:"type"`
+	User      User               `json:"user"`
+	Pack      *Pack              `json:"pack,omitempty"`
+	Place     *Place             `json:"place,omitempty"`
 }
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+type Visit struct {
+	ID        string    `json:"id" copier:"PublicID"`
+	VisitedAt time.Time `json:"visited_at"`
+	Note      string    `json:"note,omitempty"`
+	Rating    *int      `json:"rating,omitempty"`
+	Place     Place     `json:"place"`
+}
+
+type VisitCreate struct {
+	VisitedAt *time.Time `json:"visited_at"`
+	Note      string     `json:"note"`
+	Rating    *int       `json:"rating"`
+}
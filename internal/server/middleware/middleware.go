@@ -1,10 +1,13 @@
 package middleware
 
 import (
-	"net/http"
+	"context"
+	"slices"
 	"strings"
+	"time"
 
-	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/server/response"
+	"locpack-backend/internal/service"
 	"locpack-backend/pkg/adapter"
 )
 
@@ -13,9 +16,10 @@ func AnyAuthenticatedMiddleware(auth adapter.Auth) adapter.APIHandler {
 		authHeader := ctx.GetHeader("Authorization")
 		if authHeader != "" {
 			accessToken := strings.TrimPrefix(authHeader, "Bearer ")
-			token, err := auth.DecodeToken(accessToken)
+			token, err := auth.DecodeToken(ctx.Request.Context(), accessToken)
 			if err == nil && token.Valid {
 				ctx.Set("myUserID", token.Username)
+				ctx.Set("myRoles", token.Roles)
 			}
 		}
 		ctx.Next()
@@ -26,28 +30,72 @@ func AuthenticatedMiddleware(auth adapter.Auth) adapter.APIHandler {
 	return func(ctx adapter.APIContext) {
 		authHeader := ctx.GetHeader("Authorization")
 		if authHeader == "" {
-			errors := []dto.Error{{Message: "Some error", Code: "000"}}
-			ctx.JSON(http.StatusUnauthorized, dto.ResponseWrapper{
-				Meta:   dto.Meta{Success: false},
-				Errors: errors,
-			})
+			response.Error(ctx, service.ErrUnauthenticated)
 			return
 		}
 
 		accessToken := strings.TrimPrefix(authHeader, "Bearer ")
 
-		token, err := auth.DecodeToken(accessToken)
-		if err != nil || !token.Valid {
-			errors := []dto.Error{{Message: "Some error", Code: "000"}}
-			ctx.JSON(http.StatusUnauthorized, dto.ResponseWrapper{
-				Meta:   dto.Meta{Success: false},
-				Errors: errors,
-			})
+		token, err := auth.DecodeToken(ctx.Request.Context(), accessToken)
+		if err != nil {
+			response.Error(ctx, service.ErrInvalidToken.Wrap(err))
+			return
+		}
+		if !token.Valid {
+			response.Error(ctx, service.ErrInvalidToken)
 			return
 		}
 
 		ctx.Set("myUserID", token.Username)
+		ctx.Set("myRoles", token.Roles)
+
+		ctx.Next()
+	}
+}
+
+// RequireRole lets through users having any of the roles. It must run after
+// AuthenticatedMiddleware, which stores the roles of the token.
+func RequireRole(roles ...string) adapter.APIHandler {
+	return func(ctx adapter.APIContext) {
+		myRoles := ctx.GetStringSlice("myRoles")
+		for _, role := range roles {
+			if slices.Contains(myRoles, role) {
+				ctx.Next()
+				return
+			}
+		}
+
+		response.Error(ctx, service.ErrMissingRole)
+	}
+}
+
+// NotBannedMiddleware rejects requests of banned users. It must run after
+// AuthenticatedMiddleware.
+func NotBannedMiddleware(userService service.UserService) adapter.APIHandler {
+	return func(ctx adapter.APIContext) {
+		err := userService.CheckNotBanned(ctx.Request.Context(), ctx.GetString("myUserID"))
+		if err != nil {
+			response.Error(ctx, err)
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// TimeoutMiddleware bounds the request context, so that database queries
+// and identity provider calls are cancelled once the deadline passes.
+func TimeoutMiddleware(timeout time.Duration) adapter.APIHandler {
+	return func(ctx adapter.APIContext) {
+		if timeout <= 0 {
+			ctx.Next()
+			return
+		}
+
+		requestCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+		defer cancel()
 
+		ctx.Request = ctx.Request.WithContext(requestCtx)
 		ctx.Next()
 	}
 }
@@ -56,11 +104,7 @@ func NotAuthenticatedMiddleware() adapter.APIHandler {
 	return func(ctx adapter.APIContext) {
 		authHeader := ctx.GetHeader("Authorization")
 		if authHeader != "" {
-			errors := []dto.Error{{Message: "Some error", Code: "000"}}
-			ctx.JSON(http.StatusUnauthorized, dto.ResponseWrapper{
-				Meta:   dto.Meta{Success: false},
-				Errors: errors,
-			})
+			response.Error(ctx, service.ErrAlreadyAuthenticated)
 			return
 		}
 
@@ -0,0 +1,63 @@
+package response
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"locpack-backend/internal/server/dto"
+	"locpack-backend/internal/service"
+	"locpack-backend/pkg/adapter"
+)
+
+const badRequestCode = "bad_request"
+
+var statuses = map[service.Kind]int{
+	service.KindInternal:     http.StatusInternalServerError,
+	service.KindNotFound:     http.StatusNotFound,
+	service.KindForbidden:    http.StatusForbidden,
+	service.KindConflict:     http.StatusConflict,
+	service.KindInvalid:      http.StatusUnprocessableEntity,
+	service.KindUnauthorized: http.StatusUnauthorized,
+	service.KindUpstream:     http.StatusBadGateway,
+	service.KindUnavailable:  http.StatusServiceUnavailable,
+	service.KindTimeout:      http.StatusGatewayTimeout,
+	service.KindPrecondition: http.StatusPreconditionFailed,
+	service.KindTooLarge:     http.StatusRequestEntityTooLarge,
+	service.KindUnsupported:  http.StatusUnsupportedMediaType,
+}
+
+// Error aborts the request with err. Domain errors are written with their
+// status and code, anything else is reported as an internal error without
+// exposing its details. Exceeded request deadlines are reported as timeouts
+// even when a domain error wraps them.
+func Error(ctx adapter.APIContext, err error) {
+	_ = ctx.Error(err)
+
+	var domainErr *service.Error
+	if errors.Is(err, context.DeadlineExceeded) {
+		domainErr = service.ErrTimeout
+	} else if !errors.As(err, &domainErr) {
+		domainErr = service.ErrInternal
+	}
+
+	status, ok := statuses[domainErr.Kind]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	abort(ctx, status, dto.Error{Message: domainErr.Message, Code: domainErr.Code})
+}
+
+// BadRequest aborts the request because it is malformed: a path or query
+// parameter is missing or the body cannot be decoded.
+func BadRequest(ctx adapter.APIContext, message string) {
+	abort(ctx, http.StatusBadRequest, dto.Error{Message: message, Code: badRequestCode})
+}
+
+func abort(ctx adapter.APIContext, status int, e dto.Error) {
+	ctx.AbortWithStatusJSON(status, dto.ResponseWrapper{
+		Meta:   dto.Meta{Success: false},
+		Errors: []dto.Error{e},
+	})
+}
@@ -3,42 +3,105 @@ package router
 import (
 	"locpack-backend/internal/server"
 	"locpack-backend/internal/server/middleware"
+	"locpack-backend/internal/service"
 	"locpack-backend/pkg/adapter"
 	"locpack-backend/pkg/adapter/swagger"
+	"locpack-backend/pkg/enum/role"
 )
 
 func New(
 	api adapter.API,
 	authAdapter adapter.Auth,
+	userService service.UserService,
 	packController server.PackController,
 	placeController server.PlaceController,
 	userController server.UserController,
+	mediaController server.MediaController,
+	reviewController server.ReviewController,
+	visitController server.VisitController,
 	authController server.AuthController,
+	moderationController server.ModerationController,
+	healthController server.HealthController,
 ) {
+	api.GET("/healthz", healthController.GetLiveness)
+	api.GET("/readyz", healthController.GetReadiness)
+
 	public := api.Group("")
 	public.Use(middleware.AnyAuthenticatedMiddleware(authAdapter))
 	{
 		public.GET("/api/v1/packs", packController.GetPacksByQuery)
 		public.GET("/api/v1/packs/:id", packController.GetPackByID)
+		public.GET("/api/v1/shared/:token", packController.GetSharedPack)
 		public.GET("/api/v1/places", placeController.GetPlacesByQuery)
+		public.GET("/api/v1/places/nearby", placeController.GetPlacesNearby)
+		public.GET("/api/v1/places/duplicates", placeController.GetPlaceDuplicates)
 		public.GET("/api/v1/places/:id", placeController.GetPlaceByID)
+		public.GET("/api/v1/places/:id/reviews", reviewController.GetPlaceReviews)
 		public.GET("/api/v1/users/:id", userController.GetUserByID)
 		public.GET("/swagger/*any", swagger.GetHandler())
 	}
 
 	auth := api.Group("")
-	auth.Use(middleware.AuthenticatedMiddleware(authAdapter))
+	auth.Use(middleware.AuthenticatedMiddleware(authAdapter), middleware.NotBannedMiddleware(userService))
 	{
 		auth.POST("/api/v1/packs", packController.PostPack)
 		auth.GET("/api/v1/packs/followed", packController.GetPacksFollowed)
 		auth.GET("/api/v1/packs/created", packController.GetPacksCreated)
 		auth.PUT("/api/v1/packs/:id", packController.PutPackByID)
+		auth.PATCH("/api/v1/packs/:id/places/:placeId", packController.PatchPackPlaceByID)
+		auth.DELETE("/api/v1/packs/:id", packController.DeletePackByID)
+		auth.POST("/api/v1/packs/:id/restore", packController.RestorePackByID)
+		auth.POST("/api/v1/packs/:id/collaborators", packController.PostPackCollaborator)
+		auth.DELETE("/api/v1/packs/:id/collaborators/:userId", packController.DeletePackCollaborator)
+		auth.POST("/api/v1/packs/:id/invitation/accept", packController.AcceptPackInvitation)
+		auth.POST("/api/v1/packs/:id/share-links", packController.PostPackShareLink)
+		auth.GET("/api/v1/packs/:id/share-links", packController.GetPackShareLinks)
+		auth.DELETE("/api/v1/packs/:id/share-links/:linkId", packController.DeletePackShareLink)
+		auth.PUT("/api/v1/packs/:id/cover", mediaController.PutPackCover)
+		auth.DELETE("/api/v1/packs/:id/cover", mediaController.DeletePackCover)
+		auth.POST("/api/v1/shared/:token/follow", packController.FollowSharedPack)
 		auth.POST("/api/v1/places", placeController.PostPlace)
 		auth.PUT("/api/v1/places/:id", placeController.PutPlaceByID)
+		auth.DELETE("/api/v1/places/:id", placeController.DeletePlaceByID)
+		auth.POST("/api/v1/places/:id/restore", placeController.RestorePlaceByID)
+		auth.POST("/api/v1/places/:id/merge", placeController.MergePlaceByID)
+		auth.POST("/api/v1/places/:id/media", mediaController.PostPlaceMedia)
+		auth.DELETE("/api/v1/places/:id/media/:mediaId", mediaController.DeletePlaceMedia)
+		auth.POST("/api/v1/places/:id/reviews", reviewController.PostPlaceReview)
+		auth.PUT("/api/v1/places/:id/reviews/:reviewId", reviewController.PutPlaceReview)
+		auth.DELETE("/api/v1/places/:id/reviews/:reviewId", reviewController.DeletePlaceReview)
+		auth.POST("/api/v1/places/:id/visit", visitController.PostPlaceVisit)
 		auth.GET("/api/v1/users/my", userController.GetUserMy)
+		auth.PUT("/api/v1/users/my", userController.PutUserMy)
+		auth.GET("/api/v1/users/my/visits", visitController.GetUserMyVisits)
+		auth.DELETE("/api/v1/visits/:id", visitController.DeleteVisit)
+		auth.POST("/api/v1/users/:id/follow", userController.FollowUserByID)
+		auth.DELETE("/api/v1/users/:id/follow", userController.UnfollowUserByID)
+		auth.GET("/api/v1/feed", userController.GetFeed)
 		auth.POST("/api/v1/auth/refresh", authController.Refresh)
 	}
 
+	admin := api.Group("/api/v1/admin")
+	admin.Use(
+		middleware.AuthenticatedMiddleware(authAdapter),
+		middleware.NotBannedMiddleware(userService),
+		middleware.RequireRole(role.Moderator),
+	)
+	{
+		admin.GET("/log", moderationController.GetModerationLog)
+		admin.POST("/places/:id/hide", moderationController.HidePlaceByID)
+		admin.POST("/places/:id/unhide", moderationController.UnhidePlaceByID)
+		admin.DELETE("/places/:id", moderationController.DeletePlaceByID)
+		admin.POST("/places/:id/merge", moderationController.MergePlaceByID)
+		admin.DELETE("/reviews/:id", moderationController.DeleteReviewByID)
+		admin.POST("/packs/:id/hide", moderationController.HidePackByID)
+		admin.POST("/packs/:id/unhide", moderationController.UnhidePackByID)
+		admin.DELETE("/packs/:id", moderationController.DeletePackByID)
+		admin.POST("/packs/:id/transfer", moderationController.TransferPackByID)
+		admin.POST("/users/:id/ban", moderationController.BanUserByID)
+		admin.POST("/users/:id/unban", moderationController.UnbanUserByID)
+	}
+
 	notAuth := api.Group("")
 	notAuth.Use(middleware.NotAuthenticatedMiddleware())
 	{
@@ -6,9 +6,14 @@ import (
 
 type PlaceController interface {
 	GetPlacesByQuery(ctx adapter.APIContext)
+	GetPlacesNearby(ctx adapter.APIContext)
+	GetPlaceDuplicates(ctx adapter.APIContext)
 	PostPlace(ctx adapter.APIContext)
 	GetPlaceByID(ctx adapter.APIContext)
 	PutPlaceByID(ctx adapter.APIContext)
+	DeletePlaceByID(ctx adapter.APIContext)
+	RestorePlaceByID(ctx adapter.APIContext)
+	MergePlaceByID(ctx adapter.APIContext)
 }
 
 type PackController interface {
@@ -18,11 +23,66 @@ type PackController interface {
 	GetPacksCreated(ctx adapter.APIContext)
 	GetPackByID(ctx adapter.APIContext)
 	PutPackByID(ctx adapter.APIContext)
+	PatchPackPlaceByID(ctx adapter.APIContext)
+	DeletePackByID(ctx adapter.APIContext)
+	RestorePackByID(ctx adapter.APIContext)
+	PostPackCollaborator(ctx adapter.APIContext)
+	AcceptPackInvitation(ctx adapter.APIContext)
+	DeletePackCollaborator(ctx adapter.APIContext)
+	PostPackShareLink(ctx adapter.APIContext)
+	GetPackShareLinks(ctx adapter.APIContext)
+	DeletePackShareLink(ctx adapter.APIContext)
+	GetSharedPack(ctx adapter.APIContext)
+	FollowSharedPack(ctx adapter.APIContext)
+}
+
+type ReviewController interface {
+	GetPlaceReviews(ctx adapter.APIContext)
+	PostPlaceReview(ctx adapter.APIContext)
+	PutPlaceReview(ctx adapter.APIContext)
+	DeletePlaceReview(ctx adapter.APIContext)
+}
+
+type VisitController interface {
+	GetUserMyVisits(ctx adapter.APIContext)
+	PostPlaceVisit(ctx adapter.APIContext)
+	DeleteVisit(ctx adapter.APIContext)
+}
+
+type MediaController interface {
+	PostPlaceMedia(ctx adapter.APIContext)
+	DeletePlaceMedia(ctx adapter.APIContext)
+	PutPackCover(ctx adapter.APIContext)
+	DeletePackCover(ctx adapter.APIContext)
 }
 
 type UserController interface {
 	GetUserMy(ctx adapter.APIContext)
+	PutUserMy(ctx adapter.APIContext)
 	GetUserByID(ctx adapter.APIContext)
+	FollowUserByID(ctx adapter.APIContext)
+	UnfollowUserByID(ctx adapter.APIContext)
+	GetFeed(ctx adapter.APIContext)
+}
+
+type ModerationController interface {
+	GetModerationLog(ctx adapter.APIContext)
+	HidePlaceByID(ctx adapter.APIContext)
+	UnhidePlaceByID(ctx adapter.APIContext)
+	DeletePlaceByID(ctx adapter.APIContext)
+	HidePackByID(ctx adapter.APIContext)
+	UnhidePackByID(ctx adapter.APIContext)
+	DeletePackByID(ctx adapter.APIContext)
+	TransferPackByID(ctx adapter.APIContext)
+	MergePlaceByID(ctx adapter.APIContext)
+	DeleteReviewByID(ctx adapter.APIContext)
+	BanUserByID(ctx adapter.APIContext)
+	UnbanUserByID(ctx adapter.APIContext)
+}
+
+type HealthController interface {
+	GetLiveness(ctx adapter.APIContext)
+	GetReadiness(ctx adapter.APIContext)
 }
 
 type AuthController interface {
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/random"
+
+	"github.com/google/uuid"
+)
+
+// newActivity returns an activity of the user about the pack or the place,
+// either of which may be nil.
+func newActivity(userEntity entity.User, activityType types.ActivityType, packEntity *entity.Pack, placeEntity *entity.Place) entity.Activity {
+	activity := entity.Activity{
+		ID:       uuid.New(),
+		PublicID: random.GeneratePublicID(),
+		UserID:   userEntity.ID,
+		Type:     activityType,
+	}
+	if packEntity != nil {
+		activity.PackID = &packEntity.ID
+	}
+	if placeEntity != nil {
+		activity.PlaceID = &placeEntity.ID
+	}
+	return activity
+}
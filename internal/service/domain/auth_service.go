@@ -1,8 +1,13 @@
 package domain
 
 import (
+	"context"
+	"errors"
+	"log"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
@@ -11,6 +16,8 @@ import (
 	"locpack-backend/pkg/adapter"
 )
 
+const compensationTimeout = 10 * time.Second
+
 type authServiceImpl struct {
 	auth       adapter.Auth
 	repository storage.UserRepository
@@ -20,10 +27,10 @@ func NewAuthService(auth adapter.Auth, repository storage.UserRepository) servic
 	return &authServiceImpl{auth, repository}
 }
 
-func (s *authServiceImpl) Register(register model.Register) (model.AccessToken, error) {
-	userID, err := s.auth.Register(register.Username, register.Email, register.Password)
+func (s *authServiceImpl) Register(ctx context.Context, register model.Register) (model.AccessToken, error) {
+	userID, err := s.auth.Register(ctx, register.Username, register.Email, register.Password)
 	if err != nil {
-		return model.AccessToken{}, err
+		return model.AccessToken{}, authError(err)
 	}
 
 	userEntity := entity.User{
@@ -32,14 +39,18 @@ func (s *authServiceImpl) Register(register model.Register) (model.AccessToken,
 		Username: strings.ToLower(register.Username),
 	}
 
-	err = s.repository.Create(userEntity)
+	err = s.repository.Create(ctx, userEntity)
 	if err != nil {
+		s.deleteIdentity(ctx, userID)
+		if errors.Is(err, storage.ErrDuplicate) {
+			return model.AccessToken{}, service.ErrUserExists.Wrap(err)
+		}
 		return model.AccessToken{}, err
 	}
 
-	token, err := s.auth.Login(register.Username, register.Password)
+	token, err := s.auth.Login(ctx, register.Username, register.Password)
 	if err != nil {
-		return model.AccessToken{}, err
+		return model.AccessToken{}, authError(err)
 	}
 
 	accessToken := model.AccessToken{}
@@ -51,11 +62,19 @@ func (s *authServiceImpl) Register(register model.Register) (model.AccessToken,
 	return accessToken, err
 }
 
-func (s *authServiceImpl) Login(login model.Login) (model.AccessToken, error) {
-	token, err := s.auth.Login(login.Username, login.Password)
+func (s *authServiceImpl) Login(ctx context.Context, login model.Login) (model.AccessToken, error) {
+	token, err := s.auth.Login(ctx, login.Username, login.Password)
 	if err != nil {
+		return model.AccessToken{}, authError(err)
+	}
+
+	userEntity, err := s.repository.GetByPublicID(ctx, strings.ToLower(login.Username))
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
 		return model.AccessToken{}, err
 	}
+	if userEntity.BannedAt != nil {
+		return model.AccessToken{}, service.ErrUserBanned
+	}
 
 	accessToken := model.AccessToken{}
 	err = copier.Copy(&accessToken, &token)
@@ -66,10 +85,10 @@ func (s *authServiceImpl) Login(login model.Login) (model.AccessToken, error) {
 	return accessToken, err
 }
 
-func (s *authServiceImpl) Refresh(refresh model.Refresh) (model.AccessToken, error) {
-	token, err := s.auth.Refresh(refresh.Value)
+func (s *authServiceImpl) Refresh(ctx context.Context, refresh model.Refresh) (model.AccessToken, error) {
+	token, err := s.auth.Refresh(ctx, refresh.Value)
 	if err != nil {
-		return model.AccessToken{}, err
+		return model.AccessToken{}, authError(err)
 	}
 
 	accessToken := model.AccessToken{}
@@ -80,3 +99,26 @@ func (s *authServiceImpl) Refresh(refresh model.Refresh) (model.AccessToken, err
 
 	return accessToken, err
 }
+
+// deleteIdentity compensates a registration whose user could not be stored,
+// so that no identity is left behind without a user. It outlives the request,
+// because the insert may have failed due to the request being cancelled.
+func (s *authServiceImpl) deleteIdentity(ctx context.Context, userID uuid.UUID) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), compensationTimeout)
+	defer cancel()
+
+	err := s.auth.Delete(ctx, userID)
+	if err != nil {
+		log.Printf("register: delete identity %s: %v", userID, err)
+	}
+}
+
+func authError(err error) error {
+	switch {
+	case errors.Is(err, adapter.ErrInvalidCredentials):
+		return service.ErrInvalidCredentials.Wrap(err)
+	case errors.Is(err, adapter.ErrUserExists):
+		return service.ErrUserExists.Wrap(err)
+	}
+	return service.ErrAuthProvider.Wrap(err)
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/types"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type registerAuth struct {
+	adapter.Auth
+	userID     uuid.UUID
+	deleted    []uuid.UUID
+	deleteErrs []error
+}
+
+func (a *registerAuth) Register(context.Context, string, string, string) (uuid.UUID, error) {
+	return a.userID, nil
+}
+
+func (a *registerAuth) Login(context.Context, string, string) (types.AccessToken, error) {
+	return types.AccessToken{Value: "token"}, nil
+}
+
+func (a *registerAuth) Delete(ctx context.Context, userID uuid.UUID) error {
+	a.deleted = append(a.deleted, userID)
+	a.deleteErrs = append(a.deleteErrs, ctx.Err())
+	return nil
+}
+
+func TestAuthService_Register(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	dbErr := errors.New("database error")
+	register := model.Register{Username: "John", Email: "john@example.com", Password: "secret"}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		createErr   error
+		expectedErr error
+		deleted     []uuid.UUID
+	}{
+		{
+			name: "success",
+			ctx:  context.Background(),
+		},
+		{
+			name:        "duplicate user",
+			ctx:         context.Background(),
+			createErr:   storage.ErrDuplicate,
+			expectedErr: service.ErrUserExists,
+			deleted:     []uuid.UUID{userID},
+		},
+		{
+			name:        "database error",
+			ctx:         context.Background(),
+			createErr:   dbErr,
+			expectedErr: dbErr,
+			deleted:     []uuid.UUID{userID},
+		},
+		{
+			name:        "request cancelled",
+			ctx:         cancelled,
+			createErr:   context.Canceled,
+			expectedErr: context.Canceled,
+			deleted:     []uuid.UUID{userID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			auth := &registerAuth{userID: userID}
+			userRepo := new(storage.MockUserRepository)
+			userRepo.EXPECT().
+				Create(mock.Anything, entity.User{ID: userID, PublicID: "john", Username: "john"}).
+				Return(tt.createErr)
+
+			svc := NewAuthService(auth, userRepo)
+			token, err := svc.Register(tt.ctx, register)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Equal(t, tt.deleted, auth.deleted)
+				assert.Equal(t, []error{nil}, auth.deleteErrs)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "token", token.Value)
+				assert.Empty(t, auth.deleted)
+			}
+
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
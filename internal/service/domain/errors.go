@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"errors"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/storage"
+)
+
+// storageError converts a repository failure into a domain error. Missing
+// records are reported as notFound and stale writes as version mismatches,
+// other failures are returned unchanged and surface as internal errors.
+func storageError(err error, notFound *service.Error) error {
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return notFound.Wrap(err)
+	case errors.Is(err, storage.ErrStale):
+		return service.ErrVersionMismatch.Wrap(err)
+	}
+	return err
+}
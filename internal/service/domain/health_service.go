@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/storage"
+	"locpack-backend/pkg/adapter"
+)
+
+const readinessTimeout = 2 * time.Second
+
+type healthServiceImpl struct {
+	shutdown   context.Context
+	repository storage.HealthRepository
+	auth       adapter.Auth
+}
+
+// NewHealthService reports readiness until shutdown is done, so that load
+// balancers stop routing to the instance while it drains.
+func NewHealthService(
+	shutdown context.Context,
+	repository storage.HealthRepository,
+	auth adapter.Auth,
+) service.HealthService {
+	return &healthServiceImpl{shutdown, repository, auth}
+}
+
+func (s *healthServiceImpl) Ready(ctx context.Context) error {
+	if s.shutdown.Err() != nil {
+		return service.ErrShuttingDown
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+
+	err := s.repository.Ping(ctx)
+	if err != nil {
+		return service.ErrDatabaseUnavailable.Wrap(err)
+	}
+
+	err = s.auth.Ping(ctx)
+	if err != nil {
+		return service.ErrAuthProviderUnavailable.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/storage"
+	"locpack-backend/pkg/adapter"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type pingAuth struct {
+	adapter.Auth
+	err error
+}
+
+func (a pingAuth) Ping(context.Context) error {
+	return a.err
+}
+
+func TestHealthService_Ready(t *testing.T) {
+	t.Parallel()
+
+	stopped, stop := context.WithCancel(context.Background())
+	stop()
+
+	tests := []struct {
+		name        string
+		shutdown    context.Context
+		dbErr       error
+		authErr     error
+		expectedErr error
+	}{
+		{
+			name:     "ready",
+			shutdown: context.Background(),
+		},
+		{
+			name:        "shutting down",
+			shutdown:    stopped,
+			expectedErr: service.ErrShuttingDown,
+		},
+		{
+			name:        "database unavailable",
+			shutdown:    context.Background(),
+			dbErr:       errors.New("connection refused"),
+			expectedErr: service.ErrDatabaseUnavailable,
+		},
+		{
+			name:        "auth provider unavailable",
+			shutdown:    context.Background(),
+			authErr:     errors.New("no signing keys"),
+			expectedErr: service.ErrAuthProviderUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(storage.MockHealthRepository)
+			if tt.shutdown.Err() == nil {
+				repo.EXPECT().Ping(mock.Anything).Return(tt.dbErr)
+			}
+			svc := NewHealthService(tt.shutdown, repo, pingAuth{err: tt.authErr})
+
+			err := svc.Ready(context.Background())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
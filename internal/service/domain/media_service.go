@@ -0,0 +1,294 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/utils/imaging"
+	"locpack-backend/pkg/utils/random"
+)
+
+const (
+	maxMediaPixels = 50_000_000
+	thumbnailSize  = 320
+	maxPlaceMedia  = 30
+)
+
+var mediaExtensions = map[string]string{
+	imaging.ContentTypeJPEG: ".jpg",
+	imaging.ContentTypePNG:  ".png",
+}
+
+type mediaServiceImpl struct {
+	mediaRepository storage.MediaRepository
+	placeRepository storage.PlaceRepository
+	packRepository  storage.PackRepository
+	userRepository  storage.UserRepository
+	blobStorage     adapter.BlobStorage
+	unitOfWork      storage.UnitOfWork
+}
+
+func NewMediaService(
+	mediaRepository storage.MediaRepository,
+	placeRepository storage.PlaceRepository,
+	packRepository storage.PackRepository,
+	userRepository storage.UserRepository,
+	blobStorage adapter.BlobStorage,
+	unitOfWork storage.UnitOfWork,
+) service.MediaService {
+	return &mediaServiceImpl{mediaRepository, placeRepository, packRepository, userRepository, blobStorage, unitOfWork}
+}
+
+// AddToPlace adds a photo to the gallery of the place. Any user may add
+// photos to a place.
+func (s *mediaServiceImpl) AddToPlace(ctx context.Context, placeID string, userID string, mu model.MediaUpload) (model.Media, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Media{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	placeEntity, err := s.placeRepository.GetByPublicIDFull(ctx, placeID)
+	if err != nil {
+		return model.Media{}, storageError(err, service.ErrPlaceNotFound)
+	}
+
+	if len(placeEntity.Media) >= maxPlaceMedia {
+		return model.Media{}, service.ErrMediaLimit
+	}
+
+	mediaEntity, err := s.upload(ctx, userEntity, "places/"+placeEntity.PublicID, mu)
+	if err != nil {
+		return model.Media{}, err
+	}
+	mediaEntity.PlaceID = &placeEntity.ID
+
+	// The gallery is counted again with the place locked, as concurrent
+	// uploads may have filled it meanwhile.
+	_, err = atomically(ctx, s.unitOfWork, func(ctx context.Context) (struct{}, error) {
+		placeEntity, err := s.placeRepository.GetByPublicIDFull(ctx, placeID)
+		if err != nil {
+			return struct{}{}, storageError(err, service.ErrPlaceNotFound)
+		}
+		if len(placeEntity.Media) >= maxPlaceMedia {
+			return struct{}{}, service.ErrMediaLimit
+		}
+		return struct{}{}, s.mediaRepository.Create(ctx, mediaEntity)
+	})
+	if err != nil {
+		s.deleteBlobs(ctx, mediaEntity)
+		return model.Media{}, err
+	}
+
+	return mapMediaEntityToModel(mediaEntity), nil
+}
+
+// DeleteFromPlace removes a photo from the gallery of the place. It is
+// allowed to the user who added the photo and to the author of the place.
+func (s *mediaServiceImpl) DeleteFromPlace(ctx context.Context, placeID string, mediaID string, userID string) error {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return storageError(err, service.ErrUserNotFound)
+	}
+
+	placeEntity, err := s.placeRepository.GetByPublicID(ctx, placeID)
+	if err != nil {
+		return storageError(err, service.ErrPlaceNotFound)
+	}
+
+	mediaEntity, err := s.mediaRepository.GetByPublicID(ctx, mediaID)
+	if err != nil {
+		return storageError(err, service.ErrMediaNotFound)
+	}
+
+	if mediaEntity.PlaceID == nil || *mediaEntity.PlaceID != placeEntity.ID {
+		return service.ErrMediaNotFound
+	}
+	if mediaEntity.AuthorID != userEntity.ID && placeEntity.AuthorID != userEntity.ID {
+		return service.ErrNotAuthor
+	}
+
+	err = s.mediaRepository.Delete(ctx, mediaEntity)
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, mediaEntity)
+
+	return nil
+}
+
+// SetPackCover replaces the cover of the pack, which is allowed to the users
+// who can edit the pack.
+func (s *mediaServiceImpl) SetPackCover(ctx context.Context, packID string, userID string, mu model.MediaUpload) (model.Media, error) {
+	userEntity, packEntity, err := s.getEditablePack(ctx, packID, userID)
+	if err != nil {
+		return model.Media{}, err
+	}
+
+	mediaEntity, err := s.upload(ctx, userEntity, "packs/"+packEntity.PublicID, mu)
+	if err != nil {
+		return model.Media{}, err
+	}
+	mediaEntity.PackID = &packEntity.ID
+
+	// The pack is loaded again with its row locked, so that the cover
+	// replaced is the current one even when covers are set concurrently.
+	previous, err := atomically(ctx, s.unitOfWork, func(ctx context.Context) (*entity.Media, error) {
+		_, packEntity, err := s.getEditablePack(ctx, packID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if packEntity.Cover != nil {
+			err := s.mediaRepository.Delete(ctx, *packEntity.Cover)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return packEntity.Cover, s.mediaRepository.Create(ctx, mediaEntity)
+	})
+	if err != nil {
+		s.deleteBlobs(ctx, mediaEntity)
+		return model.Media{}, err
+	}
+
+	if previous != nil {
+		s.deleteBlobs(ctx, *previous)
+	}
+
+	return mapMediaEntityToModel(mediaEntity), nil
+}
+
+func (s *mediaServiceImpl) DeletePackCover(ctx context.Context, packID string, userID string) error {
+	_, packEntity, err := s.getEditablePack(ctx, packID, userID)
+	if err != nil {
+		return err
+	}
+
+	if packEntity.Cover == nil {
+		return service.ErrMediaNotFound
+	}
+
+	err = s.mediaRepository.Delete(ctx, *packEntity.Cover)
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, *packEntity.Cover)
+
+	return nil
+}
+
+func (s *mediaServiceImpl) getEditablePack(ctx context.Context, packID string, userID string) (entity.User, entity.Pack, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return entity.User{}, entity.Pack{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	packEntity, err := s.packRepository.GetByPublicIDFull(ctx, packID, userID)
+	if err != nil {
+		return entity.User{}, entity.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+
+	if !canEdit(packEntity, userEntity) {
+		return entity.User{}, entity.Pack{}, service.ErrNotEditor
+	}
+
+	return userEntity, packEntity, nil
+}
+
+// upload validates the image, strips its metadata and stores it with its
+// thumbnail under the prefix. The returned media is not attached to anything
+// yet.
+func (s *mediaServiceImpl) upload(ctx context.Context, userEntity entity.User, prefix string, mu model.MediaUpload) (entity.Media, error) {
+	if len(mu.Data) > model.MaxMediaSize {
+		return entity.Media{}, service.ErrMediaTooLarge
+	}
+
+	original, thumbnail, err := imaging.Process(mu.Data, maxMediaPixels, thumbnailSize)
+	if errors.Is(err, imaging.ErrUnsupportedFormat) {
+		return entity.Media{}, service.ErrUnsupportedMedia
+	}
+	if err != nil {
+		return entity.Media{}, service.ErrInvalidImage.Wrap(err)
+	}
+
+	publicID := random.GeneratePublicID()
+	extension := mediaExtensions[original.ContentType]
+	mediaEntity := entity.Media{
+		ID:           random.GenerateID(),
+		PublicID:     publicID,
+		AuthorID:     userEntity.ID,
+		ContentType:  original.ContentType,
+		Width:        original.Width,
+		Height:       original.Height,
+		Size:         int64(len(original.Data)),
+		Key:          prefix + "/" + publicID + extension,
+		ThumbnailKey: prefix + "/" + publicID + "_thumb" + extension,
+	}
+	mediaEntity.URL = s.blobStorage.URL(mediaEntity.Key)
+	mediaEntity.ThumbnailURL = s.blobStorage.URL(mediaEntity.ThumbnailKey)
+
+	err = s.blobStorage.Put(ctx, mediaEntity.Key, original.ContentType, original.Data)
+	if err != nil {
+		return entity.Media{}, service.ErrBlobStorage.Wrap(err)
+	}
+
+	err = s.blobStorage.Put(ctx, mediaEntity.ThumbnailKey, thumbnail.ContentType, thumbnail.Data)
+	if err != nil {
+		s.deleteBlobs(ctx, mediaEntity)
+		return entity.Media{}, service.ErrBlobStorage.Wrap(err)
+	}
+
+	return mediaEntity, nil
+}
+
+// deleteBlobs removes the files of media that is no longer referenced. It
+// outlives the request, so that files are not left behind when the request
+// is cancelled; failures only leave unreachable files.
+func (s *mediaServiceImpl) deleteBlobs(ctx context.Context, mediaEntity entity.Media) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), compensationTimeout)
+	defer cancel()
+
+	for _, key := range []string{mediaEntity.Key, mediaEntity.ThumbnailKey} {
+		err := s.blobStorage.Delete(ctx, key)
+		if err != nil {
+			log.Printf("media: delete blob %s: %v", key, err)
+		}
+	}
+}
+
+func mapMediaEntityToModel(mediaEntity entity.Media) model.Media {
+	return model.Media{
+		ID:           mediaEntity.PublicID,
+		URL:          mediaEntity.URL,
+		ThumbnailURL: mediaEntity.ThumbnailURL,
+		ContentType:  mediaEntity.ContentType,
+		Width:        mediaEntity.Width,
+		Height:       mediaEntity.Height,
+	}
+}
+
+// mapMediaEntitiesToModels returns the gallery of a place, or nil when it is
+// empty.
+func mapMediaEntitiesToModels(mediaEntities []entity.Media) []model.Media {
+	var media []model.Media
+	for _, mediaEntity := range mediaEntities {
+		media = append(media, mapMediaEntityToModel(mediaEntity))
+	}
+	return media
+}
+
+// mapCoverEntityToModel returns the cover of a pack, or nil when it has none.
+func mapCoverEntityToModel(coverEntity *entity.Media) *model.Media {
+	if coverEntity == nil {
+		return nil
+	}
+	cover := mapMediaEntityToModel(*coverEntity)
+	return &cover
+}
@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/collaborator_role"
+)
+
+// memoryBlobs keeps files in memory.
+type memoryBlobs struct {
+	files map[string][]byte
+}
+
+func (b *memoryBlobs) Put(_ context.Context, key string, _ string, data []byte) error {
+	b.files[key] = data
+	return nil
+}
+
+func (b *memoryBlobs) Delete(_ context.Context, key string) error {
+	delete(b.files, key)
+	return nil
+}
+
+func (b *memoryBlobs) URL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, image.NewNRGBA(image.Rect(0, 0, 640, 480))))
+	return buffer.Bytes()
+}
+
+func TestMediaService_AddToPlace(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	placeUUID := uuid.New()
+	createErr := errors.New("create failed")
+
+	tests := []struct {
+		name        string
+		data        []byte
+		media       []entity.Media
+		lockedMedia []entity.Media
+		createErr   error
+		expectedErr error
+		files       int
+	}{
+		{name: "success", data: testPNG(t), files: 2},
+		{name: "unsupported format", data: []byte("GIF89a"), expectedErr: service.ErrUnsupportedMedia},
+		{name: "too large", data: make([]byte, model.MaxMediaSize+1), expectedErr: service.ErrMediaTooLarge},
+		{name: "corrupted image", data: testPNG(t)[:100], expectedErr: service.ErrInvalidImage},
+		{name: "gallery full", data: testPNG(t), media: make([]entity.Media, maxPlaceMedia), expectedErr: service.ErrMediaLimit},
+		{name: "gallery filled meanwhile", data: testPNG(t), lockedMedia: make([]entity.Media, maxPlaceMedia), expectedErr: service.ErrMediaLimit},
+		{name: "create fails", data: testPNG(t), createErr: createErr, expectedErr: createErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mediaSvc, mediaRepo, placeRepo, _, userRepo, blobs := setupMediaTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1"}, nil)
+			placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, "place1").Return(entity.Place{ID: placeUUID, PublicID: "place1", Media: tt.media}, nil).Once()
+			placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, "place1").Return(entity.Place{ID: placeUUID, PublicID: "place1", Media: append(tt.media, tt.lockedMedia...)}, nil).Maybe()
+			mediaRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(m entity.Media) bool {
+				return *m.PlaceID == placeUUID && m.PackID == nil && m.AuthorID == userUUID && m.ContentType == "image/png"
+			})).Return(tt.createErr).Maybe()
+
+			result, err := mediaSvc.AddToPlace(context.Background(), "place1", "user1", model.MediaUpload{Data: tt.data})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, 640, result.Width)
+				assert.Equal(t, 480, result.Height)
+				assert.Equal(t, "https://cdn.example.com/places/place1/"+result.ID+".png", result.URL)
+				assert.Equal(t, "https://cdn.example.com/places/place1/"+result.ID+"_thumb.png", result.ThumbnailURL)
+			}
+			assert.Len(t, blobs.files, tt.files)
+		})
+	}
+}
+
+func TestMediaService_DeleteFromPlace(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	otherUUID := uuid.New()
+	placeUUID := uuid.New()
+	otherPlaceUUID := uuid.New()
+
+	tests := []struct {
+		name        string
+		placeAuthor uuid.UUID
+		mediaAuthor uuid.UUID
+		mediaPlace  uuid.UUID
+		expectedErr error
+	}{
+		{name: "media author", placeAuthor: otherUUID, mediaAuthor: userUUID, mediaPlace: placeUUID},
+		{name: "place author", placeAuthor: userUUID, mediaAuthor: otherUUID, mediaPlace: placeUUID},
+		{name: "not author", placeAuthor: otherUUID, mediaAuthor: otherUUID, mediaPlace: placeUUID, expectedErr: service.ErrNotAuthor},
+		{name: "other place", placeAuthor: userUUID, mediaAuthor: userUUID, mediaPlace: otherPlaceUUID, expectedErr: service.ErrMediaNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mediaSvc, mediaRepo, placeRepo, _, userRepo, blobs := setupMediaTest(t)
+			blobs.files["places/place1/media1.png"] = []byte("original")
+			blobs.files["places/place1/media1_thumb.png"] = []byte("thumbnail")
+
+			mediaEntity := entity.Media{
+				PublicID:     "media1",
+				AuthorID:     tt.mediaAuthor,
+				PlaceID:      &tt.mediaPlace,
+				Key:          "places/place1/media1.png",
+				ThumbnailKey: "places/place1/media1_thumb.png",
+			}
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1"}, nil)
+			placeRepo.EXPECT().GetByPublicID(mock.Anything, "place1").Return(entity.Place{ID: placeUUID, AuthorID: tt.placeAuthor}, nil)
+			mediaRepo.EXPECT().GetByPublicID(mock.Anything, "media1").Return(mediaEntity, nil)
+			if tt.expectedErr == nil {
+				mediaRepo.EXPECT().Delete(mock.Anything, mediaEntity).Return(nil).Once()
+			}
+
+			err := mediaSvc.DeleteFromPlace(context.Background(), "place1", "media1", "user1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Len(t, blobs.files, 2)
+			} else {
+				assert.NoError(t, err)
+				assert.Empty(t, blobs.files)
+			}
+			mediaRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMediaService_SetPackCover(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	packUUID := uuid.New()
+	acceptedAt := time.Now()
+
+	oldCover := entity.Media{
+		ID:           uuid.New(),
+		PublicID:     "old",
+		PackID:       &packUUID,
+		Key:          "packs/pack1/old.png",
+		ThumbnailKey: "packs/pack1/old_thumb.png",
+	}
+
+	tests := []struct {
+		name        string
+		pack        entity.Pack
+		lockedCover *entity.Media
+		expectedErr error
+	}{
+		{
+			name: "author replaces cover",
+			pack: entity.Pack{ID: packUUID, PublicID: "pack1", AuthorID: userUUID, Cover: &oldCover},
+		},
+		{
+			name:        "cover set meanwhile",
+			pack:        entity.Pack{ID: packUUID, PublicID: "pack1", AuthorID: userUUID},
+			lockedCover: &oldCover,
+		},
+		{
+			name: "editor sets cover",
+			pack: entity.Pack{
+				ID:       packUUID,
+				PublicID: "pack1",
+				AuthorID: uuid.New(),
+				Collaborators: []entity.PackCollaborator{
+					{UserID: userUUID, Role: collaborator_role.Editor, AcceptedAt: &acceptedAt},
+				},
+			},
+		},
+		{
+			name: "viewer",
+			pack: entity.Pack{
+				ID:       packUUID,
+				PublicID: "pack1",
+				AuthorID: uuid.New(),
+				Collaborators: []entity.PackCollaborator{
+					{UserID: userUUID, Role: collaborator_role.Viewer, AcceptedAt: &acceptedAt},
+				},
+			},
+			expectedErr: service.ErrNotEditor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mediaSvc, mediaRepo, _, packRepo, userRepo, blobs := setupMediaTest(t)
+			blobs.files[oldCover.Key] = []byte("original")
+			blobs.files[oldCover.ThumbnailKey] = []byte("thumbnail")
+
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1"}, nil)
+			lockedPack := tt.pack
+			if tt.lockedCover != nil {
+				lockedPack.Cover = tt.lockedCover
+			}
+			packRepo.EXPECT().GetByPublicIDFull(mock.Anything, "pack1", "user1").Return(tt.pack, nil).Once()
+			packRepo.EXPECT().GetByPublicIDFull(mock.Anything, "pack1", "user1").Return(lockedPack, nil).Maybe()
+			if tt.expectedErr == nil {
+				if lockedPack.Cover != nil {
+					mediaRepo.EXPECT().Delete(mock.Anything, oldCover).Return(nil).Once()
+				}
+				mediaRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(m entity.Media) bool {
+					return *m.PackID == packUUID && m.PlaceID == nil
+				})).Return(nil).Once()
+			}
+
+			result, err := mediaSvc.SetPackCover(context.Background(), "pack1", "user1", model.MediaUpload{Data: testPNG(t)})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Len(t, blobs.files, 2)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "https://cdn.example.com/packs/pack1/"+result.ID+".png", result.URL)
+				if lockedPack.Cover != nil {
+					assert.NotContains(t, blobs.files, oldCover.Key)
+					assert.Len(t, blobs.files, 2)
+				} else {
+					assert.Len(t, blobs.files, 4)
+				}
+			}
+			mediaRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMediaService_DeletePackCover_NoCover(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+
+	mediaSvc, _, _, packRepo, userRepo, _ := setupMediaTest(t)
+	userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1"}, nil)
+	packRepo.EXPECT().GetByPublicIDFull(mock.Anything, "pack1", "user1").Return(entity.Pack{PublicID: "pack1", AuthorID: userUUID}, nil)
+
+	err := mediaSvc.DeletePackCover(context.Background(), "pack1", "user1")
+
+	assert.ErrorIs(t, err, service.ErrMediaNotFound)
+}
@@ -0,0 +1,297 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/moderation_action"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
+	"locpack-backend/pkg/utils/random"
+)
+
+const (
+	targetPlace  = "place"
+	targetPack   = "pack"
+	targetUser   = "user"
+	targetReview = "review"
+)
+
+type moderationServiceImpl struct {
+	moderationRepository storage.ModerationRepository
+	placeRepository      storage.PlaceRepository
+	packRepository       storage.PackRepository
+	userRepository       storage.UserRepository
+	reviewRepository     storage.ReviewRepository
+	unitOfWork           storage.UnitOfWork
+}
+
+func NewModerationService(
+	moderationRepository storage.ModerationRepository,
+	placeRepository storage.PlaceRepository,
+	packRepository storage.PackRepository,
+	userRepository storage.UserRepository,
+	reviewRepository storage.ReviewRepository,
+	unitOfWork storage.UnitOfWork,
+) service.ModerationService {
+	return &moderationServiceImpl{moderationRepository, placeRepository, packRepository, userRepository, reviewRepository, unitOfWork}
+}
+
+func (s *moderationServiceImpl) GetLog(ctx context.Context, page model.Page) ([]model.ModerationAction, model.PageInfo, error) {
+	limit, after, err := pageBounds(page, 0)
+	if err != nil {
+		return []model.ModerationAction{}, model.PageInfo{}, err
+	}
+
+	actionsEntities, total, err := s.moderationRepository.GetAllFull(ctx, limit+1, after)
+	if err != nil {
+		return []model.ModerationAction{}, model.PageInfo{}, err
+	}
+	actionsEntities, info := pageOf(actionsEntities, limit, total, func(e entity.ModerationAction) cursor.Cursor {
+		return cursor.Cursor{Time: e.CreatedAt, ID: e.PublicID}
+	})
+
+	actions := []model.ModerationAction{}
+	for _, actionEntity := range actionsEntities {
+		actions = append(actions, model.ModerationAction{
+			ID:        actionEntity.PublicID,
+			CreatedAt: actionEntity.CreatedAt,
+			Moderator: model.User{
+				ID:       actionEntity.Moderator.PublicID,
+				Username: actionEntity.Moderator.Username,
+			},
+			Action:     actionEntity.Action,
+			TargetType: actionEntity.TargetType,
+			TargetID:   actionEntity.TargetID,
+			Reason:     actionEntity.Reason,
+		})
+	}
+
+	return actions, info, nil
+}
+
+func (s *moderationServiceImpl) HidePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error {
+	return s.moderatePlace(ctx, placeID, moderatorID, moderation_action.HidePlace, m, func(ctx context.Context, p entity.Place) error {
+		now := time.Now()
+		return s.placeRepository.SetHidden(ctx, p, &now)
+	})
+}
+
+func (s *moderationServiceImpl) UnhidePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error {
+	return s.moderatePlace(ctx, placeID, moderatorID, moderation_action.UnhidePlace, m, func(ctx context.Context, p entity.Place) error {
+		return s.placeRepository.SetHidden(ctx, p, nil)
+	})
+}
+
+// DeletePlace hides the place before deleting it, so that restoring it by
+// the author does not bring the removed content back.
+func (s *moderationServiceImpl) DeletePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error {
+	return s.moderatePlace(ctx, placeID, moderatorID, moderation_action.DeletePlace, m, func(ctx context.Context, p entity.Place) error {
+		now := time.Now()
+		err := s.placeRepository.SetHidden(ctx, p, &now)
+		if err != nil {
+			return err
+		}
+		return s.placeRepository.Delete(ctx, p)
+	})
+}
+
+func (s *moderationServiceImpl) HidePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error {
+	return s.moderatePack(ctx, packID, moderatorID, moderation_action.HidePack, m, func(ctx context.Context, p entity.Pack) error {
+		now := time.Now()
+		return s.packRepository.SetHidden(ctx, p, &now)
+	})
+}
+
+func (s *moderationServiceImpl) UnhidePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error {
+	return s.moderatePack(ctx, packID, moderatorID, moderation_action.UnhidePack, m, func(ctx context.Context, p entity.Pack) error {
+		return s.packRepository.SetHidden(ctx, p, nil)
+	})
+}
+
+// DeletePack hides the pack before deleting it for the same reason as
+// DeletePlace.
+func (s *moderationServiceImpl) DeletePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error {
+	return s.moderatePack(ctx, packID, moderatorID, moderation_action.DeletePack, m, func(ctx context.Context, p entity.Pack) error {
+		now := time.Now()
+		err := s.packRepository.SetHidden(ctx, p, &now)
+		if err != nil {
+			return err
+		}
+		return s.packRepository.Delete(ctx, p)
+	})
+}
+
+func (s *moderationServiceImpl) TransferPack(ctx context.Context, packID string, moderatorID string, pt model.PackTransfer) error {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, pt.UserID)
+	if err != nil {
+		return storageError(err, service.ErrUserNotFound)
+	}
+	if userEntity.BannedAt != nil {
+		return service.ErrUserBanned
+	}
+
+	m := model.Moderation{Reason: pt.Reason}
+	return s.moderatePack(ctx, packID, moderatorID, moderation_action.TransferPack, m, func(ctx context.Context, p entity.Pack) error {
+		return s.packRepository.SetAuthor(ctx, p, userEntity.ID)
+	})
+}
+
+// MergePlace merges a duplicate place into another place regardless of who
+// created them.
+func (s *moderationServiceImpl) MergePlace(ctx context.Context, placeID string, moderatorID string, pm model.PlaceMerge) error {
+	m := model.Moderation{Reason: pm.Reason}
+	return s.moderatePlace(ctx, placeID, moderatorID, moderation_action.MergePlace, m, func(ctx context.Context, p entity.Place) error {
+		return mergePlace(ctx, s.placeRepository, p, pm.IntoID)
+	})
+}
+
+// DeleteReview removes a review of any user.
+func (s *moderationServiceImpl) DeleteReview(ctx context.Context, reviewID string, moderatorID string, m model.Moderation) error {
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		moderatorEntity, err := s.userRepository.GetByPublicID(ctx, moderatorID)
+		if err != nil {
+			return storageError(err, service.ErrUserNotFound)
+		}
+
+		reviewEntity, err := s.reviewRepository.GetByPublicID(ctx, reviewID)
+		if err != nil {
+			return storageError(err, service.ErrReviewNotFound)
+		}
+
+		err = s.reviewRepository.Delete(ctx, reviewEntity)
+		if err != nil {
+			return err
+		}
+
+		return s.log(ctx, moderatorEntity, moderation_action.DeleteReview, targetReview, reviewEntity.PublicID, m)
+	})
+}
+
+func (s *moderationServiceImpl) BanUser(ctx context.Context, userID string, moderatorID string, m model.Moderation) error {
+	return s.moderateUser(ctx, userID, moderatorID, moderation_action.BanUser, m, func(ctx context.Context, u entity.User) error {
+		now := time.Now()
+		u.BannedAt = &now
+		u.BanReason = m.Reason
+		return s.userRepository.Update(ctx, u)
+	})
+}
+
+func (s *moderationServiceImpl) UnbanUser(ctx context.Context, userID string, moderatorID string, m model.Moderation) error {
+	return s.moderateUser(ctx, userID, moderatorID, moderation_action.UnbanUser, m, func(ctx context.Context, u entity.User) error {
+		u.BannedAt = nil
+		u.BanReason = ""
+		return s.userRepository.Update(ctx, u)
+	})
+}
+
+func (s *moderationServiceImpl) moderatePlace(
+	ctx context.Context,
+	placeID string,
+	moderatorID string,
+	action types.ModerationAction,
+	m model.Moderation,
+	apply func(ctx context.Context, p entity.Place) error,
+) error {
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		moderatorEntity, err := s.userRepository.GetByPublicID(ctx, moderatorID)
+		if err != nil {
+			return storageError(err, service.ErrUserNotFound)
+		}
+
+		placeEntity, err := s.placeRepository.GetByPublicID(ctx, placeID)
+		if err != nil {
+			return storageError(err, service.ErrPlaceNotFound)
+		}
+
+		err = apply(ctx, placeEntity)
+		if err != nil {
+			return err
+		}
+
+		return s.log(ctx, moderatorEntity, action, targetPlace, placeEntity.PublicID, m)
+	})
+}
+
+func (s *moderationServiceImpl) moderatePack(
+	ctx context.Context,
+	packID string,
+	moderatorID string,
+	action types.ModerationAction,
+	m model.Moderation,
+	apply func(ctx context.Context, p entity.Pack) error,
+) error {
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		moderatorEntity, err := s.userRepository.GetByPublicID(ctx, moderatorID)
+		if err != nil {
+			return storageError(err, service.ErrUserNotFound)
+		}
+
+		packEntity, err := s.packRepository.GetByPublicID(ctx, packID)
+		if err != nil {
+			return storageError(err, service.ErrPackNotFound)
+		}
+
+		err = apply(ctx, packEntity)
+		if err != nil {
+			return err
+		}
+
+		return s.log(ctx, moderatorEntity, action, targetPack, packEntity.PublicID, m)
+	})
+}
+
+func (s *moderationServiceImpl) moderateUser(
+	ctx context.Context,
+	userID string,
+	moderatorID string,
+	action types.ModerationAction,
+	m model.Moderation,
+	apply func(ctx context.Context, u entity.User) error,
+) error {
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		moderatorEntity, err := s.userRepository.GetByPublicID(ctx, moderatorID)
+		if err != nil {
+			return storageError(err, service.ErrUserNotFound)
+		}
+
+		userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+		if err != nil {
+			return storageError(err, service.ErrUserNotFound)
+		}
+		if userEntity.ID == moderatorEntity.ID {
+			return service.ErrSelfModeration
+		}
+
+		err = apply(ctx, userEntity)
+		if err != nil {
+			return err
+		}
+
+		return s.log(ctx, moderatorEntity, action, targetUser, userEntity.PublicID, m)
+	})
+}
+
+func (s *moderationServiceImpl) log(
+	ctx context.Context,
+	moderatorEntity entity.User,
+	action types.ModerationAction,
+	targetType string,
+	targetID string,
+	m model.Moderation,
+) error {
+	return s.moderationRepository.Create(ctx, entity.ModerationAction{
+		ID:          uuid.New(),
+		PublicID:    random.GeneratePublicID(),
+		ModeratorID: moderatorEntity.ID,
+		Action:      action,
+		TargetType:  targetType,
+		TargetID:    targetID,
+		Reason:      m.Reason,
+	})
+}
@@ -0,0 +1,386 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/moderation_action"
+	"locpack-backend/pkg/utils/cursor"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type moderationMocks struct {
+	moderationRepo *storage.MockModerationRepository
+	placeRepo      *storage.MockPlaceRepository
+	packRepo       *storage.MockPackRepository
+	userRepo       *storage.MockUserRepository
+	reviewRepo     *storage.MockReviewRepository
+}
+
+func setupModerationServiceTest(t *testing.T) (*moderationServiceImpl, moderationMocks) {
+	t.Helper()
+
+	m := moderationMocks{
+		moderationRepo: new(storage.MockModerationRepository),
+		placeRepo:      new(storage.MockPlaceRepository),
+		packRepo:       new(storage.MockPackRepository),
+		userRepo:       new(storage.MockUserRepository),
+		reviewRepo:     new(storage.MockReviewRepository),
+	}
+	svc := NewModerationService(m.moderationRepo, m.placeRepo, m.packRepo, m.userRepo, m.reviewRepo, inlineUnitOfWork{}).(*moderationServiceImpl)
+
+	return svc, m
+}
+
+func loggedAction(action string, targetType string, targetID string, reason string) interface{} {
+	return mock.MatchedBy(func(a entity.ModerationAction) bool {
+		return a.PublicID != "" && a.Action == action && a.TargetType == targetType && a.TargetID == targetID && a.Reason == reason
+	})
+}
+
+func TestModerationService_HidePlace(t *testing.T) {
+	t.Parallel()
+
+	moderator := entity.User{ID: uuid.New(), PublicID: "mod"}
+	place := entity.Place{ID: uuid.New(), PublicID: "place123"}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(m moderationMocks)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.placeRepo.EXPECT().GetByPublicID(mock.Anything, "place123").Return(place, nil)
+				m.placeRepo.EXPECT().SetHidden(mock.Anything, place, mock.MatchedBy(func(at *time.Time) bool { return at != nil })).Return(nil)
+				m.moderationRepo.EXPECT().Create(mock.Anything, loggedAction(moderation_action.HidePlace, targetPlace, "place123", "spam")).Return(nil)
+			},
+		},
+		{
+			name: "place not found",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.placeRepo.EXPECT().GetByPublicID(mock.Anything, "place123").Return(entity.Place{}, storage.ErrNotFound)
+			},
+			expectedErr: service.ErrPlaceNotFound,
+		},
+		{
+			name: "moderator not found",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(entity.User{}, storage.ErrNotFound)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := setupModerationServiceTest(t)
+			tt.setupMocks(m)
+
+			err := svc.HidePlace(context.Background(), "place123", "mod", model.Moderation{Reason: "spam"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			m.userRepo.AssertExpectations(t)
+			m.placeRepo.AssertExpectations(t)
+			m.moderationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationService_DeletePack(t *testing.T) {
+	t.Parallel()
+
+	moderator := entity.User{ID: uuid.New(), PublicID: "mod"}
+	pack := entity.Pack{ID: uuid.New(), PublicID: "pack123"}
+
+	svc, m := setupModerationServiceTest(t)
+	m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+	m.packRepo.EXPECT().GetByPublicID(mock.Anything, "pack123").Return(pack, nil)
+	m.packRepo.EXPECT().SetHidden(mock.Anything, pack, mock.MatchedBy(func(at *time.Time) bool { return at != nil })).Return(nil)
+	m.packRepo.EXPECT().Delete(mock.Anything, pack).Return(nil)
+	m.moderationRepo.EXPECT().Create(mock.Anything, loggedAction(moderation_action.DeletePack, targetPack, "pack123", "")).Return(nil)
+
+	err := svc.DeletePack(context.Background(), "pack123", "mod", model.Moderation{})
+
+	assert.NoError(t, err)
+	m.packRepo.AssertExpectations(t)
+	m.moderationRepo.AssertExpectations(t)
+}
+
+func TestModerationService_TransferPack(t *testing.T) {
+	t.Parallel()
+
+	moderator := entity.User{ID: uuid.New(), PublicID: "mod"}
+	pack := entity.Pack{ID: uuid.New(), PublicID: "pack123"}
+	bannedAt := time.Now()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(m moderationMocks)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMocks: func(m moderationMocks) {
+				newAuthor := entity.User{ID: uuid.New(), PublicID: "john"}
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "john").Return(newAuthor, nil)
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.packRepo.EXPECT().GetByPublicID(mock.Anything, "pack123").Return(pack, nil)
+				m.packRepo.EXPECT().SetAuthor(mock.Anything, pack, newAuthor.ID).Return(nil)
+				m.moderationRepo.EXPECT().Create(mock.Anything, loggedAction(moderation_action.TransferPack, targetPack, "pack123", "abandoned")).Return(nil)
+			},
+		},
+		{
+			name: "new author is banned",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "john").Return(entity.User{ID: uuid.New(), BannedAt: &bannedAt}, nil)
+			},
+			expectedErr: service.ErrUserBanned,
+		},
+		{
+			name: "new author not found",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "john").Return(entity.User{}, storage.ErrNotFound)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := setupModerationServiceTest(t)
+			tt.setupMocks(m)
+
+			err := svc.TransferPack(context.Background(), "pack123", "mod", model.PackTransfer{UserID: "john", Reason: "abandoned"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			m.userRepo.AssertExpectations(t)
+			m.packRepo.AssertExpectations(t)
+			m.moderationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationService_MergePlace(t *testing.T) {
+	t.Parallel()
+
+	moderator := entity.User{ID: uuid.New(), PublicID: "mod"}
+	place := entity.Place{ID: uuid.New(), PublicID: "place123", AuthorID: uuid.New()}
+	into := entity.Place{ID: uuid.New(), PublicID: "place456"}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(m moderationMocks)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.placeRepo.EXPECT().GetByPublicID(mock.Anything, "place123").Return(place, nil)
+				m.placeRepo.EXPECT().GetByPublicID(mock.Anything, "place456").Return(into, nil)
+				m.placeRepo.EXPECT().Merge(mock.Anything, place, into).Return(nil)
+				m.moderationRepo.EXPECT().Create(mock.Anything, loggedAction(moderation_action.MergePlace, targetPlace, "place123", "duplicate")).Return(nil)
+			},
+		},
+		{
+			name: "surviving place not found",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.placeRepo.EXPECT().GetByPublicID(mock.Anything, "place123").Return(place, nil)
+				m.placeRepo.EXPECT().GetByPublicID(mock.Anything, "place456").Return(entity.Place{}, storage.ErrNotFound)
+			},
+			expectedErr: service.ErrPlaceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := setupModerationServiceTest(t)
+			tt.setupMocks(m)
+
+			err := svc.MergePlace(context.Background(), "place123", "mod", model.PlaceMerge{IntoID: "place456", Reason: "duplicate"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			m.userRepo.AssertExpectations(t)
+			m.placeRepo.AssertExpectations(t)
+			m.moderationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationService_DeleteReview(t *testing.T) {
+	t.Parallel()
+
+	moderator := entity.User{ID: uuid.New(), PublicID: "mod"}
+	review := entity.Review{ID: uuid.New(), PublicID: "review123", AuthorID: uuid.New()}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(m moderationMocks)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.reviewRepo.EXPECT().GetByPublicID(mock.Anything, "review123").Return(review, nil)
+				m.reviewRepo.EXPECT().Delete(mock.Anything, review).Return(nil)
+				m.moderationRepo.EXPECT().Create(mock.Anything, loggedAction(moderation_action.DeleteReview, targetReview, "review123", "spam")).Return(nil)
+			},
+		},
+		{
+			name: "review not found",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.reviewRepo.EXPECT().GetByPublicID(mock.Anything, "review123").Return(entity.Review{}, storage.ErrNotFound)
+			},
+			expectedErr: service.ErrReviewNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := setupModerationServiceTest(t)
+			tt.setupMocks(m)
+
+			err := svc.DeleteReview(context.Background(), "review123", "mod", model.Moderation{Reason: "spam"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			m.reviewRepo.AssertExpectations(t)
+			m.moderationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationService_BanUser(t *testing.T) {
+	t.Parallel()
+
+	moderator := entity.User{ID: uuid.New(), PublicID: "mod"}
+	user := entity.User{ID: uuid.New(), PublicID: "john"}
+
+	tests := []struct {
+		name        string
+		userID      string
+		setupMocks  func(m moderationMocks)
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			userID: "john",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "john").Return(user, nil)
+				m.userRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u entity.User) bool {
+					return u.ID == user.ID && u.BannedAt != nil && u.BanReason == "abuse"
+				})).Return(nil)
+				m.moderationRepo.EXPECT().Create(mock.Anything, loggedAction(moderation_action.BanUser, targetUser, "john", "abuse")).Return(nil)
+			},
+		},
+		{
+			name:   "self ban",
+			userID: "mod",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+			},
+			expectedErr: service.ErrSelfModeration,
+		},
+		{
+			name:   "update error",
+			userID: "john",
+			setupMocks: func(m moderationMocks) {
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "mod").Return(moderator, nil)
+				m.userRepo.EXPECT().GetByPublicID(mock.Anything, "john").Return(user, nil)
+				m.userRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := setupModerationServiceTest(t)
+			tt.setupMocks(m)
+
+			err := svc.BanUser(context.Background(), tt.userID, "mod", model.Moderation{Reason: "abuse"})
+
+			if tt.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			m.userRepo.AssertExpectations(t)
+			m.moderationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationService_GetLog(t *testing.T) {
+	t.Parallel()
+
+	svc, m := setupModerationServiceTest(t)
+	actionID := uuid.New()
+	createdAt := time.Now()
+	m.moderationRepo.EXPECT().GetAllFull(mock.Anything, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return([]entity.ModerationAction{
+		{
+			ID:         actionID,
+			CreatedAt:  createdAt,
+			PublicID:   "action1",
+			Moderator:  entity.User{PublicID: "mod", Username: "mod"},
+			Action:     moderation_action.HidePack,
+			TargetType: targetPack,
+			TargetID:   "pack123",
+			Reason:     "spam",
+		},
+	}, 1, nil)
+
+	actions, info, err := svc.GetLog(context.Background(), model.Page{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ModerationAction{
+		{
+			ID:         "action1",
+			CreatedAt:  createdAt,
+			Moderator:  model.User{ID: "mod", Username: "mod"},
+			Action:     moderation_action.HidePack,
+			TargetType: targetPack,
+			TargetID:   "pack123",
+			Reason:     "spam",
+		},
+	}, actions)
+	assert.Equal(t, model.PageInfo{Total: 1}, info)
+}
@@ -1,92 +1,149 @@
 package domain
 
 import (
+	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/internal/storage"
 	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/activity_type"
+	"locpack-backend/pkg/enum/collaborator_role"
+	"locpack-backend/pkg/enum/pack_progress"
 	"locpack-backend/pkg/enum/pack_status"
+	"locpack-backend/pkg/enum/pack_visibility"
 	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
 	"locpack-backend/pkg/utils/random"
+	"locpack-backend/pkg/utils/signature"
+
+	"github.com/google/uuid"
+	"strings"
 )
 
 type packServiceImpl struct {
-	packRepository  storage.PackRepository
-	placeRepository storage.PlaceRepository
-	userRepository  storage.UserRepository
+	packRepository         storage.PackRepository
+	placeRepository        storage.PlaceRepository
+	userRepository         storage.UserRepository
+	collaboratorRepository storage.PackCollaboratorRepository
+	shareLinkRepository    storage.ShareLinkRepository
+	activityRepository     storage.ActivityRepository
+	shareLinkSigner        *signature.Signer
+	unitOfWork             storage.UnitOfWork
 }
 
 func NewPackService(
 	packRepository storage.PackRepository,
 	placeRepository storage.PlaceRepository,
 	userRepository storage.UserRepository,
+	collaboratorRepository storage.PackCollaboratorRepository,
+	shareLinkRepository storage.ShareLinkRepository,
+	activityRepository storage.ActivityRepository,
+	shareLinkSigner *signature.Signer,
+	unitOfWork storage.UnitOfWork,
 ) service.PackService {
-	return &packServiceImpl{packRepository, placeRepository, userRepository}
+	return &packServiceImpl{
+		packRepository,
+		placeRepository,
+		userRepository,
+		collaboratorRepository,
+		shareLinkRepository,
+		activityRepository,
+		shareLinkSigner,
+		unitOfWork,
+	}
 }
 
-func (s *packServiceImpl) GetByID(packID string, userID string) (model.Pack, error) {
-	packEntity, err := s.packRepository.GetByPublicIDFull(packID)
+func (s *packServiceImpl) GetByID(ctx context.Context, packID string, userID string) (model.Pack, error) {
+	packEntity, err := s.packRepository.GetByPublicIDFull(ctx, packID, userID)
 	if err != nil {
-		return model.Pack{}, err
-	}
-
-	foundPack := model.Pack{
-		ID:     packEntity.PublicID,
-		Name:   packEntity.Name,
-		Status: s.getPackStatus(packEntity, userID),
-		Places: s.mapPlaceEntitiesToModels(packEntity.Places, userID),
-		Author: model.User{
-			ID:       packEntity.Author.PublicID,
-			Username: packEntity.Author.Username,
-		},
+		return model.Pack{}, storageError(err, service.ErrPackNotFound)
 	}
 
-	return foundPack, nil
+	return s.mapPackEntityToModel(packEntity, userID), nil
 }
 
-func (s *packServiceImpl) GetByNameOrAuthor(query string, userID string) ([]model.Pack, error) {
-	packsEntities, err := s.packRepository.GetByNameOrAuthorFull(query)
+func (s *packServiceImpl) GetByNameOrAuthor(ctx context.Context, query string, userID string, page model.Page) ([]model.Pack, model.PageInfo, error) {
+	scores := 0
+	if len(query) != 0 {
+		scores = 1
+	}
+	limit, after, err := pageBounds(page, scores)
+	if err != nil {
+		return []model.Pack{}, model.PageInfo{}, err
+	}
+
+	packsEntities, total, err := s.packRepository.GetByNameOrAuthorFull(ctx, query, userID, limit+1, after)
 	if err != nil {
-		return []model.Pack{}, err
+		return []model.Pack{}, model.PageInfo{}, err
 	}
+	packsEntities, info := pageOf(packsEntities, limit, total, func(p entity.Pack) cursor.Cursor {
+		c := cursor.Cursor{Time: p.CreatedAt, ID: p.PublicID}
+		if len(query) != 0 {
+			c.Scores = []float64{p.Rank}
+		}
+		return c
+	})
 
 	var foundPacks []model.Pack
 	for _, packEntity := range packsEntities {
 		pack := model.Pack{
-			ID:     packEntity.PublicID,
-			Name:   packEntity.Name,
-			Status: s.getPackStatus(packEntity, userID),
-			Places: s.mapPlaceEntitiesToModels(packEntity.Places, userID),
+			ID:         packEntity.PublicID,
+			Name:       packEntity.Name,
+			Visibility: packEntity.Visibility,
+			Version:    packEntity.Version,
+			Status:     s.getPackStatus(packEntity, userID),
+			Places:     s.mapPackPlaceEntitiesToModels(packEntity.PlaceEntries, userID),
+			Cover:      mapCoverEntityToModel(packEntity.Cover),
 			Author: model.User{
 				ID:       packEntity.Author.PublicID,
 				Username: packEntity.Author.Username,
 			},
+			NameHighlight: highlight(packEntity.NameHeadline),
 		}
 		foundPacks = append(foundPacks, pack)
 	}
 
-	return foundPacks, nil
+	return foundPacks, info, nil
 }
 
-func (s *packServiceImpl) GetFollowedByUserID(userID string) ([]model.Pack, error) {
-	userEntity, err := s.userRepository.GetByPublicIDFull(userID)
+// GetFollowedByUserID returns the packs followed by the user with their
+// progress. A progress of pack_progress.InProgress or pack_progress.Completed
+// keeps only the packs in that state; an empty progress keeps all of them.
+func (s *packServiceImpl) GetFollowedByUserID(ctx context.Context, userID string, progress types.PackProgress) ([]model.Pack, error) {
+	if progress != "" && progress != pack_progress.InProgress && progress != pack_progress.Completed {
+		return []model.Pack{}, service.ErrInvalidProgress
+	}
+
+	userEntity, err := s.userRepository.GetByPublicIDFull(ctx, userID)
 	if err != nil {
-		return []model.Pack{}, err
+		return []model.Pack{}, storageError(err, service.ErrUserNotFound)
 	}
 
 	var foundPacks []model.Pack
 	for _, packEntity := range userEntity.FollowedPacks {
+		places := s.mapPackPlaceEntitiesToModels(packEntity.PlaceEntries, userID)
 		pack := model.Pack{
-			ID:     packEntity.PublicID,
-			Name:   packEntity.Name,
-			Status: pack_status.Followed,
-			Places: s.mapPlaceEntitiesToModels(packEntity.Places, userID),
+			ID:         packEntity.PublicID,
+			Name:       packEntity.Name,
+			Visibility: packEntity.Visibility,
+			Version:    packEntity.Version,
+			Status:     pack_status.Followed,
+			Places:     places,
+			Cover:      mapCoverEntityToModel(packEntity.Cover),
 			Author: model.User{
 				ID:       packEntity.Author.PublicID,
 				Username: packEntity.Author.Username,
 			},
+			Progress: packProgress(places, packEntity.CompletedAt),
+		}
+
+		completed := isCompleted(*pack.Progress)
+		if (progress == pack_progress.Completed && !completed) || (progress == pack_progress.InProgress && completed) {
+			continue
 		}
 		foundPacks = append(foundPacks, pack)
 	}
@@ -94,19 +151,22 @@ func (s *packServiceImpl) GetFollowedByUserID(userID string) ([]model.Pack, erro
 	return foundPacks, nil
 }
 
-func (s *packServiceImpl) GetCreatedByUserID(userID string) ([]model.Pack, error) {
-	userEntity, err := s.userRepository.GetByPublicIDFull(userID)
+func (s *packServiceImpl) GetCreatedByUserID(ctx context.Context, userID string) ([]model.Pack, error) {
+	userEntity, err := s.userRepository.GetByPublicIDFull(ctx, userID)
 	if err != nil {
-		return []model.Pack{}, err
+		return []model.Pack{}, storageError(err, service.ErrUserNotFound)
 	}
 
 	var foundPacks []model.Pack
 	for _, packEntity := range userEntity.CreatedPacks {
 		pack := model.Pack{
-			ID:     packEntity.PublicID,
-			Name:   packEntity.Name,
-			Status: pack_status.Created,
-			Places: s.mapPlaceEntitiesToModels(packEntity.Places, userID),
+			ID:         packEntity.PublicID,
+			Name:       packEntity.Name,
+			Visibility: packEntity.Visibility,
+			Version:    packEntity.Version,
+			Status:     pack_status.Created,
+			Places:     s.mapPackPlaceEntitiesToModels(packEntity.PlaceEntries, userID),
+			Cover:      mapCoverEntityToModel(packEntity.Cover),
 			Author: model.User{
 				ID:       packEntity.Author.PublicID,
 				Username: packEntity.Author.Username,
@@ -118,29 +178,52 @@ func (s *packServiceImpl) GetCreatedByUserID(userID string) ([]model.Pack, error
 	return foundPacks, nil
 }
 
-func (s *packServiceImpl) Create(userID string, pc model.PackCreate) (model.Pack, error) {
-	userEntity, err := s.userRepository.GetByPublicID(userID)
+func (s *packServiceImpl) Create(ctx context.Context, userID string, pc model.PackCreate) (model.Pack, error) {
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Pack, error) {
+		return s.create(ctx, userID, pc)
+	})
+}
+
+func (s *packServiceImpl) create(ctx context.Context, userID string, pc model.PackCreate) (model.Pack, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
 	if err != nil {
-		return model.Pack{}, err
+		return model.Pack{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	visibility := pc.Visibility
+	if len(visibility) == 0 {
+		visibility = pack_visibility.Public
+	}
+	if !isPackVisibility(visibility) {
+		return model.Pack{}, service.ErrInvalidVisibility
 	}
 
 	packEntity := entity.Pack{
-		ID:       random.GenerateID(),
-		PublicID: random.GeneratePublicID(),
-		Name:     pc.Name,
-		AuthorID: userEntity.ID,
+		ID:         random.GenerateID(),
+		PublicID:   random.GeneratePublicID(),
+		Name:       pc.Name,
+		Visibility: visibility,
+		Version:    1,
+		AuthorID:   userEntity.ID,
 	}
 
-	err = s.packRepository.Create(packEntity)
+	err = s.packRepository.Create(ctx, packEntity)
+	if err != nil {
+		return model.Pack{}, err
+	}
+
+	err = s.activityRepository.Create(ctx, newActivity(userEntity, activity_type.PackCreated, &packEntity, nil))
 	if err != nil {
 		return model.Pack{}, err
 	}
 
 	pack := model.Pack{
-		ID:     packEntity.PublicID,
-		Name:   packEntity.Name,
-		Status: pack_status.Created,
-		Places: []model.Place{},
+		ID:         packEntity.PublicID,
+		Name:       packEntity.Name,
+		Visibility: packEntity.Visibility,
+		Version:    packEntity.Version,
+		Status:     pack_status.Created,
+		Places:     []model.Place{},
 		Author: model.User{
 			ID:       userEntity.PublicID,
 			Username: userEntity.Username,
@@ -150,98 +233,707 @@ func (s *packServiceImpl) Create(userID string, pc model.PackCreate) (model.Pack
 	return pack, err
 }
 
-func (s *packServiceImpl) UpdateByID(packID string, userID string, pu model.PackUpdate) (model.Pack, error) {
-	userEntity, err := s.userRepository.GetByPublicID(userID)
+func (s *packServiceImpl) UpdateByID(ctx context.Context, packID string, userID string, pu model.PackUpdate) (model.Pack, error) {
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Pack, error) {
+		return s.updateByID(ctx, packID, userID, pu)
+	})
+}
+
+func (s *packServiceImpl) updateByID(ctx context.Context, packID string, userID string, pu model.PackUpdate) (model.Pack, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
 	if err != nil {
-		return model.Pack{}, err
+		return model.Pack{}, storageError(err, service.ErrUserNotFound)
 	}
 
-	packEntity, err := s.packRepository.GetByPublicIDFull(packID)
+	packEntity, err := s.packRepository.GetByPublicIDFull(ctx, packID, userID)
 	if err != nil {
-		return model.Pack{}, err
+		return model.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+
+	if pu.Versions != nil && !slices.Contains(pu.Versions, packEntity.Version) {
+		return model.Pack{}, service.ErrVersionMismatch
 	}
 
 	status := s.getPackStatus(packEntity, userID)
+	addedEntries := []entity.PackPlace{}
 
 	if status == pack_status.None {
 		if pu.Status != pack_status.Followed {
-			return model.Pack{}, errors.New("it is possible only to follow this pack")
+			return model.Pack{}, service.ErrPackFollowOnly
+		}
+		err = s.packRepository.Follow(ctx, packEntity, userEntity.ID)
+		if err != nil {
+			return model.Pack{}, storageError(err, service.ErrPackNotFound)
 		}
 		packEntity.FollowedUsers = append(packEntity.FollowedUsers, userEntity)
 		status = pack_status.Followed
-	} else if status == pack_status.Created {
-		packEntity.Name = pu.Name
-		var placeEntities []entity.Place
-		for _, placeID := range pu.PlacesIDs {
-			placeEntity, err := s.placeRepository.GetByPublicID(placeID)
-			if err == nil {
-				placeEntities = append(placeEntities, placeEntity)
+	} else if status == pack_status.Created || status == pack_status.Editor {
+		if len(pu.Visibility) != 0 && pu.Visibility != packEntity.Visibility {
+			if status != pack_status.Created {
+				return model.Pack{}, service.ErrNotAuthor
+			}
+			if !isPackVisibility(pu.Visibility) {
+				return model.Pack{}, service.ErrInvalidVisibility
 			}
+			packEntity.Visibility = pu.Visibility
 		}
-		packEntity.Places = placeEntities
+		entries, err := s.buildPackPlaceEntities(ctx, packEntity, pu.PlacesIDs)
+		if err != nil {
+			return model.Pack{}, err
+		}
+		for _, entry := range entries {
+			if !slices.ContainsFunc(packEntity.PlaceEntries, func(e entity.PackPlace) bool { return e.PlaceID == entry.PlaceID }) {
+				addedEntries = append(addedEntries, entry)
+			}
+		}
+		packEntity.Name = pu.Name
+		packEntity.PlaceEntries = entries
+	} else if status == pack_status.Invited || status == pack_status.Viewer {
+		return model.Pack{}, service.ErrNotEditor
 	} else if status == pack_status.Followed {
 		if pu.Status != pack_status.None {
-			return model.Pack{}, errors.New("it is possible only to unfollow this pack")
+			return model.Pack{}, service.ErrPackUnfollowOnly
+		}
+		err = s.packRepository.Unfollow(ctx, packEntity, userEntity.ID)
+		if err != nil {
+			return model.Pack{}, storageError(err, service.ErrPackNotFound)
 		}
 		for i, follower := range packEntity.FollowedUsers {
-			if follower.PublicID == userID {
+			if strings.EqualFold(follower.PublicID, userID) {
 				packEntity.FollowedUsers = append(packEntity.FollowedUsers[:i], packEntity.FollowedUsers[i+1:]...)
 			}
 		}
 		status = pack_status.None
 	}
 
-	err = s.packRepository.Update(packEntity)
+	// Following and unfollowing leave the pack itself and its version
+	// untouched.
+	if status == pack_status.Created || status == pack_status.Editor {
+		err = s.packRepository.Update(ctx, packEntity)
+		if err != nil {
+			return model.Pack{}, storageError(err, service.ErrPackNotFound)
+		}
+		packEntity.Version++
+	}
+
+	for _, entry := range addedEntries {
+		err = s.activityRepository.Create(ctx, newActivity(userEntity, activity_type.PlaceAdded, &packEntity, &entry.Place))
+		if err != nil {
+			return model.Pack{}, err
+		}
+	}
+
+	pack := model.Pack{
+		ID:         packEntity.PublicID,
+		Name:       packEntity.Name,
+		Visibility: packEntity.Visibility,
+		Version:    packEntity.Version,
+		Status:     status,
+		Places:     s.mapPackPlaceEntitiesToModels(packEntity.PlaceEntries, userID),
+		Cover:      mapCoverEntityToModel(packEntity.Cover),
+		Author: model.User{
+			ID:       packEntity.Author.PublicID,
+			Username: packEntity.Author.Username,
+		},
+		Collaborators: s.mapCollaboratorEntitiesToModels(packEntity.Collaborators),
+	}
+
+	return pack, nil
+}
+
+func (s *packServiceImpl) DeleteByID(ctx context.Context, packID string, userID string) error {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return storageError(err, service.ErrUserNotFound)
+	}
+
+	packEntity, err := s.packRepository.GetByPublicIDFull(ctx, packID, userID)
+	if err != nil {
+		return storageError(err, service.ErrPackNotFound)
+	}
+
+	if packEntity.AuthorID != userEntity.ID {
+		return service.ErrNotAuthor
+	}
+
+	return s.packRepository.Delete(ctx, packEntity)
+}
+
+func (s *packServiceImpl) RestoreByID(ctx context.Context, packID string, userID string) (model.Pack, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Pack{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	packEntity, err := s.packRepository.GetDeletedByPublicID(ctx, packID)
+	if err != nil {
+		return model.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+
+	if packEntity.AuthorID != userEntity.ID {
+		return model.Pack{}, service.ErrNotAuthor
+	}
+
+	err = s.packRepository.Restore(ctx, packEntity)
 	if err != nil {
 		return model.Pack{}, err
 	}
 
+	return s.GetByID(ctx, packID, userID)
+}
+
+func (s *packServiceImpl) UpdatePlaceByID(ctx context.Context, packID string, placeID string, userID string, ppu model.PackPlaceUpdate) (model.Pack, error) {
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Pack, error) {
+		return s.updatePlaceByID(ctx, packID, placeID, userID, ppu)
+	})
+}
+
+func (s *packServiceImpl) updatePlaceByID(ctx context.Context, packID string, placeID string, userID string, ppu model.PackPlaceUpdate) (model.Pack, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Pack{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	packEntity, err := s.packRepository.GetByPublicIDFull(ctx, packID, userID)
+	if err != nil {
+		return model.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+
+	if !canEdit(packEntity, userEntity) {
+		return model.Pack{}, service.ErrNotEditor
+	}
+
+	// Positions are shown and changed among the visible places only, while
+	// the entries of hidden or deleted places stay where they are.
+	visible := []entity.PackPlace{}
+	hidden := []entity.PackPlace{}
+	for _, entry := range packEntity.PlaceEntries {
+		if isVisibleEntry(entry) {
+			visible = append(visible, entry)
+		} else {
+			hidden = append(hidden, entry)
+		}
+	}
+
+	index := slices.IndexFunc(visible, func(e entity.PackPlace) bool { return e.Place.PublicID == placeID })
+	if index == -1 {
+		return model.Pack{}, service.ErrPlaceNotInPack
+	}
+
+	entry := visible[index]
+	if ppu.Note != nil {
+		entry.Note = *ppu.Note
+	}
+	if ppu.PlannedDuration != nil {
+		if *ppu.PlannedDuration < 0 {
+			return model.Pack{}, service.ErrInvalidDuration
+		}
+		entry.PlannedDuration = *ppu.PlannedDuration
+	}
+
+	position := index + 1
+	if ppu.Position != nil {
+		position = *ppu.Position
+		if position < 1 || position > len(visible) {
+			return model.Pack{}, service.ErrInvalidPosition
+		}
+	}
+	entries := make([]entity.PackPlace, 0, len(packEntity.PlaceEntries))
+	entries = append(entries, visible[:index]...)
+	entries = append(entries, visible[index+1:]...)
+	entries = slices.Insert(entries, position-1, entry)
+
+	for i := range entries {
+		entries[i].Position = i + 1
+	}
+	packEntity.PlaceEntries = append(entries, hidden...)
+
+	err = s.packRepository.Update(ctx, packEntity)
+	if err != nil {
+		return model.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+	packEntity.Version++
+
 	pack := model.Pack{
-		ID:     packEntity.PublicID,
-		Name:   packEntity.Name,
-		Status: status,
-		Places: s.mapPlaceEntitiesToModels(packEntity.Places, userID),
+		ID:         packEntity.PublicID,
+		Name:       packEntity.Name,
+		Visibility: packEntity.Visibility,
+		Version:    packEntity.Version,
+		Status:     s.getPackStatus(packEntity, userID),
+		Places:     s.mapPackPlaceEntitiesToModels(packEntity.PlaceEntries, userID),
+		Cover:      mapCoverEntityToModel(packEntity.Cover),
 		Author: model.User{
 			ID:       packEntity.Author.PublicID,
 			Username: packEntity.Author.Username,
 		},
+		Collaborators: s.mapCollaboratorEntitiesToModels(packEntity.Collaborators),
 	}
 
 	return pack, nil
 }
 
-func (s *packServiceImpl) mapPlaceEntitiesToModels(placeEntities []entity.Place, userID string) []model.Place {
-	places := []model.Place{}
-	for _, placeEntity := range placeEntities {
-		visited := false
-		for _, visitor := range placeEntity.Visitors {
-			if visitor.PublicID == userID {
-				visited = true
-				break
+func (s *packServiceImpl) InviteCollaborator(ctx context.Context, packID string, userID string, ci model.CollaboratorInvite) (model.Collaborator, error) {
+	if ci.Role != collaborator_role.Editor && ci.Role != collaborator_role.Viewer {
+		return model.Collaborator{}, service.ErrInvalidRole
+	}
+
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Collaborator, error) {
+		return s.inviteCollaborator(ctx, packID, userID, ci)
+	})
+}
+
+func (s *packServiceImpl) inviteCollaborator(ctx context.Context, packID string, userID string, ci model.CollaboratorInvite) (model.Collaborator, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Collaborator{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	packEntity, err := s.packRepository.GetByPublicIDFull(ctx, packID, userID)
+	if err != nil {
+		return model.Collaborator{}, storageError(err, service.ErrPackNotFound)
+	}
+
+	if packEntity.AuthorID != userEntity.ID {
+		return model.Collaborator{}, service.ErrNotAuthor
+	}
+
+	inviteeEntity, err := s.userRepository.GetByPublicID(ctx, ci.UserID)
+	if err != nil {
+		return model.Collaborator{}, storageError(err, service.ErrUserNotFound)
+	}
+	if inviteeEntity.ID == userEntity.ID {
+		return model.Collaborator{}, service.ErrSelfInvitation
+	}
+	if inviteeEntity.BannedAt != nil {
+		return model.Collaborator{}, service.ErrUserBanned
+	}
+
+	// Inviting an existing collaborator again only changes their role.
+	if index := s.findCollaborator(packEntity, inviteeEntity.PublicID); index != -1 {
+		collaboratorEntity := packEntity.Collaborators[index]
+		collaboratorEntity.Role = ci.Role
+		err = s.collaboratorRepository.Update(ctx, collaboratorEntity)
+		if err != nil {
+			return model.Collaborator{}, err
+		}
+		return s.mapCollaboratorEntityToModel(collaboratorEntity), nil
+	}
+
+	collaboratorEntity := entity.PackCollaborator{
+		PackID: packEntity.ID,
+		UserID: inviteeEntity.ID,
+		Role:   ci.Role,
+		User:   inviteeEntity,
+	}
+
+	err = s.collaboratorRepository.Create(ctx, collaboratorEntity)
+	if err != nil {
+		return model.Collaborator{}, err
+	}
+
+	return s.mapCollaboratorEntityToModel(collaboratorEntity), nil
+}
+
+func (s *packServiceImpl) AcceptInvitation(ctx context.Context, packID string, userID string) (model.Pack, error) {
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Pack, error) {
+		return s.acceptInvitation(ctx, packID, userID)
+	})
+}
+
+func (s *packServiceImpl) acceptInvitation(ctx context.Context, packID string, userID string) (model.Pack, error) {
+	packEntity, err := s.getInvitedPack(ctx, packID, userID)
+	if err != nil {
+		return model.Pack{}, err
+	}
+
+	index := s.findCollaborator(packEntity, userID)
+	if index == -1 {
+		return model.Pack{}, service.ErrInvitationNotFound
+	}
+
+	collaboratorEntity := packEntity.Collaborators[index]
+	if collaboratorEntity.AcceptedAt == nil {
+		now := time.Now()
+		collaboratorEntity.AcceptedAt = &now
+		err = s.collaboratorRepository.Update(ctx, collaboratorEntity)
+		if err != nil {
+			return model.Pack{}, err
+		}
+	}
+
+	return s.GetByID(ctx, packID, userID)
+}
+
+func (s *packServiceImpl) RemoveCollaborator(ctx context.Context, packID string, collaboratorID string, userID string) error {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return storageError(err, service.ErrUserNotFound)
+	}
+
+	packEntity, err := s.getInvitedPack(ctx, packID, userID)
+	if err != nil {
+		return err
+	}
+
+	// Collaborators can leave a pack, but only its author can remove others.
+	if packEntity.AuthorID != userEntity.ID && collaboratorID != userEntity.PublicID {
+		return service.ErrNotAuthor
+	}
+
+	index := s.findCollaborator(packEntity, collaboratorID)
+	if index == -1 {
+		return service.ErrCollaboratorNotFound
+	}
+
+	return s.collaboratorRepository.Delete(ctx, packEntity.Collaborators[index])
+}
+
+func (s *packServiceImpl) CreateShareLink(ctx context.Context, packID string, userID string, slc model.ShareLinkCreate) (model.ShareLink, error) {
+	packEntity, err := s.getAuthoredPack(ctx, packID, userID)
+	if err != nil {
+		return model.ShareLink{}, err
+	}
+
+	if slc.ExpiresAt != nil && !slc.ExpiresAt.After(time.Now()) {
+		return model.ShareLink{}, service.ErrInvalidExpiry
+	}
+
+	shareLinkEntity := entity.ShareLink{
+		ID:        random.GenerateID(),
+		CreatedAt: time.Now(),
+		PublicID:  random.GeneratePublicID(),
+		PackID:    packEntity.ID,
+		ExpiresAt: slc.ExpiresAt,
+	}
+
+	err = s.shareLinkRepository.Create(ctx, shareLinkEntity)
+	if err != nil {
+		return model.ShareLink{}, err
+	}
+
+	return s.mapShareLinkEntityToModel(shareLinkEntity), nil
+}
+
+func (s *packServiceImpl) GetShareLinks(ctx context.Context, packID string, userID string) ([]model.ShareLink, error) {
+	packEntity, err := s.getAuthoredPack(ctx, packID, userID)
+	if err != nil {
+		return []model.ShareLink{}, err
+	}
+
+	shareLinkEntities, err := s.shareLinkRepository.GetActiveByPackID(ctx, packEntity.ID)
+	if err != nil {
+		return []model.ShareLink{}, err
+	}
+
+	shareLinks := []model.ShareLink{}
+	for _, shareLinkEntity := range shareLinkEntities {
+		shareLinks = append(shareLinks, s.mapShareLinkEntityToModel(shareLinkEntity))
+	}
+
+	return shareLinks, nil
+}
+
+func (s *packServiceImpl) RevokeShareLink(ctx context.Context, packID string, linkID string, userID string) error {
+	packEntity, err := s.getAuthoredPack(ctx, packID, userID)
+	if err != nil {
+		return err
+	}
+
+	shareLinkEntity, err := s.shareLinkRepository.GetByPublicID(ctx, linkID)
+	if err != nil {
+		return storageError(err, service.ErrShareLinkNotFound)
+	}
+	if shareLinkEntity.PackID != packEntity.ID || shareLinkEntity.RevokedAt != nil {
+		return service.ErrShareLinkNotFound
+	}
+
+	now := time.Now()
+	shareLinkEntity.RevokedAt = &now
+	return s.shareLinkRepository.Update(ctx, shareLinkEntity)
+}
+
+func (s *packServiceImpl) GetByShareToken(ctx context.Context, token string, userID string) (model.Pack, error) {
+	packEntity, err := s.getSharedPack(ctx, token)
+	if err != nil {
+		return model.Pack{}, err
+	}
+
+	return s.mapPackEntityToModel(packEntity, userID), nil
+}
+
+func (s *packServiceImpl) FollowByShareToken(ctx context.Context, token string, userID string) (model.Pack, error) {
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Pack, error) {
+		return s.followByShareToken(ctx, token, userID)
+	})
+}
+
+func (s *packServiceImpl) followByShareToken(ctx context.Context, token string, userID string) (model.Pack, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Pack{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	packEntity, err := s.getSharedPack(ctx, token)
+	if err != nil {
+		return model.Pack{}, err
+	}
+
+	if s.getPackStatus(packEntity, userID) != pack_status.None {
+		return s.mapPackEntityToModel(packEntity, userID), nil
+	}
+
+	// Followers cannot see private packs, so following one through a share
+	// link makes the user a viewer instead.
+	if packEntity.Visibility == pack_visibility.Private {
+		now := time.Now()
+		collaboratorEntity := entity.PackCollaborator{
+			PackID:     packEntity.ID,
+			UserID:     userEntity.ID,
+			Role:       collaborator_role.Viewer,
+			AcceptedAt: &now,
+			User:       userEntity,
+		}
+		err = s.collaboratorRepository.Create(ctx, collaboratorEntity)
+		if err != nil {
+			return model.Pack{}, err
+		}
+		packEntity.Collaborators = append(packEntity.Collaborators, collaboratorEntity)
+
+		return s.mapPackEntityToModel(packEntity, userID), nil
+	}
+
+	err = s.packRepository.Follow(ctx, packEntity, userEntity.ID)
+	if err != nil {
+		return model.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+	packEntity.FollowedUsers = append(packEntity.FollowedUsers, userEntity)
+
+	return s.mapPackEntityToModel(packEntity, userID), nil
+}
+
+// getAuthoredPack returns a pack that the user created, for actions that
+// only its author may take.
+func (s *packServiceImpl) getAuthoredPack(ctx context.Context, packID string, userID string) (entity.Pack, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return entity.Pack{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	packEntity, err := s.packRepository.GetByPublicIDFull(ctx, packID, userID)
+	if err != nil {
+		return entity.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+
+	if packEntity.AuthorID != userEntity.ID {
+		return entity.Pack{}, service.ErrNotAuthor
+	}
+
+	return packEntity, nil
+}
+
+// getInvitedPack returns a pack that the user may open or has been invited
+// to. Private packs are hidden from invitees until they accept, but they must
+// still be able to accept or decline the invitation.
+func (s *packServiceImpl) getInvitedPack(ctx context.Context, packID string, userID string) (entity.Pack, error) {
+	packEntity, err := s.packRepository.GetByPublicIDFull(ctx, packID, userID)
+	if !errors.Is(err, storage.ErrNotFound) {
+		return packEntity, storageError(err, service.ErrPackNotFound)
+	}
+
+	packEntity, err = s.packRepository.GetByPublicID(ctx, packID)
+	if err != nil {
+		return entity.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+	packEntity, err = s.packRepository.GetByIDFull(ctx, packEntity.ID)
+	if err != nil {
+		return entity.Pack{}, storageError(err, service.ErrPackNotFound)
+	}
+	if s.findCollaborator(packEntity, userID) == -1 {
+		return entity.Pack{}, service.ErrPackNotFound
+	}
+
+	return packEntity, nil
+}
+
+// getSharedPack returns the pack of an active share link. Any failure is
+// reported as a missing link, so that tokens cannot be probed.
+func (s *packServiceImpl) getSharedPack(ctx context.Context, token string) (entity.Pack, error) {
+	value, err := s.shareLinkSigner.Verify(token)
+	if err != nil {
+		return entity.Pack{}, service.ErrShareLinkNotFound
+	}
+
+	shareLinkEntity, err := s.shareLinkRepository.GetByPublicID(ctx, string(value))
+	if err != nil {
+		return entity.Pack{}, storageError(err, service.ErrShareLinkNotFound)
+	}
+	if shareLinkEntity.RevokedAt != nil || (shareLinkEntity.ExpiresAt != nil && !shareLinkEntity.ExpiresAt.After(time.Now())) {
+		return entity.Pack{}, service.ErrShareLinkNotFound
+	}
+
+	packEntity, err := s.packRepository.GetByIDFull(ctx, shareLinkEntity.PackID)
+	if err != nil {
+		return entity.Pack{}, storageError(err, service.ErrShareLinkNotFound)
+	}
+
+	return packEntity, nil
+}
+
+// buildPackPlaceEntities orders the places of the pack as listed by
+// placesIDs. Entries of hidden or deleted places are not shown to the user,
+// so they are kept at their positions whether listed or not.
+func (s *packServiceImpl) buildPackPlaceEntities(ctx context.Context, packEntity entity.Pack, placesIDs []string) ([]entity.PackPlace, error) {
+	existing := map[uuid.UUID]entity.PackPlace{}
+	for _, entry := range packEntity.PlaceEntries {
+		existing[entry.PlaceID] = entry
+	}
+
+	entries := []entity.PackPlace{}
+	added := map[uuid.UUID]bool{}
+	position := 0
+	for _, placeID := range placesIDs {
+		placeEntity, err := s.placeRepository.GetByPublicID(ctx, placeID)
+		if err != nil {
+			return nil, storageError(err, service.ErrPlaceNotFound)
+		}
+		if added[placeEntity.ID] {
+			continue
+		}
+
+		entry, ok := existing[placeEntity.ID]
+		if !ok {
+			entry = entity.PackPlace{
+				PackID:  packEntity.ID,
+				PlaceID: placeEntity.ID,
+				Place:   placeEntity,
 			}
+		} else if !isVisibleEntry(entry) {
+			continue
 		}
 
-		place := model.Place{
-			ID:      placeEntity.PublicID,
-			Name:    placeEntity.Name,
-			Address: placeEntity.Address,
-			Visited: visited,
+		position++
+		entry.Position = position
+		entries = append(entries, entry)
+		added[placeEntity.ID] = true
+	}
+
+	for _, entry := range packEntity.PlaceEntries {
+		if !isVisibleEntry(entry) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// isVisibleEntry reports whether the place of the entry was loaded, which
+// it is not once hidden or deleted.
+func isVisibleEntry(entry entity.PackPlace) bool {
+	return entry.Place.PublicID != ""
+}
+
+func (s *packServiceImpl) mapPackEntityToModel(packEntity entity.Pack, userID string) model.Pack {
+	places := s.mapPackPlaceEntitiesToModels(packEntity.PlaceEntries, userID)
+	return model.Pack{
+		ID:         packEntity.PublicID,
+		Name:       packEntity.Name,
+		Visibility: packEntity.Visibility,
+		Version:    packEntity.Version,
+		Status:     s.getPackStatus(packEntity, userID),
+		Places:     places,
+		Cover:      mapCoverEntityToModel(packEntity.Cover),
+		Author: model.User{
+			ID:       packEntity.Author.PublicID,
+			Username: packEntity.Author.Username,
+		},
+		Collaborators: s.mapCollaboratorEntitiesToModels(packEntity.Collaborators),
+		Progress:      packProgress(places, packEntity.CompletedAt),
+	}
+}
+
+// packProgress counts the visited places. The completion date is dropped
+// when places were added to the pack since it was completed.
+func packProgress(places []model.Place, completedAt *time.Time) *model.PackProgress {
+	progress := model.PackProgress{Total: len(places)}
+	for _, place := range places {
+		if place.Visited {
+			progress.Visited++
+		}
+	}
+
+	if progress.Total != 0 {
+		progress.Percent = progress.Visited * 100 / progress.Total
+	}
+	if isCompleted(progress) {
+		progress.CompletedAt = completedAt
+	}
+
+	return &progress
+}
+
+// isCompleted reports whether all the places of a pack are visited. Packs
+// without places are never completed.
+func isCompleted(progress model.PackProgress) bool {
+	return progress.Total != 0 && progress.Visited == progress.Total
+}
+
+func (s *packServiceImpl) mapPackPlaceEntitiesToModels(entries []entity.PackPlace, userID string) []model.Place {
+	places := []model.Place{}
+	for _, entry := range entries {
+		// Places deleted by their authors are not loaded.
+		if entry.Place.PublicID == "" {
+			continue
 		}
 
+		place := s.mapPlaceEntityToModel(entry.Place, userID)
+		place.Position = entry.Position
+		place.Note = entry.Note
+		place.PlannedDuration = entry.PlannedDuration
+
 		places = append(places, place)
 	}
 
 	return places
 }
 
+func (s *packServiceImpl) mapPlaceEntityToModel(placeEntity entity.Place, userID string) model.Place {
+	lastVisited := lastVisitedAt(placeEntity, userID)
+
+	return model.Place{
+		ID:        placeEntity.PublicID,
+		Name:      placeEntity.Name,
+		Address:   placeEntity.Address,
+		Latitude:  placeEntity.Latitude,
+		Longitude: placeEntity.Longitude,
+		Visited:   lastVisited != nil,
+		Version:   placeEntity.Version,
+
+		LastVisitedAt: lastVisited,
+
+		RatingAverage: placeEntity.RatingAverage,
+		RatingCount:   placeEntity.RatingCount,
+	}
+}
+
 func (s *packServiceImpl) getPackStatus(packEntity entity.Pack, userID string) types.PackStatus {
-	if packEntity.Author.PublicID == userID {
+	if strings.EqualFold(packEntity.Author.PublicID, userID) {
 		return pack_status.Created
 	}
 
+	if index := s.findCollaborator(packEntity, userID); index != -1 {
+		collaboratorEntity := packEntity.Collaborators[index]
+		if collaboratorEntity.AcceptedAt == nil {
+			return pack_status.Invited
+		}
+		if collaboratorEntity.Role == collaborator_role.Editor {
+			return pack_status.Editor
+		}
+		return pack_status.Viewer
+	}
+
 	followed := false
 	for _, follower := range packEntity.FollowedUsers {
-		if follower.PublicID == userID {
+		if strings.EqualFold(follower.PublicID, userID) {
 			followed = true
 			break
 		}
@@ -252,3 +944,61 @@ func (s *packServiceImpl) getPackStatus(packEntity entity.Pack, userID string) t
 
 	return pack_status.None
 }
+
+// canEdit reports whether the user may change the name, places and cover of
+// the pack, which is allowed to its author and to editors who accepted the
+// invitation.
+func canEdit(packEntity entity.Pack, userEntity entity.User) bool {
+	if packEntity.AuthorID == userEntity.ID {
+		return true
+	}
+
+	for _, collaboratorEntity := range packEntity.Collaborators {
+		if collaboratorEntity.UserID == userEntity.ID {
+			return collaboratorEntity.AcceptedAt != nil && collaboratorEntity.Role == collaborator_role.Editor
+		}
+	}
+
+	return false
+}
+
+func (s *packServiceImpl) findCollaborator(packEntity entity.Pack, userID string) int {
+	for i, collaboratorEntity := range packEntity.Collaborators {
+		if strings.EqualFold(collaboratorEntity.User.PublicID, userID) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *packServiceImpl) mapCollaboratorEntitiesToModels(collaboratorEntities []entity.PackCollaborator) []model.Collaborator {
+	collaborators := []model.Collaborator{}
+	for _, collaboratorEntity := range collaboratorEntities {
+		collaborators = append(collaborators, s.mapCollaboratorEntityToModel(collaboratorEntity))
+	}
+	return collaborators
+}
+
+func (s *packServiceImpl) mapCollaboratorEntityToModel(collaboratorEntity entity.PackCollaborator) model.Collaborator {
+	return model.Collaborator{
+		User: model.User{
+			ID:       collaboratorEntity.User.PublicID,
+			Username: collaboratorEntity.User.Username,
+		},
+		Role:     collaboratorEntity.Role,
+		Accepted: collaboratorEntity.AcceptedAt != nil,
+	}
+}
+
+func isPackVisibility(visibility types.PackVisibility) bool {
+	return visibility == pack_visibility.Private || visibility == pack_visibility.Unlisted || visibility == pack_visibility.Public
+}
+
+func (s *packServiceImpl) mapShareLinkEntityToModel(shareLinkEntity entity.ShareLink) model.ShareLink {
+	return model.ShareLink{
+		ID:        shareLinkEntity.PublicID,
+		Token:     s.shareLinkSigner.Sign([]byte(shareLinkEntity.PublicID)),
+		CreatedAt: shareLinkEntity.CreatedAt,
+		ExpiresAt: shareLinkEntity.ExpiresAt,
+	}
+}
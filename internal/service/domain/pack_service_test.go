@@ -1,14 +1,24 @@
 package domain
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/internal/storage"
 	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/activity_type"
+	"locpack-backend/pkg/enum/collaborator_role"
+	"locpack-backend/pkg/enum/pack_progress"
 	"locpack-backend/pkg/enum/pack_status"
+	"locpack-backend/pkg/enum/pack_visibility"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -38,27 +48,29 @@ func TestPackService_GetByID(t *testing.T) {
 		{
 			name: "success - created",
 			setup: func() {
-				packRepo.On("GetByPublicIDFull", packID).Return(packEntity, nil).Once()
+				packRepo.On("GetByPublicIDFull", mock.Anything, packID, mock.Anything).Return(packEntity, nil).Once()
 			},
 			expected: model.Pack{
-				ID:             packID,
-				Name:           "Test Pack",
-				AuthorID:       userID,
-				AuthorUsername: "author",
-				Status:         pack_status.Created,
+				ID:            packID,
+				Name:          "Test Pack",
+				Author:        model.User{ID: userID, Username: "author"},
+				Status:        pack_status.Created,
+				Places:        []model.Place{},
+				Collaborators: []model.Collaborator{},
+				Progress:      &model.PackProgress{},
 			},
 		},
 		{
 			name: "error fetching pack",
 			setup: func() {
-				packRepo.On("GetByPublicIDFull", packID).Return(entity.Pack{}, errors.New("not found")).Once()
+				packRepo.On("GetByPublicIDFull", mock.Anything, packID, mock.Anything).Return(entity.Pack{}, errors.New("not found")).Once()
 			},
 			wantErr: true,
 		},
 		{
 			name: "user neither author nor follower",
 			setup: func() {
-				packRepo.On("GetByPublicIDFull", packID).Return(entity.Pack{
+				packRepo.On("GetByPublicIDFull", mock.Anything, packID, mock.Anything).Return(entity.Pack{
 					PublicID: packID,
 					Name:     "Pack",
 					Author:   entity.User{PublicID: "other"},
@@ -68,11 +80,13 @@ func TestPackService_GetByID(t *testing.T) {
 				}, nil).Once()
 			},
 			expected: model.Pack{
-				ID:             packID,
-				Name:           "Pack",
-				AuthorID:       "other",
-				AuthorUsername: "",
-				Status:         pack_status.None,
+				ID:            packID,
+				Name:          "Pack",
+				Author:        model.User{ID: "other"},
+				Status:        pack_status.None,
+				Places:        []model.Place{},
+				Collaborators: []model.Collaborator{},
+				Progress:      &model.PackProgress{},
 			},
 		},
 	}
@@ -81,7 +95,7 @@ func TestPackService_GetByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			got, err := packSvc.GetByID(packID, userID)
+			got, err := packSvc.GetByID(context.Background(), packID, userID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -94,6 +108,66 @@ func TestPackService_GetByID(t *testing.T) {
 	}
 }
 
+func TestPackService_GetByID_UserIDCase(t *testing.T) {
+	t.Parallel()
+
+	acceptedAt := time.Now()
+	tests := []struct {
+		name     string
+		pack     entity.Pack
+		expected types.PackStatus
+	}{
+		{
+			name:     "author",
+			pack:     entity.Pack{Author: entity.User{PublicID: "User1"}},
+			expected: pack_status.Created,
+		},
+		{
+			name: "editor",
+			pack: entity.Pack{
+				Author: entity.User{PublicID: "other"},
+				Collaborators: []entity.PackCollaborator{
+					{User: entity.User{PublicID: "User1"}, Role: collaborator_role.Editor, AcceptedAt: &acceptedAt},
+				},
+			},
+			expected: pack_status.Editor,
+		},
+		{
+			name: "invited",
+			pack: entity.Pack{
+				Author: entity.User{PublicID: "other"},
+				Collaborators: []entity.PackCollaborator{
+					{User: entity.User{PublicID: "User1"}, Role: collaborator_role.Viewer},
+				},
+			},
+			expected: pack_status.Invited,
+		},
+		{
+			name: "follower",
+			pack: entity.Pack{
+				Author:        entity.User{PublicID: "other"},
+				FollowedUsers: []entity.User{{PublicID: "User1"}},
+			},
+			expected: pack_status.Followed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, _, _, packRepo, _, _ := setupServiceTest(t)
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", "uSER1").Return(tt.pack, nil).Once()
+
+			got, err := packSvc.GetByID(context.Background(), "pack1", "uSER1")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got.Status)
+			packRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestPackService_GetByNameOrAuthor(t *testing.T) {
 	t.Parallel()
 
@@ -156,9 +230,9 @@ func TestPackService_GetByNameOrAuthor(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			packSvc, _, _, packRepo, _, _ := setupServiceTest(t)
-			packRepo.On("GetByNameOrAuthorFull", tt.query).Return(tt.mockReturn, tt.mockError).Once()
+			packRepo.On("GetByNameOrAuthorFull", mock.Anything, tt.query, tt.userID, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return(tt.mockReturn, int64(len(tt.mockReturn)), tt.mockError).Once()
 
-			res, err := packSvc.GetByNameOrAuthor(tt.query, tt.userID)
+			res, _, err := packSvc.GetByNameOrAuthor(context.Background(), tt.query, tt.userID, model.Page{})
 
 			if tt.expectErr {
 				assert.Error(t, err)
@@ -177,13 +251,27 @@ func TestPackService_GetByNameOrAuthor(t *testing.T) {
 func TestPackService_GetFollowedByUserID(t *testing.T) {
 	t.Parallel()
 
+	completedAt := lastVisit.Add(time.Hour)
+	visited := entity.PackPlace{Place: entity.Place{
+		PublicID: "place1",
+		Visitors: []entity.PlaceVisitor{{User: entity.User{PublicID: "user1"}, LastVisitedAt: lastVisit}},
+	}}
+	notVisited := entity.PackPlace{Place: entity.Place{PublicID: "place2"}}
+	followedPacks := []entity.Pack{
+		{PublicID: "p1", PlaceEntries: []entity.PackPlace{visited, notVisited}, CompletedAt: &completedAt},
+		{PublicID: "p2", PlaceEntries: []entity.PackPlace{visited}, CompletedAt: &completedAt},
+		{PublicID: "p3"},
+	}
+
 	tests := []struct {
-		name      string
-		userID    string
-		mockUser  entity.User
-		mockError error
-		expected  int
-		expectErr bool
+		name             string
+		userID           string
+		progress         string
+		mockUser         entity.User
+		mockError        error
+		expected         int
+		expectedProgress []model.PackProgress
+		expectErr        bool
 	}{
 		{
 			name:   "Single followed pack",
@@ -211,14 +299,47 @@ func TestPackService_GetFollowedByUserID(t *testing.T) {
 			mockUser: entity.User{FollowedPacks: []entity.Pack{}},
 			expected: 0,
 		},
+		{
+			name:     "Progress of followed packs",
+			userID:   "user1",
+			mockUser: entity.User{FollowedPacks: followedPacks},
+			expected: 3,
+			expectedProgress: []model.PackProgress{
+				{Visited: 1, Total: 2, Percent: 50},
+				{Visited: 1, Total: 1, Percent: 100, CompletedAt: &completedAt},
+				{},
+			},
+		},
+		{
+			name:             "Completed packs",
+			userID:           "user1",
+			progress:         pack_progress.Completed,
+			mockUser:         entity.User{FollowedPacks: followedPacks},
+			expected:         1,
+			expectedProgress: []model.PackProgress{{Visited: 1, Total: 1, Percent: 100, CompletedAt: &completedAt}},
+		},
+		{
+			name:             "Packs in progress",
+			userID:           "user1",
+			progress:         pack_progress.InProgress,
+			mockUser:         entity.User{FollowedPacks: followedPacks},
+			expected:         2,
+			expectedProgress: []model.PackProgress{{Visited: 1, Total: 2, Percent: 50}, {}},
+		},
+		{
+			name:      "Invalid progress",
+			userID:    "user1",
+			progress:  "DONE",
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			packSvc, _, _, _, _, userRepo := setupServiceTest(t)
-			userRepo.On("GetByPublicIDFull", tt.userID).Return(tt.mockUser, tt.mockError).Once()
+			userRepo.On("GetByPublicIDFull", mock.Anything, tt.userID).Return(tt.mockUser, tt.mockError).Maybe()
 
-			res, err := packSvc.GetFollowedByUserID(tt.userID)
+			res, err := packSvc.GetFollowedByUserID(context.Background(), tt.userID, tt.progress)
 
 			if tt.expectErr {
 				assert.Error(t, err)
@@ -226,8 +347,11 @@ func TestPackService_GetFollowedByUserID(t *testing.T) {
 			}
 			assert.NoError(t, err)
 			assert.Len(t, res, tt.expected)
-			for _, p := range res {
+			for i, p := range res {
 				assert.Equal(t, pack_status.Followed, p.Status)
+				if tt.expectedProgress != nil {
+					assert.Equal(t, tt.expectedProgress[i], *p.Progress)
+				}
 			}
 		})
 	}
@@ -247,7 +371,7 @@ func TestPackService_GetCreatedByUserID(t *testing.T) {
 		{
 			name:   "Single created pack",
 			userID: "user1",
-			mockUser: entity.User{FollowedPacks: []entity.Pack{
+			mockUser: entity.User{CreatedPacks: []entity.Pack{
 				{
 					PublicID: "p1",
 					Name:     "Created Pack",
@@ -267,7 +391,7 @@ func TestPackService_GetCreatedByUserID(t *testing.T) {
 		{
 			name:     "No created packs",
 			userID:   "user1",
-			mockUser: entity.User{FollowedPacks: []entity.Pack{}},
+			mockUser: entity.User{CreatedPacks: []entity.Pack{}},
 			expected: 0,
 		},
 	}
@@ -275,9 +399,9 @@ func TestPackService_GetCreatedByUserID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			packSvc, _, _, _, _, userRepo := setupServiceTest(t)
-			userRepo.On("GetByPublicIDFull", tt.userID).Return(tt.mockUser, tt.mockError).Once()
+			userRepo.On("GetByPublicIDFull", mock.Anything, tt.userID).Return(tt.mockUser, tt.mockError).Once()
 
-			res, err := packSvc.GetCreatedByUserID(tt.userID)
+			res, err := packSvc.GetCreatedByUserID(context.Background(), tt.userID)
 
 			if tt.expectErr {
 				assert.Error(t, err)
@@ -301,23 +425,23 @@ func TestPackService_Create(t *testing.T) {
 		{
 			name: "success",
 			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository) {
-				userRepo.On("GetByPublicID", "user1").Return(entity.User{PublicID: "user1"}, nil)
-				packRepo.On("Register", mock.AnythingOfType("entity.Pack")).Return(nil)
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{PublicID: "user1"}, nil)
+				packRepo.On("Create", mock.Anything, mock.AnythingOfType("entity.Pack")).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "user repo error",
 			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository) {
-				userRepo.On("GetByPublicID", "user1").Return(entity.User{}, errors.New("user not found"))
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{}, errors.New("user not found"))
 			},
 			wantErr: true,
 		},
 		{
 			name: "pack repo create error",
 			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository) {
-				userRepo.On("GetByPublicID", "user1").Return(entity.User{PublicID: "user1"}, nil)
-				packRepo.On("Register", mock.AnythingOfType("entity.Pack")).Return(errors.New("db error"))
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{PublicID: "user1"}, nil)
+				packRepo.On("Create", mock.Anything, mock.AnythingOfType("entity.Pack")).Return(errors.New("db error"))
 			},
 			wantErr: true,
 		},
@@ -328,7 +452,7 @@ func TestPackService_Create(t *testing.T) {
 			packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
 			tt.setupMocks(userRepo, packRepo)
 
-			pack, err := packSvc.Create("user1", model.PackCreate{Name: "My Pack"})
+			pack, err := packSvc.Create(context.Background(), "user1", model.PackCreate{Name: "My Pack"})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -348,21 +472,21 @@ func TestPackService_UpdateByID(t *testing.T) {
 		wantErr    bool
 	}{
 		{
-			name: "success followed",
+			name: "success author",
 			update: model.PackUpdate{
 				Name:      "New Name",
-				Status:    pack_status.Followed,
+				Status:    pack_status.Created,
 				PlacesIDs: []string{"place1"},
 			},
 			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository, placeRepo *storage.MockPlaceRepository) {
-				userRepo.On("GetByPublicID", "user1").Return(entity.User{PublicID: "user1"}, nil)
-				packRepo.On("GetByPublicIDFull", "pack1").Return(entity.Pack{
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{PublicID: "user1"}, nil)
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
 					PublicID: "pack1",
 					Name:     "Old Name",
-					Author:   entity.User{PublicID: "user2"},
+					Author:   entity.User{PublicID: "user1"},
 				}, nil)
-				placeRepo.On("GetByPublicID", "place1").Return(entity.Place{PublicID: "place1"}, nil)
-				packRepo.On("Update", mock.AnythingOfType("entity.Pack")).Return(nil)
+				placeRepo.On("GetByPublicID", mock.Anything, "place1").Return(entity.Place{PublicID: "place1"}, nil)
+				packRepo.On("Update", mock.Anything, mock.AnythingOfType("entity.Pack")).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -372,8 +496,14 @@ func TestPackService_UpdateByID(t *testing.T) {
 				Name:   "New Name",
 				Status: pack_status.Created,
 			},
-			setupMocks: func(_ *storage.MockUserRepository, _ *storage.MockPackRepository, _ *storage.MockPlaceRepository) {},
-			wantErr:    true,
+			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository, _ *storage.MockPlaceRepository) {
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{PublicID: "user1"}, nil)
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+					PublicID: "pack1",
+					Author:   entity.User{PublicID: "user2"},
+				}, nil)
+			},
+			wantErr: true,
 		},
 		{
 			name: "user repo error",
@@ -382,7 +512,7 @@ func TestPackService_UpdateByID(t *testing.T) {
 				Status: pack_status.Followed,
 			},
 			setupMocks: func(userRepo *storage.MockUserRepository, _ *storage.MockPackRepository, _ *storage.MockPlaceRepository) {
-				userRepo.On("GetByPublicID", "user1").Return(entity.User{}, errors.New("user not found"))
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{}, errors.New("user not found"))
 			},
 			wantErr: true,
 		},
@@ -393,27 +523,26 @@ func TestPackService_UpdateByID(t *testing.T) {
 				Status: pack_status.Followed,
 			},
 			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository, placeRepo *storage.MockPlaceRepository) {
-				userRepo.On("GetByPublicID", "user1").Return(entity.User{PublicID: "user1"}, nil)
-				packRepo.On("GetByPublicIDFull", "pack1").Return(entity.Pack{}, errors.New("not found"))
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{PublicID: "user1"}, nil)
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{}, errors.New("not found"))
 			},
 			wantErr: true,
 		},
 		{
-			name: "update repo error",
+			name: "follow repo error",
 			update: model.PackUpdate{
 				Name:      "New Name",
 				Status:    pack_status.Followed,
 				PlacesIDs: []string{"place1"},
 			},
 			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository, placeRepo *storage.MockPlaceRepository) {
-				userRepo.On("GetByPublicID", "user1").Return(entity.User{PublicID: "user1"}, nil)
-				packRepo.On("GetByPublicIDFull", "pack1").Return(entity.Pack{
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{PublicID: "user1"}, nil)
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
 					PublicID: "pack1",
 					Name:     "Old Name",
 					Author:   entity.User{PublicID: "user2"},
 				}, nil)
-				placeRepo.On("GetByPublicID", "place1").Return(entity.Place{PublicID: "place1"}, nil)
-				packRepo.On("Update", mock.AnythingOfType("entity.Pack")).Return(errors.New("update error"))
+				packRepo.On("Follow", mock.Anything, mock.AnythingOfType("entity.Pack"), mock.Anything).Return(errors.New("follow error"))
 			},
 			wantErr: true,
 		},
@@ -424,7 +553,7 @@ func TestPackService_UpdateByID(t *testing.T) {
 			packSvc, _, _, packRepo, placeRepo, userRepo := setupServiceTest(t)
 			tt.setupMocks(userRepo, packRepo, placeRepo)
 
-			updated, err := packSvc.UpdateByID("pack1", "user1", tt.update)
+			updated, err := packSvc.UpdateByID(context.Background(), "pack1", "user1", tt.update)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -436,6 +565,117 @@ func TestPackService_UpdateByID(t *testing.T) {
 	}
 }
 
+func TestPackService_UpdateByID_Version(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	currentVersion := int64(3)
+	staleVersion := int64(2)
+
+	tests := []struct {
+		name            string
+		versions        []int64
+		updateErr       error
+		expectedErr     error
+		expectedVersion int64
+	}{
+		{
+			name:            "no precondition",
+			expectedVersion: 4,
+		},
+		{
+			name:            "version matches",
+			versions:        []int64{currentVersion},
+			expectedVersion: 4,
+		},
+		{
+			name:            "one of the versions matches",
+			versions:        []int64{staleVersion, currentVersion},
+			expectedVersion: 4,
+		},
+		{
+			name:        "version mismatch",
+			versions:    []int64{staleVersion},
+			expectedErr: service.ErrVersionMismatch,
+		},
+		{
+			name:        "no version matches",
+			versions:    []int64{},
+			expectedErr: service.ErrVersionMismatch,
+		},
+		{
+			name:        "stale write",
+			versions:    []int64{currentVersion},
+			updateErr:   storage.ErrStale,
+			expectedErr: service.ErrVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+			packRepo.EXPECT().GetByPublicIDFull(mock.Anything, "pack1", "user1").Return(entity.Pack{
+				PublicID: "pack1",
+				Name:     "Old Name",
+				Version:  currentVersion,
+				AuthorID: authorUUID,
+				Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+			}, nil)
+			if tt.expectedErr == nil || tt.updateErr != nil {
+				packRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("entity.Pack")).Return(tt.updateErr)
+			}
+
+			updated, err := packSvc.UpdateByID(context.Background(), "pack1", "user1", model.PackUpdate{
+				Name:     "New Name",
+				Status:   pack_status.Created,
+				Versions: tt.versions,
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedVersion, updated.Version)
+			}
+			packRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_UpdateByID_Unfollow(t *testing.T) {
+	t.Parallel()
+
+	packUUID := uuid.New()
+	userUUID := uuid.New()
+	follower := entity.User{ID: userUUID, PublicID: "user1"}
+
+	packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
+	userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(follower, nil)
+	packRepo.EXPECT().GetByPublicIDFull(mock.Anything, "pack1", "user1").Return(entity.Pack{
+		ID:            packUUID,
+		PublicID:      "pack1",
+		Name:          "Pack",
+		Version:       3,
+		Author:        entity.User{PublicID: "user2"},
+		FollowedUsers: []entity.User{follower},
+	}, nil)
+	packRepo.EXPECT().Unfollow(mock.Anything, mock.MatchedBy(func(p entity.Pack) bool {
+		return p.ID == packUUID
+	}), userUUID).Return(nil)
+
+	pack, err := packSvc.UpdateByID(context.Background(), "pack1", "user1", model.PackUpdate{
+		Status: pack_status.None,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, pack_status.None, pack.Status)
+	assert.Equal(t, int64(3), pack.Version)
+	packRepo.AssertExpectations(t)
+}
+
 func TestPackService_GetPlacesByID(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -450,14 +690,16 @@ func TestPackService_GetPlacesByID(t *testing.T) {
 			packID: "pack1",
 			userID: "user1",
 			mockSetup: func(packRepo *storage.MockPackRepository) {
-				packRepo.On("GetByPublicIDFull", "pack1").Return(entity.Pack{
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
 					PublicID: "pack1",
-					Places: []entity.Place{
+					PlaceEntries: []entity.PackPlace{
 						{
-							PublicID: "place1",
-							Name:     "Visited Place",
-							Visitors: []entity.User{
-								{PublicID: "user1"},
+							Place: entity.Place{
+								PublicID: "place1",
+								Name:     "Visited Place",
+								Visitors: []entity.PlaceVisitor{
+									{User: entity.User{PublicID: "user1"}, LastVisitedAt: lastVisit},
+								},
 							},
 						},
 					},
@@ -473,14 +715,16 @@ func TestPackService_GetPlacesByID(t *testing.T) {
 			packID: "pack1",
 			userID: "user1",
 			mockSetup: func(packRepo *storage.MockPackRepository) {
-				packRepo.On("GetByPublicIDFull", "pack1").Return(entity.Pack{
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
 					PublicID: "pack1",
-					Places: []entity.Place{
+					PlaceEntries: []entity.PackPlace{
 						{
-							PublicID: "place2",
-							Name:     "Not Visited Place",
-							Visitors: []entity.User{
-								{PublicID: "user2"},
+							Place: entity.Place{
+								PublicID: "place2",
+								Name:     "Not Visited Place",
+								Visitors: []entity.PlaceVisitor{
+									{User: entity.User{PublicID: "user2"}, LastVisitedAt: lastVisit},
+								},
 							},
 						},
 					},
@@ -496,7 +740,7 @@ func TestPackService_GetPlacesByID(t *testing.T) {
 			packID: "pack404",
 			userID: "user1",
 			mockSetup: func(packRepo *storage.MockPackRepository) {
-				packRepo.On("GetByPublicIDFull", "pack404").Return(entity.Pack{}, errors.New("not found"))
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack404", mock.Anything).Return(entity.Pack{}, errors.New("not found"))
 			},
 			expectedPlaces: nil,
 			expectErr:      true,
@@ -510,17 +754,17 @@ func TestPackService_GetPlacesByID(t *testing.T) {
 				tt.mockSetup(packRepo)
 			}
 
-			got, err := packSvc.GetPlacesByID(tt.packID, tt.userID)
+			got, err := packSvc.GetByID(context.Background(), tt.packID, tt.userID)
 
 			if tt.expectErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, len(tt.expectedPlaces), len(got))
+				assert.Equal(t, len(tt.expectedPlaces), len(got.Places))
 				for i := range tt.expectedPlaces {
-					assert.Equal(t, tt.expectedPlaces[i].Visited, got[i].Visited)
-					assert.Equal(t, tt.expectedPlaces[i].ID, got[i].ID)
-					assert.Equal(t, tt.expectedPlaces[i].Name, got[i].Name)
+					assert.Equal(t, tt.expectedPlaces[i].Visited, got.Places[i].Visited)
+					assert.Equal(t, tt.expectedPlaces[i].ID, got.Places[i].ID)
+					assert.Equal(t, tt.expectedPlaces[i].Name, got.Places[i].Name)
 				}
 			}
 
@@ -530,11 +774,1158 @@ func TestPackService_GetPlacesByID(t *testing.T) {
 }
 
 func TestPackService_UpdateByID_ErrorOnCreateStatus(t *testing.T) {
-	packSvc, _, _, _, _, _ := setupServiceTest(t)
+	packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
+	userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{PublicID: "user1"}, nil)
+	packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+		PublicID: "pack1",
+		Author:   entity.User{PublicID: "user2"},
+	}, nil)
 
-	_, err := packSvc.UpdateByID("pack1", "user1", model.PackUpdate{
+	_, err := packSvc.UpdateByID(context.Background(), "pack1", "user1", model.PackUpdate{
 		Status: pack_status.Created,
 	})
 
 	assert.Error(t, err)
 }
+
+func TestPackService_DeleteByID(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+
+	tests := []struct {
+		name       string
+		setupMocks func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository) {
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{PublicID: "pack1", AuthorID: authorUUID}, nil)
+				packRepo.On("Delete", mock.Anything, mock.AnythingOfType("entity.Pack")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "user is not author",
+			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository) {
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: uuid.New(), PublicID: "user1"}, nil)
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{PublicID: "pack1", AuthorID: authorUUID}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "pack repo error",
+			setupMocks: func(userRepo *storage.MockUserRepository, packRepo *storage.MockPackRepository) {
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{}, errors.New("not found"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
+			tt.setupMocks(userRepo, packRepo)
+
+			err := packSvc.DeleteByID(context.Background(), "pack1", "user1")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			packRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_UpdatePlaceByID(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	position := func(v int) *int { return &v }
+	note := "Lunch here"
+
+	newPack := func() entity.Pack {
+		return entity.Pack{
+			PublicID: "pack1",
+			AuthorID: authorUUID,
+			Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+			PlaceEntries: []entity.PackPlace{
+				{Position: 1, Place: entity.Place{PublicID: "place1"}},
+				{Position: 2, Place: entity.Place{PublicID: "place2"}},
+				{Position: 3, Place: entity.Place{PublicID: "place3"}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		placeID    string
+		update     model.PackPlaceUpdate
+		authorID   uuid.UUID
+		updated    bool
+		wantErr    bool
+		wantPlaces []string
+	}{
+		{
+			name:       "move to first position",
+			placeID:    "place3",
+			update:     model.PackPlaceUpdate{Position: position(1)},
+			authorID:   authorUUID,
+			updated:    true,
+			wantPlaces: []string{"place3", "place1", "place2"},
+		},
+		{
+			name:       "move to last position",
+			placeID:    "place1",
+			update:     model.PackPlaceUpdate{Position: position(3), Note: &note},
+			authorID:   authorUUID,
+			updated:    true,
+			wantPlaces: []string{"place2", "place3", "place1"},
+		},
+		{
+			name:       "note only",
+			placeID:    "place2",
+			update:     model.PackPlaceUpdate{Note: &note},
+			authorID:   authorUUID,
+			updated:    true,
+			wantPlaces: []string{"place1", "place2", "place3"},
+		},
+		{
+			name:     "position out of range",
+			placeID:  "place2",
+			update:   model.PackPlaceUpdate{Position: position(4)},
+			authorID: authorUUID,
+			wantErr:  true,
+		},
+		{
+			name:     "negative planned duration",
+			placeID:  "place2",
+			update:   model.PackPlaceUpdate{PlannedDuration: position(-5)},
+			authorID: authorUUID,
+			wantErr:  true,
+		},
+		{
+			name:     "place is not in pack",
+			placeID:  "place4",
+			update:   model.PackPlaceUpdate{Position: position(1)},
+			authorID: authorUUID,
+			wantErr:  true,
+		},
+		{
+			name:     "user is not author",
+			placeID:  "place1",
+			update:   model.PackPlaceUpdate{Position: position(2)},
+			authorID: uuid.New(),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
+
+			userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: tt.authorID, PublicID: "user1"}, nil)
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(newPack(), nil)
+			if tt.updated {
+				packRepo.On("Update", mock.Anything, mock.MatchedBy(func(p entity.Pack) bool {
+					for i, entry := range p.PlaceEntries {
+						if entry.Position != i+1 {
+							return false
+						}
+					}
+					return len(p.PlaceEntries) == len(tt.wantPlaces)
+				})).Return(nil)
+			}
+
+			pack, err := packSvc.UpdatePlaceByID(context.Background(), "pack1", tt.placeID, "user1", tt.update)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				var gotPlaces []string
+				for i, place := range pack.Places {
+					gotPlaces = append(gotPlaces, place.ID)
+					assert.Equal(t, i+1, place.Position)
+				}
+				assert.Equal(t, tt.wantPlaces, gotPlaces)
+				if tt.update.Note != nil {
+					for _, place := range pack.Places {
+						if place.ID == tt.placeID {
+							assert.Equal(t, note, place.Note)
+						}
+					}
+				}
+			}
+
+			packRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_UpdatePlaceByID_HiddenPlaces(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	hiddenUUID := uuid.New()
+	position := func(v int) *int { return &v }
+
+	newPack := func() entity.Pack {
+		return entity.Pack{
+			PublicID: "pack1",
+			AuthorID: authorUUID,
+			Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+			PlaceEntries: []entity.PackPlace{
+				{Position: 1, Place: entity.Place{PublicID: "place1"}},
+				{PlaceID: hiddenUUID, Position: 2},
+				{Position: 3, Place: entity.Place{PublicID: "place2"}},
+			},
+		}
+	}
+
+	t.Run("position counts visible places only", func(t *testing.T) {
+		packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
+		userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+		packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(newPack(), nil)
+
+		_, err := packSvc.UpdatePlaceByID(context.Background(), "pack1", "place1", "user1", model.PackPlaceUpdate{Position: position(3)})
+
+		assert.ErrorIs(t, err, service.ErrInvalidPosition)
+	})
+
+	t.Run("hidden place keeps its position", func(t *testing.T) {
+		packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
+		userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+		packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(newPack(), nil)
+		packRepo.On("Update", mock.Anything, mock.MatchedBy(func(p entity.Pack) bool {
+			return len(p.PlaceEntries) == 3 &&
+				p.PlaceEntries[0].Place.PublicID == "place2" && p.PlaceEntries[0].Position == 1 &&
+				p.PlaceEntries[1].Place.PublicID == "place1" && p.PlaceEntries[1].Position == 2 &&
+				p.PlaceEntries[2].PlaceID == hiddenUUID && p.PlaceEntries[2].Position == 2
+		})).Return(nil)
+
+		pack, err := packSvc.UpdatePlaceByID(context.Background(), "pack1", "place1", "user1", model.PackPlaceUpdate{Position: position(2)})
+
+		assert.NoError(t, err)
+		assert.Len(t, pack.Places, 2)
+		packRepo.AssertExpectations(t)
+	})
+}
+
+func TestPackService_UpdateByID_KeepsPlacesOrder(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	place1 := entity.Place{ID: uuid.New(), PublicID: "place1"}
+
+	packSvc, _, _, packRepo, placeRepo, userRepo := setupServiceTest(t)
+
+	userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+	packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+		PublicID: "pack1",
+		Name:     "Old Name",
+		AuthorID: authorUUID,
+		Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+		PlaceEntries: []entity.PackPlace{
+			{PlaceID: place1.ID, Position: 1, Note: "Breakfast", Place: place1},
+		},
+	}, nil)
+	placeRepo.On("GetByPublicID", mock.Anything, "place1").Return(place1, nil)
+	placeRepo.On("GetByPublicID", mock.Anything, "place2").Return(entity.Place{ID: uuid.New(), PublicID: "place2"}, nil)
+	placeRepo.On("GetByPublicID", mock.Anything, "place3").Return(entity.Place{ID: uuid.New(), PublicID: "place3"}, nil)
+	packRepo.On("Update", mock.Anything, mock.AnythingOfType("entity.Pack")).Return(nil)
+
+	pack, err := packSvc.UpdateByID(context.Background(), "pack1", "user1", model.PackUpdate{
+		Name:      "New Name",
+		Status:    pack_status.Created,
+		PlacesIDs: []string{"place3", "place1", "place2", "place3"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, pack.Places, 3)
+	assert.Equal(t, model.Place{ID: "place3", Position: 1}, pack.Places[0])
+	assert.Equal(t, model.Place{ID: "place1", Position: 2, Note: "Breakfast"}, pack.Places[1])
+	assert.Equal(t, model.Place{ID: "place2", Position: 3}, pack.Places[2])
+}
+
+func TestPackService_UpdateByID_KeepsHiddenPlaces(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	hiddenUUID := uuid.New()
+	place1 := entity.Place{ID: uuid.New(), PublicID: "place1"}
+
+	packSvc, _, _, packRepo, placeRepo, userRepo := setupServiceTest(t)
+
+	userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+	packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+		PublicID: "pack1",
+		AuthorID: authorUUID,
+		Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+		PlaceEntries: []entity.PackPlace{
+			{PlaceID: hiddenUUID, Position: 1},
+			{PlaceID: place1.ID, Position: 2, Place: place1},
+		},
+	}, nil)
+	placeRepo.On("GetByPublicID", mock.Anything, "place1").Return(place1, nil)
+	packRepo.On("Update", mock.Anything, mock.MatchedBy(func(p entity.Pack) bool {
+		return len(p.PlaceEntries) == 2 &&
+			p.PlaceEntries[0].PlaceID == place1.ID && p.PlaceEntries[0].Position == 1 &&
+			p.PlaceEntries[1].PlaceID == hiddenUUID && p.PlaceEntries[1].Position == 1
+	})).Return(nil)
+
+	pack, err := packSvc.UpdateByID(context.Background(), "pack1", "user1", model.PackUpdate{
+		Name:      "New Name",
+		Status:    pack_status.Created,
+		PlacesIDs: []string{"place1"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Place{{ID: "place1", Position: 1}}, pack.Places)
+	packRepo.AssertExpectations(t)
+}
+
+func TestPackService_UpdateByID_UnknownPlace(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+
+	packSvc, _, _, packRepo, placeRepo, userRepo := setupServiceTest(t)
+
+	userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+	packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+		PublicID: "pack1",
+		AuthorID: authorUUID,
+		Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+	}, nil)
+	placeRepo.On("GetByPublicID", mock.Anything, "missing").Return(entity.Place{}, storage.ErrNotFound)
+
+	_, err := packSvc.UpdateByID(context.Background(), "pack1", "user1", model.PackUpdate{
+		Name:      "New Name",
+		Status:    pack_status.Created,
+		PlacesIDs: []string{"missing"},
+	})
+
+	assert.ErrorIs(t, err, service.ErrPlaceNotFound)
+	packRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestPackService_UpdateByID_RecordsAddedPlaces(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	packUUID := uuid.New()
+	place1 := entity.Place{ID: uuid.New(), PublicID: "place1"}
+	place2UUID := uuid.New()
+
+	packRepo := new(storage.MockPackRepository)
+	placeRepo := new(storage.MockPlaceRepository)
+	userRepo := new(storage.MockUserRepository)
+	activityRepo := new(storage.MockActivityRepository)
+	packSvc := NewPackService(packRepo, placeRepo, userRepo, new(storage.MockPackCollaboratorRepository), new(storage.MockShareLinkRepository), activityRepo, testSigner(t), inlineUnitOfWork{})
+
+	userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+	packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+		ID:       packUUID,
+		PublicID: "pack1",
+		AuthorID: authorUUID,
+		Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+		PlaceEntries: []entity.PackPlace{
+			{PackID: packUUID, PlaceID: place1.ID, Position: 1, Place: place1},
+		},
+	}, nil)
+	placeRepo.On("GetByPublicID", mock.Anything, "place1").Return(place1, nil)
+	placeRepo.On("GetByPublicID", mock.Anything, "place2").Return(entity.Place{ID: place2UUID, PublicID: "place2"}, nil)
+	packRepo.On("Update", mock.Anything, mock.AnythingOfType("entity.Pack")).Return(nil)
+	activityRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(a entity.Activity) bool {
+		return a.PublicID != "" && a.Type == activity_type.PlaceAdded && a.UserID == authorUUID && *a.PackID == packUUID && *a.PlaceID == place2UUID
+	})).Return(nil).Once()
+
+	_, err := packSvc.UpdateByID(context.Background(), "pack1", "user1", model.PackUpdate{
+		Name:      "Paris",
+		Status:    pack_status.Created,
+		PlacesIDs: []string{"place1", "place2"},
+	})
+
+	assert.NoError(t, err)
+	activityRepo.AssertExpectations(t)
+}
+
+func TestPackService_UpdateByID_Collaborator(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	collaboratorUUID := uuid.New()
+	accepted := time.Now()
+
+	tests := []struct {
+		name       string
+		role       string
+		acceptedAt *time.Time
+		wantStatus string
+		wantErr    error
+	}{
+		{
+			name:       "editor renames pack",
+			role:       collaborator_role.Editor,
+			acceptedAt: &accepted,
+			wantStatus: pack_status.Editor,
+		},
+		{
+			name:       "viewer cannot edit",
+			role:       collaborator_role.Viewer,
+			acceptedAt: &accepted,
+			wantErr:    service.ErrNotEditor,
+		},
+		{
+			name:    "invited editor cannot edit",
+			role:    collaborator_role.Editor,
+			wantErr: service.ErrNotEditor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, userRepo, _ := setupCollaboratorTest(t)
+
+			collaborator := entity.User{ID: collaboratorUUID, PublicID: "user2"}
+			userRepo.On("GetByPublicID", mock.Anything, "user2").Return(collaborator, nil)
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+				PublicID: "pack1",
+				Name:     "Old Name",
+				AuthorID: authorUUID,
+				Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+				Collaborators: []entity.PackCollaborator{
+					{UserID: collaboratorUUID, Role: tt.role, AcceptedAt: tt.acceptedAt, User: collaborator},
+				},
+			}, nil)
+			if tt.wantErr == nil {
+				packRepo.On("Update", mock.Anything, mock.MatchedBy(func(p entity.Pack) bool {
+					return p.Name == "New Name"
+				})).Return(nil)
+			}
+
+			pack, err := packSvc.UpdateByID(context.Background(), "pack1", "user2", model.PackUpdate{Name: "New Name"})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "New Name", pack.Name)
+				assert.Equal(t, tt.wantStatus, pack.Status)
+			}
+
+			packRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_InviteCollaborator(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	inviteeUUID := uuid.New()
+	banned := time.Now()
+
+	tests := []struct {
+		name        string
+		userID      string
+		invite      model.CollaboratorInvite
+		invitee     entity.User
+		existing    []entity.PackCollaborator
+		wantCreate  bool
+		wantUpdate  bool
+		expectedErr error
+	}{
+		{
+			name:       "new collaborator",
+			userID:     "user1",
+			invite:     model.CollaboratorInvite{UserID: "user2", Role: collaborator_role.Editor},
+			wantCreate: true,
+		},
+		{
+			name:       "existing collaborator changes role",
+			userID:     "user1",
+			invite:     model.CollaboratorInvite{UserID: "user2", Role: collaborator_role.Viewer},
+			existing:   []entity.PackCollaborator{{UserID: inviteeUUID, Role: collaborator_role.Editor, User: entity.User{ID: inviteeUUID, PublicID: "user2"}}},
+			wantUpdate: true,
+		},
+		{
+			name:        "invalid role",
+			userID:      "user1",
+			invite:      model.CollaboratorInvite{UserID: "user2", Role: "OWNER"},
+			expectedErr: service.ErrInvalidRole,
+		},
+		{
+			name:        "not author",
+			userID:      "user2",
+			invite:      model.CollaboratorInvite{UserID: "user2", Role: collaborator_role.Editor},
+			expectedErr: service.ErrNotAuthor,
+		},
+		{
+			name:        "self invitation",
+			userID:      "user1",
+			invite:      model.CollaboratorInvite{UserID: "user1", Role: collaborator_role.Editor},
+			expectedErr: service.ErrSelfInvitation,
+		},
+		{
+			name:        "banned invitee",
+			userID:      "user1",
+			invite:      model.CollaboratorInvite{UserID: "user2", Role: collaborator_role.Editor},
+			invitee:     entity.User{ID: inviteeUUID, PublicID: "user2", BannedAt: &banned},
+			expectedErr: service.ErrUserBanned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, userRepo, collaboratorRepo := setupCollaboratorTest(t)
+
+			invitee := entity.User{ID: inviteeUUID, PublicID: "user2"}
+			if tt.invitee.BannedAt != nil {
+				invitee = tt.invitee
+			}
+			userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil).Maybe()
+			userRepo.On("GetByPublicID", mock.Anything, "user2").Return(invitee, nil).Maybe()
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+				PublicID:      "pack1",
+				AuthorID:      authorUUID,
+				Author:        entity.User{ID: authorUUID, PublicID: "user1"},
+				Collaborators: tt.existing,
+			}, nil).Maybe()
+			if tt.wantCreate {
+				collaboratorRepo.On("Create", mock.Anything, mock.MatchedBy(func(c entity.PackCollaborator) bool {
+					return c.UserID == inviteeUUID && c.Role == tt.invite.Role && c.AcceptedAt == nil
+				})).Return(nil)
+			}
+			if tt.wantUpdate {
+				collaboratorRepo.On("Update", mock.Anything, mock.MatchedBy(func(c entity.PackCollaborator) bool {
+					return c.UserID == inviteeUUID && c.Role == tt.invite.Role
+				})).Return(nil)
+			}
+
+			collaborator, err := packSvc.InviteCollaborator(context.Background(), "pack1", tt.userID, tt.invite)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, model.Collaborator{
+					User: model.User{ID: "user2"},
+					Role: tt.invite.Role,
+				}, collaborator)
+			}
+
+			collaboratorRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_AcceptInvitation(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	inviteeUUID := uuid.New()
+
+	packSvc, packRepo, _, collaboratorRepo := setupCollaboratorTest(t)
+
+	invitee := entity.User{ID: inviteeUUID, PublicID: "user2"}
+	packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+		PublicID: "pack1",
+		AuthorID: authorUUID,
+		Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+		Collaborators: []entity.PackCollaborator{
+			{UserID: inviteeUUID, Role: collaborator_role.Viewer, User: invitee},
+		},
+	}, nil).Once()
+	collaboratorRepo.On("Update", mock.Anything, mock.MatchedBy(func(c entity.PackCollaborator) bool {
+		return c.UserID == inviteeUUID && c.AcceptedAt != nil
+	})).Return(nil)
+	accepted := time.Now()
+	packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+		PublicID: "pack1",
+		AuthorID: authorUUID,
+		Author:   entity.User{ID: authorUUID, PublicID: "user1"},
+		Collaborators: []entity.PackCollaborator{
+			{UserID: inviteeUUID, Role: collaborator_role.Viewer, AcceptedAt: &accepted, User: invitee},
+		},
+	}, nil).Once()
+
+	pack, err := packSvc.AcceptInvitation(context.Background(), "pack1", "user2")
+
+	assert.NoError(t, err)
+	assert.Equal(t, pack_status.Viewer, pack.Status)
+	assert.Equal(t, []model.Collaborator{
+		{User: model.User{ID: "user2"}, Role: collaborator_role.Viewer, Accepted: true},
+	}, pack.Collaborators)
+
+	collaboratorRepo.AssertExpectations(t)
+}
+
+func TestPackService_AcceptInvitation_PrivatePack(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	inviteeUUID := uuid.New()
+	packUUID := uuid.New()
+	invitee := entity.User{ID: inviteeUUID, PublicID: "user2"}
+	privatePack := entity.Pack{
+		ID:         packUUID,
+		PublicID:   "pack1",
+		Visibility: pack_visibility.Private,
+		AuthorID:   authorUUID,
+		Author:     entity.User{ID: authorUUID, PublicID: "user1"},
+		Collaborators: []entity.PackCollaborator{
+			{UserID: inviteeUUID, Role: collaborator_role.Editor, User: invitee},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		userID      string
+		expectedErr error
+	}{
+		{
+			name:   "invitee accepts",
+			userID: "user2",
+		},
+		{
+			name:        "other user",
+			userID:      "user3",
+			expectedErr: service.ErrPackNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, _, collaboratorRepo := setupCollaboratorTest(t)
+
+			// Private packs are hidden from users until they accept.
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", tt.userID).Return(entity.Pack{}, storage.ErrNotFound).Once()
+			packRepo.On("GetByPublicID", mock.Anything, "pack1").Return(entity.Pack{ID: packUUID, PublicID: "pack1"}, nil)
+			packRepo.On("GetByIDFull", mock.Anything, packUUID).Return(privatePack, nil)
+			if tt.expectedErr == nil {
+				collaboratorRepo.On("Update", mock.Anything, mock.MatchedBy(func(c entity.PackCollaborator) bool {
+					return c.UserID == inviteeUUID && c.AcceptedAt != nil
+				})).Return(nil)
+				accepted := privatePack
+				accepted.Collaborators = []entity.PackCollaborator{
+					{UserID: inviteeUUID, Role: collaborator_role.Editor, AcceptedAt: &time.Time{}, User: invitee},
+				}
+				packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", tt.userID).Return(accepted, nil).Once()
+			}
+
+			pack, err := packSvc.AcceptInvitation(context.Background(), "pack1", tt.userID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, pack_status.Editor, pack.Status)
+			}
+
+			collaboratorRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_RemoveCollaborator(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	collaboratorUUID := uuid.New()
+	otherUUID := uuid.New()
+
+	tests := []struct {
+		name           string
+		userID         string
+		collaboratorID string
+		expectedErr    error
+	}{
+		{
+			name:           "author removes collaborator",
+			userID:         "user1",
+			collaboratorID: "user2",
+		},
+		{
+			name:           "collaborator leaves pack",
+			userID:         "user2",
+			collaboratorID: "user2",
+		},
+		{
+			name:           "other user cannot remove collaborator",
+			userID:         "user3",
+			collaboratorID: "user2",
+			expectedErr:    service.ErrNotAuthor,
+		},
+		{
+			name:           "collaborator not found",
+			userID:         "user1",
+			collaboratorID: "user3",
+			expectedErr:    service.ErrCollaboratorNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, userRepo, collaboratorRepo := setupCollaboratorTest(t)
+
+			users := map[string]entity.User{
+				"user1": {ID: authorUUID, PublicID: "user1"},
+				"user2": {ID: collaboratorUUID, PublicID: "user2"},
+				"user3": {ID: otherUUID, PublicID: "user3"},
+			}
+			collaborator := entity.PackCollaborator{UserID: collaboratorUUID, Role: collaborator_role.Editor, User: users["user2"]}
+			userRepo.On("GetByPublicID", mock.Anything, tt.userID).Return(users[tt.userID], nil)
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", mock.Anything).Return(entity.Pack{
+				PublicID:      "pack1",
+				AuthorID:      authorUUID,
+				Author:        users["user1"],
+				Collaborators: []entity.PackCollaborator{collaborator},
+			}, nil)
+			if tt.expectedErr == nil {
+				collaboratorRepo.On("Delete", mock.Anything, collaborator).Return(nil)
+			}
+
+			err := packSvc.RemoveCollaborator(context.Background(), "pack1", tt.collaboratorID, tt.userID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			collaboratorRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_Create_Visibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		visibility     string
+		wantVisibility string
+		expectedErr    error
+	}{
+		{
+			name:           "defaults to public",
+			wantVisibility: pack_visibility.Public,
+		},
+		{
+			name:           "private",
+			visibility:     pack_visibility.Private,
+			wantVisibility: pack_visibility.Private,
+		},
+		{
+			name:        "invalid visibility",
+			visibility:  "SECRET",
+			expectedErr: service.ErrInvalidVisibility,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, _, _, packRepo, _, userRepo := setupServiceTest(t)
+			userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: uuid.New(), PublicID: "user1"}, nil)
+			if tt.expectedErr == nil {
+				packRepo.On("Create", mock.Anything, mock.MatchedBy(func(p entity.Pack) bool {
+					return p.Visibility == tt.wantVisibility
+				})).Return(nil)
+			}
+
+			pack, err := packSvc.Create(context.Background(), "user1", model.PackCreate{Name: "Wishlist", Visibility: tt.visibility})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantVisibility, pack.Visibility)
+			}
+
+			packRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_UpdateByID_Visibility(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	editorUUID := uuid.New()
+	accepted := time.Now()
+
+	tests := []struct {
+		name           string
+		userID         string
+		visibility     string
+		wantVisibility string
+		expectedErr    error
+	}{
+		{
+			name:           "author makes pack private",
+			userID:         "user1",
+			visibility:     pack_visibility.Private,
+			wantVisibility: pack_visibility.Private,
+		},
+		{
+			name:           "empty visibility keeps current",
+			userID:         "user1",
+			wantVisibility: pack_visibility.Public,
+		},
+		{
+			name:        "invalid visibility",
+			userID:      "user1",
+			visibility:  "SECRET",
+			expectedErr: service.ErrInvalidVisibility,
+		},
+		{
+			name:        "editor cannot change visibility",
+			userID:      "user2",
+			visibility:  pack_visibility.Unlisted,
+			expectedErr: service.ErrNotAuthor,
+		},
+		{
+			name:           "editor keeps visibility",
+			userID:         "user2",
+			visibility:     pack_visibility.Public,
+			wantVisibility: pack_visibility.Public,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, userRepo, _ := setupCollaboratorTest(t)
+
+			editor := entity.User{ID: editorUUID, PublicID: "user2"}
+			userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil).Maybe()
+			userRepo.On("GetByPublicID", mock.Anything, "user2").Return(editor, nil).Maybe()
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", tt.userID).Return(entity.Pack{
+				PublicID:   "pack1",
+				Name:       "Wishlist",
+				Visibility: pack_visibility.Public,
+				AuthorID:   authorUUID,
+				Author:     entity.User{ID: authorUUID, PublicID: "user1"},
+				Collaborators: []entity.PackCollaborator{
+					{UserID: editorUUID, Role: collaborator_role.Editor, AcceptedAt: &accepted, User: editor},
+				},
+			}, nil)
+			if tt.expectedErr == nil {
+				packRepo.On("Update", mock.Anything, mock.MatchedBy(func(p entity.Pack) bool {
+					return p.Visibility == tt.wantVisibility
+				})).Return(nil)
+			}
+
+			pack, err := packSvc.UpdateByID(context.Background(), "pack1", tt.userID, model.PackUpdate{
+				Name:       "Wishlist",
+				Visibility: tt.visibility,
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantVisibility, pack.Visibility)
+			}
+
+			packRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_CreateShareLink(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		userID      string
+		expiresAt   *time.Time
+		expectedErr error
+	}{
+		{
+			name:   "without expiry",
+			userID: "user1",
+		},
+		{
+			name:      "with expiry",
+			userID:    "user1",
+			expiresAt: &future,
+		},
+		{
+			name:        "expiry in the past",
+			userID:      "user1",
+			expiresAt:   &past,
+			expectedErr: service.ErrInvalidExpiry,
+		},
+		{
+			name:        "not author",
+			userID:      "user2",
+			expectedErr: service.ErrNotAuthor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, userRepo, _, shareLinkRepo := setupShareLinkTest(t)
+
+			packUUID := uuid.New()
+			userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil).Maybe()
+			userRepo.On("GetByPublicID", mock.Anything, "user2").Return(entity.User{ID: uuid.New(), PublicID: "user2"}, nil).Maybe()
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", tt.userID).Return(entity.Pack{
+				ID:       packUUID,
+				PublicID: "pack1",
+				AuthorID: authorUUID,
+			}, nil)
+			var created entity.ShareLink
+			if tt.expectedErr == nil {
+				shareLinkRepo.On("Create", mock.Anything, mock.MatchedBy(func(l entity.ShareLink) bool {
+					created = l
+					return l.PackID == packUUID && l.PublicID != "" && l.ExpiresAt == tt.expiresAt
+				})).Return(nil)
+			}
+
+			shareLink, err := packSvc.CreateShareLink(context.Background(), "pack1", tt.userID, model.ShareLinkCreate{ExpiresAt: tt.expiresAt})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, created.PublicID, shareLink.ID)
+				value, err := testSigner(t).Verify(shareLink.Token)
+				assert.NoError(t, err)
+				assert.Equal(t, created.PublicID, string(value))
+			}
+
+			shareLinkRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_RevokeShareLink(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+	packUUID := uuid.New()
+	linkUUID := uuid.New()
+	revoked := time.Now()
+
+	tests := []struct {
+		name        string
+		linkID      string
+		link        entity.ShareLink
+		linkErr     error
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			linkID: "link1",
+			link:   entity.ShareLink{ID: linkUUID, PublicID: "link1", PackID: packUUID},
+		},
+		{
+			name:        "link not found",
+			linkID:      "link1",
+			linkErr:     storage.ErrNotFound,
+			expectedErr: service.ErrShareLinkNotFound,
+		},
+		{
+			name:        "link of another pack",
+			linkID:      "link1",
+			link:        entity.ShareLink{ID: linkUUID, PublicID: "link1", PackID: uuid.New()},
+			expectedErr: service.ErrShareLinkNotFound,
+		},
+		{
+			name:        "already revoked",
+			linkID:      "link1",
+			link:        entity.ShareLink{ID: linkUUID, PublicID: "link1", PackID: packUUID, RevokedAt: &revoked},
+			expectedErr: service.ErrShareLinkNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, userRepo, _, shareLinkRepo := setupShareLinkTest(t)
+
+			userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: authorUUID, PublicID: "user1"}, nil)
+			packRepo.On("GetByPublicIDFull", mock.Anything, "pack1", "user1").Return(entity.Pack{
+				ID:       packUUID,
+				PublicID: "pack1",
+				AuthorID: authorUUID,
+			}, nil)
+			shareLinkRepo.On("GetByPublicID", mock.Anything, "link1").Return(tt.link, tt.linkErr).Maybe()
+			if tt.expectedErr == nil {
+				shareLinkRepo.On("Update", mock.Anything, mock.MatchedBy(func(l entity.ShareLink) bool {
+					return l.ID == linkUUID && l.RevokedAt != nil
+				})).Return(nil)
+			}
+
+			err := packSvc.RevokeShareLink(context.Background(), "pack1", tt.linkID, "user1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			shareLinkRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPackService_GetByShareToken(t *testing.T) {
+	t.Parallel()
+
+	packUUID := uuid.New()
+	linkUUID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	token := testSigner(t).Sign([]byte("link1"))
+
+	tests := []struct {
+		name        string
+		token       string
+		link        entity.ShareLink
+		expectedErr error
+	}{
+		{
+			name:  "private pack",
+			token: token,
+			link:  entity.ShareLink{ID: linkUUID, PublicID: "link1", PackID: packUUID},
+		},
+		{
+			name:  "not expired yet",
+			token: token,
+			link:  entity.ShareLink{ID: linkUUID, PublicID: "link1", PackID: packUUID, ExpiresAt: &future},
+		},
+		{
+			name:        "tampered token",
+			token:       token + "x",
+			expectedErr: service.ErrShareLinkNotFound,
+		},
+		{
+			name:        "revoked",
+			token:       token,
+			link:        entity.ShareLink{ID: linkUUID, PublicID: "link1", PackID: packUUID, RevokedAt: &past},
+			expectedErr: service.ErrShareLinkNotFound,
+		},
+		{
+			name:        "expired",
+			token:       token,
+			link:        entity.ShareLink{ID: linkUUID, PublicID: "link1", PackID: packUUID, ExpiresAt: &past},
+			expectedErr: service.ErrShareLinkNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, _, _, shareLinkRepo := setupShareLinkTest(t)
+
+			shareLinkRepo.On("GetByPublicID", mock.Anything, "link1").Return(tt.link, nil).Maybe()
+			packRepo.On("GetByIDFull", mock.Anything, packUUID).Return(entity.Pack{
+				ID:         packUUID,
+				PublicID:   "pack1",
+				Name:       "Wishlist",
+				Visibility: pack_visibility.Private,
+				Author:     entity.User{PublicID: "user1"},
+			}, nil).Maybe()
+
+			pack, err := packSvc.GetByShareToken(context.Background(), tt.token, "user2")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "pack1", pack.ID)
+				assert.Equal(t, pack_status.None, pack.Status)
+			}
+		})
+	}
+}
+
+func TestPackService_FollowByShareToken(t *testing.T) {
+	t.Parallel()
+
+	packUUID := uuid.New()
+	linkUUID := uuid.New()
+	userUUID := uuid.New()
+	token := testSigner(t).Sign([]byte("link1"))
+
+	tests := []struct {
+		name       string
+		visibility string
+		wantStatus string
+	}{
+		{
+			name:       "unlisted pack is followed",
+			visibility: pack_visibility.Unlisted,
+			wantStatus: pack_status.Followed,
+		},
+		{
+			name:       "private pack adds viewer",
+			visibility: pack_visibility.Private,
+			wantStatus: pack_status.Viewer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			packSvc, packRepo, userRepo, collaboratorRepo, shareLinkRepo := setupShareLinkTest(t)
+
+			userRepo.On("GetByPublicID", mock.Anything, "user2").Return(entity.User{ID: userUUID, PublicID: "user2"}, nil)
+			shareLinkRepo.On("GetByPublicID", mock.Anything, "link1").Return(entity.ShareLink{ID: linkUUID, PublicID: "link1", PackID: packUUID}, nil)
+			packRepo.On("GetByIDFull", mock.Anything, packUUID).Return(entity.Pack{
+				ID:         packUUID,
+				PublicID:   "pack1",
+				Visibility: tt.visibility,
+				Author:     entity.User{PublicID: "user1"},
+			}, nil)
+			if tt.visibility == pack_visibility.Private {
+				collaboratorRepo.On("Create", mock.Anything, mock.MatchedBy(func(c entity.PackCollaborator) bool {
+					return c.UserID == userUUID && c.Role == collaborator_role.Viewer && c.AcceptedAt != nil
+				})).Return(nil)
+			} else {
+				packRepo.On("Follow", mock.Anything, mock.MatchedBy(func(p entity.Pack) bool {
+					return p.ID == packUUID
+				}), userUUID).Return(nil)
+			}
+
+			pack, err := packSvc.FollowByShareToken(context.Background(), token, "user2")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, pack.Status)
+
+			packRepo.AssertExpectations(t)
+			collaboratorRepo.AssertExpectations(t)
+		})
+	}
+}
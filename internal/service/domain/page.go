@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/utils/cursor"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+var errCursorScores = errors.New("cursor does not match the sort")
+
+// pageBounds returns the limit of the page and the cursor it starts after,
+// for a list sorted by as many scores as given.
+func pageBounds(page model.Page, scores int) (int, *cursor.Cursor, error) {
+	after, err := cursor.Decode(page.Cursor)
+	if err != nil {
+		return 0, nil, service.ErrInvalidPage.Wrap(err)
+	}
+	if after != nil && len(after.Scores) != scores {
+		return 0, nil, service.ErrInvalidPage.Wrap(errCursorScores)
+	}
+
+	limit := page.Limit
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	return limit, after, nil
+}
+
+// pageOf returns the items of a page and its info. Lists are fetched with one
+// item past the limit, which tells that there is a next page. The next page
+// starts after the last item kept, whose position is given by key.
+func pageOf[T any](items []T, limit int, total int64, key func(T) cursor.Cursor) ([]T, model.PageInfo) {
+	info := model.PageInfo{Total: total}
+	if len(items) > limit {
+		items = items[:limit]
+		info.NextCursor = cursor.Encode(key(items[limit-1]))
+	}
+	return items, info
+}
@@ -1,41 +1,49 @@
 package domain
 
 import (
-	"errors"
+	"context"
+	"strings"
 
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/internal/storage"
 	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/place_sort"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
 	"locpack-backend/pkg/utils/random"
 
 	"github.com/jinzhu/copier"
+	"slices"
+)
+
+const (
+	maxNearbyRadius = 50000
+	maxNearbyPlaces = 200
 )
 
 type placeServiceImpl struct {
-	placeRepository storage.PlaceRepository
-	userRepository  storage.UserRepository
+	placeRepository    storage.PlaceRepository
+	userRepository     storage.UserRepository
+	activityRepository storage.ActivityRepository
+	visitRepository    storage.VisitRepository
+	unitOfWork         storage.UnitOfWork
 }
 
 func NewPlaceService(
 	placeRepository storage.PlaceRepository,
 	userRepository storage.UserRepository,
+	activityRepository storage.ActivityRepository,
+	visitRepository storage.VisitRepository,
+	unitOfWork storage.UnitOfWork,
 ) service.PlaceService {
-	return &placeServiceImpl{placeRepository, userRepository}
+	return &placeServiceImpl{placeRepository, userRepository, activityRepository, visitRepository, unitOfWork}
 }
 
-func (s *placeServiceImpl) GetByID(placeID string, userID string) (model.Place, error) {
-	placeEntity, err := s.placeRepository.GetByPublicIDFull(placeID)
+func (s *placeServiceImpl) GetByID(ctx context.Context, placeID string, userID string) (model.Place, error) {
+	placeEntity, err := s.placeRepository.GetByPublicIDFull(ctx, placeID)
 	if err != nil {
-		return model.Place{}, err
-	}
-
-	visited := false
-	for _, visitor := range placeEntity.Visitors {
-		if visitor.PublicID == userID {
-			visited = true
-			break
-		}
+		return model.Place{}, storageError(err, service.ErrPlaceNotFound)
 	}
 
 	var place model.Place
@@ -44,13 +52,74 @@ func (s *placeServiceImpl) GetByID(placeID string, userID string) (model.Place,
 	if err != nil {
 		return model.Place{}, err
 	}
-	place.Visited = visited
+	place.LastVisitedAt = lastVisitedAt(placeEntity, userID)
+	place.Visited = place.LastVisitedAt != nil
+	place.Media = mapMediaEntitiesToModels(placeEntity.Media)
 
 	return place, nil
 }
 
-func (s *placeServiceImpl) GetByNameOrAddress(query string, userID string) ([]model.Place, error) {
-	placesEntities, err := s.placeRepository.GetByNameOrAddressFull(query)
+// GetByNameOrAddress sorts places by relevance unless sort is
+// place_sort.Rating. An empty sort means relevance.
+func (s *placeServiceImpl) GetByNameOrAddress(ctx context.Context, query string, sort types.PlaceSort, userID string, page model.Page) ([]model.Place, model.PageInfo, error) {
+	if sort == "" {
+		sort = place_sort.Relevance
+	}
+	if sort != place_sort.Relevance && sort != place_sort.Rating {
+		return []model.Place{}, model.PageInfo{}, service.ErrInvalidSort
+	}
+
+	// Places are sorted by rating first when asked, then by relevance when
+	// searching.
+	scores := func(p entity.Place) []float64 {
+		s := []float64{}
+		if sort == place_sort.Rating {
+			s = append(s, p.RatingAverage, float64(p.RatingCount))
+		}
+		if len(query) != 0 {
+			s = append(s, p.Rank)
+		}
+		return s
+	}
+
+	limit, after, err := pageBounds(page, len(scores(entity.Place{})))
+	if err != nil {
+		return []model.Place{}, model.PageInfo{}, err
+	}
+
+	placesEntities, total, err := s.placeRepository.GetByNameOrAddressFull(ctx, query, sort, limit+1, after)
+	if err != nil {
+		return []model.Place{}, model.PageInfo{}, err
+	}
+	placesEntities, info := pageOf(placesEntities, limit, total, func(p entity.Place) cursor.Cursor {
+		return cursor.Cursor{Scores: scores(p), Time: p.CreatedAt, ID: p.PublicID}
+	})
+
+	var foundPlaces []model.Place
+
+	for _, placeEntity := range placesEntities {
+		var place model.Place
+		err = copier.Copy(&place, &placeEntity)
+		if err != nil {
+			return []model.Place{}, model.PageInfo{}, err
+		}
+		place.LastVisitedAt = lastVisitedAt(placeEntity, userID)
+		place.Visited = place.LastVisitedAt != nil
+		place.NameHighlight = highlight(placeEntity.NameHeadline)
+		place.AddressHighlight = highlight(placeEntity.AddressHeadline)
+
+		foundPlaces = append(foundPlaces, place)
+	}
+
+	return foundPlaces, info, nil
+}
+
+func (s *placeServiceImpl) GetNearby(ctx context.Context, lat float64, lng float64, radius float64, userID string) ([]model.Place, error) {
+	if radius <= 0 || radius > maxNearbyRadius {
+		return []model.Place{}, service.ErrInvalidRadius
+	}
+
+	placesEntities, err := s.placeRepository.GetNearbyFull(ctx, lat, lng, radius, maxNearbyPlaces)
 	if err != nil {
 		return []model.Place{}, err
 	}
@@ -58,20 +127,43 @@ func (s *placeServiceImpl) GetByNameOrAddress(query string, userID string) ([]mo
 	var foundPlaces []model.Place
 
 	for _, placeEntity := range placesEntities {
-		visited := false
-		for _, visitor := range placeEntity.Visitors {
-			if visitor.PublicID == userID {
-				visited = true
-				break
-			}
+		var place model.Place
+		err = copier.Copy(&place, &placeEntity)
+		if err != nil {
+			return []model.Place{}, err
 		}
+		place.LastVisitedAt = lastVisitedAt(placeEntity, userID)
+		place.Visited = place.LastVisitedAt != nil
 
+		foundPlaces = append(foundPlaces, place)
+	}
+
+	return foundPlaces, nil
+}
+
+// GetDuplicates returns existing places that are likely the same as the
+// place to create, so that users can pick one of them instead.
+func (s *placeServiceImpl) GetDuplicates(ctx context.Context, pc model.PlaceCreate, userID string) ([]model.Place, error) {
+	err := validateLocation(pc.Latitude, pc.Longitude)
+	if err != nil {
+		return []model.Place{}, err
+	}
+
+	placesEntities, err := s.placeRepository.GetDuplicatesFull(ctx, pc.Name, pc.Address, pc.Latitude, pc.Longitude)
+	if err != nil {
+		return []model.Place{}, err
+	}
+
+	var foundPlaces []model.Place
+
+	for _, placeEntity := range placesEntities {
 		var place model.Place
 		err = copier.Copy(&place, &placeEntity)
 		if err != nil {
 			return []model.Place{}, err
 		}
-		place.Visited = visited
+		place.LastVisitedAt = lastVisitedAt(placeEntity, userID)
+		place.Visited = place.LastVisitedAt != nil
 
 		foundPlaces = append(foundPlaces, place)
 	}
@@ -79,80 +171,262 @@ func (s *placeServiceImpl) GetByNameOrAddress(query string, userID string) ([]mo
 	return foundPlaces, nil
 }
 
-func (s *placeServiceImpl) Create(userID string, pc model.PlaceCreate) (model.Place, error) {
-	userEntity, err := s.userRepository.GetByPublicID(userID)
+// Create fails with ErrPossibleDuplicate when similar places exist, unless
+// pc.IgnoreDuplicates is set.
+func (s *placeServiceImpl) Create(ctx context.Context, userID string, pc model.PlaceCreate) (model.Place, error) {
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Place, error) {
+		return s.create(ctx, userID, pc)
+	})
+}
+
+func (s *placeServiceImpl) create(ctx context.Context, userID string, pc model.PlaceCreate) (model.Place, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Place{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	countryCode, err := normalizeCountryCode(pc.CountryCode)
+	if err != nil {
+		return model.Place{}, err
+	}
+	err = validateLocation(pc.Latitude, pc.Longitude)
 	if err != nil {
 		return model.Place{}, err
 	}
 
-	var visitors []entity.User
-	if pc.Visited {
-		visitors = append(visitors, userEntity)
+	if !pc.IgnoreDuplicates {
+		duplicates, err := s.placeRepository.GetDuplicatesFull(ctx, pc.Name, pc.Address, pc.Latitude, pc.Longitude)
+		if err != nil {
+			return model.Place{}, err
+		}
+		if len(duplicates) != 0 {
+			return model.Place{}, service.ErrPossibleDuplicate
+		}
 	}
 
 	placeEntity := entity.Place{
-		ID:       random.GenerateID(),
-		PublicID: random.GeneratePublicID(),
-		Name:     pc.Name,
-		Address:  pc.Address,
-		AuthorID: userEntity.ID,
-		Visitors: visitors,
+		ID:        random.GenerateID(),
+		PublicID:  random.GeneratePublicID(),
+		Name:      pc.Name,
+		Address:   pc.Address,
+		Latitude:  pc.Latitude,
+		Longitude: pc.Longitude,
+		Version:   1,
+		AuthorID:  userEntity.ID,
+
+		CountryCode: countryCode,
 	}
 
-	err = s.placeRepository.Create(placeEntity)
+	var visit *entity.Visit
+	if pc.Visited || pc.Visit != nil {
+		v, err := newVisit(userEntity, placeEntity, pc.Visit)
+		if err != nil {
+			return model.Place{}, err
+		}
+		visit = &v
+	}
+
+	err = s.placeRepository.Create(ctx, placeEntity)
 	if err != nil {
 		return model.Place{}, err
 	}
 
+	if visit != nil {
+		err = checkIn(ctx, s.visitRepository, s.activityRepository, userEntity, placeEntity, *visit)
+		if err != nil {
+			return model.Place{}, err
+		}
+	}
+
 	place := model.Place{}
 	err = copier.Copy(&place, &placeEntity)
 	if err != nil {
 		return model.Place{}, err
 	}
 
-	place.Visited = pc.Visited
+	if visit != nil {
+		place.Visited = true
+		place.LastVisitedAt = &visit.VisitedAt
+	}
 
 	return place, err
 }
 
-func (s *placeServiceImpl) UpdateByID(placeID string, userID string, pu model.PlaceUpdate) (model.Place, error) {
-	userEntity, err := s.userRepository.GetByPublicID(userID)
+func (s *placeServiceImpl) UpdateByID(ctx context.Context, placeID string, userID string, pu model.PlaceUpdate) (model.Place, error) {
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Place, error) {
+		return s.updateByID(ctx, placeID, userID, pu)
+	})
+}
+
+func (s *placeServiceImpl) updateByID(ctx context.Context, placeID string, userID string, pu model.PlaceUpdate) (model.Place, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
 	if err != nil {
-		return model.Place{}, err
+		return model.Place{}, storageError(err, service.ErrUserNotFound)
 	}
 
-	placeEntity, err := s.placeRepository.GetByPublicIDFull(placeID)
+	placeEntity, err := s.placeRepository.GetByPublicIDFull(ctx, placeID)
 	if err != nil {
-		return model.Place{}, err
+		return model.Place{}, storageError(err, service.ErrPlaceNotFound)
 	}
 
-	if placeEntity.Author.ID != userEntity.ID {
-		return model.Place{}, errors.New("user is not author")
+	if placeEntity.AuthorID != userEntity.ID {
+		return model.Place{}, service.ErrNotAuthor
+	}
+	if pu.Versions != nil && !slices.Contains(pu.Versions, placeEntity.Version) {
+		return model.Place{}, service.ErrVersionMismatch
+	}
+
+	placeEntity.CountryCode, err = normalizeCountryCode(pu.CountryCode)
+	if err != nil {
+		return model.Place{}, err
+	}
+	err = validateLocation(pu.Latitude, pu.Longitude)
+	if err != nil {
+		return model.Place{}, err
 	}
 
 	placeEntity.Name = pu.Name
 	placeEntity.Address = pu.Address
+	placeEntity.Latitude = pu.Latitude
+	placeEntity.Longitude = pu.Longitude
 
-	if pu.Visited {
-		placeEntity.Visitors = append(placeEntity.Visitors, userEntity)
-	} else {
-		for i, visitor := range placeEntity.Visitors {
-			if visitor.PublicID == userID {
-				placeEntity.Visitors = append(placeEntity.Visitors[:i], placeEntity.Visitors[i+1:]...)
-			}
-		}
-	}
-
-	err = s.placeRepository.Update(placeEntity)
+	err = s.placeRepository.Update(ctx, placeEntity)
 	if err != nil {
-		return model.Place{}, err
+		return model.Place{}, storageError(err, service.ErrPlaceNotFound)
 	}
+	placeEntity.Version++
 
 	place := model.Place{}
 	err = copier.Copy(&place, &placeEntity)
 	if err != nil {
 		return model.Place{}, err
 	}
+	place.LastVisitedAt = lastVisitedAt(placeEntity, userID)
+	place.Visited = place.LastVisitedAt != nil
+	place.Media = mapMediaEntitiesToModels(placeEntity.Media)
 
 	return place, err
 }
+
+func (s *placeServiceImpl) DeleteByID(ctx context.Context, placeID string, userID string) error {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return storageError(err, service.ErrUserNotFound)
+	}
+
+	placeEntity, err := s.placeRepository.GetByPublicID(ctx, placeID)
+	if err != nil {
+		return storageError(err, service.ErrPlaceNotFound)
+	}
+
+	if placeEntity.AuthorID != userEntity.ID {
+		return service.ErrNotAuthor
+	}
+
+	return s.placeRepository.Delete(ctx, placeEntity)
+}
+
+func (s *placeServiceImpl) RestoreByID(ctx context.Context, placeID string, userID string) (model.Place, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Place{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	placeEntity, err := s.placeRepository.GetDeletedByPublicID(ctx, placeID)
+	if err != nil {
+		return model.Place{}, storageError(err, service.ErrPlaceNotFound)
+	}
+
+	if placeEntity.AuthorID != userEntity.ID {
+		return model.Place{}, service.ErrNotAuthor
+	}
+
+	err = s.placeRepository.Restore(ctx, placeEntity)
+	if err != nil {
+		return model.Place{}, err
+	}
+
+	return s.GetByID(ctx, placeID, userID)
+}
+
+// MergeByID merges a duplicate place created by the user into another place
+// and returns the surviving place.
+func (s *placeServiceImpl) MergeByID(ctx context.Context, placeID string, userID string, pm model.PlaceMerge) (model.Place, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Place{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	placeEntity, err := s.placeRepository.GetByPublicID(ctx, placeID)
+	if err != nil {
+		return model.Place{}, storageError(err, service.ErrPlaceNotFound)
+	}
+
+	if placeEntity.AuthorID != userEntity.ID {
+		return model.Place{}, service.ErrNotAuthor
+	}
+
+	err = mergePlace(ctx, s.placeRepository, placeEntity, pm.IntoID)
+	if err != nil {
+		return model.Place{}, err
+	}
+
+	return s.GetByID(ctx, pm.IntoID, userID)
+}
+
+// mergePlace moves the packs and visits of placeEntity to the visible
+// place with intoID and deletes placeEntity.
+func mergePlace(ctx context.Context, placeRepository storage.PlaceRepository, placeEntity entity.Place, intoID string) error {
+	intoEntity, err := placeRepository.GetByPublicID(ctx, intoID)
+	if err != nil {
+		return storageError(err, service.ErrPlaceNotFound)
+	}
+	if intoEntity.HiddenAt != nil {
+		return service.ErrPlaceNotFound
+	}
+	if intoEntity.ID == placeEntity.ID {
+		return service.ErrSelfMerge
+	}
+
+	return placeRepository.Merge(ctx, placeEntity, intoEntity)
+}
+
+// getVisiblePlace returns the place with placeID unless it is hidden.
+func getVisiblePlace(ctx context.Context, placeRepository storage.PlaceRepository, placeID string) (entity.Place, error) {
+	placeEntity, err := placeRepository.GetByPublicID(ctx, placeID)
+	if err != nil {
+		return entity.Place{}, storageError(err, service.ErrPlaceNotFound)
+	}
+	if placeEntity.HiddenAt != nil {
+		return entity.Place{}, service.ErrPlaceNotFound
+	}
+
+	return placeEntity, nil
+}
+
+// validateLocation accepts coordinates that are both unknown, or both set
+// and within range.
+func validateLocation(lat *float64, lng *float64) error {
+	if lat == nil && lng == nil {
+		return nil
+	}
+	if lat == nil || lng == nil || *lat < -90 || *lat > 90 || *lng < -180 || *lng > 180 {
+		return service.ErrInvalidLocation
+	}
+	return nil
+}
+
+// normalizeCountryCode upper-cases an ISO 3166-1 alpha-2 code. An empty code
+// means the country is unknown.
+func normalizeCountryCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) == 0 {
+		return "", nil
+	}
+
+	if len(code) != 2 || strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return "", service.ErrInvalidCountry
+	}
+
+	return code, nil
+}
@@ -1,16 +1,25 @@
 package domain
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/internal/storage"
 	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/activity_type"
+	"locpack-backend/pkg/enum/place_sort"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPlaceService_GetByID(t *testing.T) {
@@ -29,12 +38,12 @@ func TestPlaceService_GetByID(t *testing.T) {
 			placeID: "place123",
 			userID:  "user123",
 			setupMocks: func(repo *storage.MockPlaceRepository) {
-				repo.EXPECT().GetByPublicIDFull("place123").Return(entity.Place{
+				repo.EXPECT().GetByPublicIDFull(mock.Anything, "place123").Return(entity.Place{
 					PublicID: "place123",
 					Name:     "Test Place",
 					Address:  "Test Address",
-					Visitors: []entity.User{
-						{PublicID: "user123"},
+					Visitors: []entity.PlaceVisitor{
+						{User: entity.User{PublicID: "user123"}, LastVisitedAt: lastVisit},
 					},
 				}, nil)
 			},
@@ -42,7 +51,7 @@ func TestPlaceService_GetByID(t *testing.T) {
 				ID:      "place123",
 				Name:    "Test Place",
 				Address: "Test Address",
-				Visited: true,
+				Visited: true, LastVisitedAt: &lastVisit,
 			},
 			expectError: false,
 		},
@@ -51,12 +60,12 @@ func TestPlaceService_GetByID(t *testing.T) {
 			placeID: "place123",
 			userID:  "user456",
 			setupMocks: func(repo *storage.MockPlaceRepository) {
-				repo.EXPECT().GetByPublicIDFull("place123").Return(entity.Place{
+				repo.EXPECT().GetByPublicIDFull(mock.Anything, "place123").Return(entity.Place{
 					PublicID: "place123",
 					Name:     "Test Place",
 					Address:  "Test Address",
-					Visitors: []entity.User{
-						{PublicID: "other-user"},
+					Visitors: []entity.PlaceVisitor{
+						{User: entity.User{PublicID: "other-user"}, LastVisitedAt: lastVisit},
 					},
 				}, nil)
 			},
@@ -73,7 +82,7 @@ func TestPlaceService_GetByID(t *testing.T) {
 			placeID: "place123",
 			userID:  "user123",
 			setupMocks: func(repo *storage.MockPlaceRepository) {
-				repo.EXPECT().GetByPublicIDFull("place123").Return(entity.Place{}, errors.New("database error"))
+				repo.EXPECT().GetByPublicIDFull(mock.Anything, "place123").Return(entity.Place{}, errors.New("database error"))
 			},
 			expectedPlace: model.Place{},
 			expectError:   true,
@@ -85,7 +94,7 @@ func TestPlaceService_GetByID(t *testing.T) {
 			_, placeSvc, _, _, placeRepo, _ := setupServiceTest(t)
 			tt.setupMocks(placeRepo)
 
-			result, err := placeSvc.GetByID(tt.placeID, tt.userID)
+			result, err := placeSvc.GetByID(context.Background(), tt.placeID, tt.userID)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -114,23 +123,23 @@ func TestPlaceService_GetByNameOrAddress(t *testing.T) {
 			query:  "park",
 			userID: "user123",
 			setupMocks: func(repo *storage.MockPlaceRepository) {
-				repo.EXPECT().GetByNameOrAddressFull("park").Return([]entity.Place{
+				repo.EXPECT().GetByNameOrAddressFull(mock.Anything, "park", place_sort.Relevance, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return([]entity.Place{
 					{
 						PublicID: "place123",
 						Name:     "Central Park",
 						Address:  "Park Avenue",
-						Visitors: []entity.User{
-							{PublicID: "user123"},
+						Visitors: []entity.PlaceVisitor{
+							{User: entity.User{PublicID: "user123"}, LastVisitedAt: lastVisit},
 						},
 					},
-				}, nil)
+				}, int64(1), nil)
 			},
 			expected: []model.Place{
 				{
 					ID:      "place123",
 					Name:    "Central Park",
 					Address: "Park Avenue",
-					Visited: true,
+					Visited: true, LastVisitedAt: &lastVisit,
 				},
 			},
 			expectError: false,
@@ -140,31 +149,31 @@ func TestPlaceService_GetByNameOrAddress(t *testing.T) {
 			query:  "cafe",
 			userID: "user123",
 			setupMocks: func(repo *storage.MockPlaceRepository) {
-				repo.EXPECT().GetByNameOrAddressFull("cafe").Return([]entity.Place{
+				repo.EXPECT().GetByNameOrAddressFull(mock.Anything, "cafe", place_sort.Relevance, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return([]entity.Place{
 					{
 						PublicID: "place123",
 						Name:     "Cafe One",
 						Address:  "First Street",
-						Visitors: []entity.User{
-							{PublicID: "user123"},
+						Visitors: []entity.PlaceVisitor{
+							{User: entity.User{PublicID: "user123"}, LastVisitedAt: lastVisit},
 						},
 					},
 					{
 						PublicID: "place456",
 						Name:     "Cafe Two",
 						Address:  "Second Street",
-						Visitors: []entity.User{
-							{PublicID: "other-user"},
+						Visitors: []entity.PlaceVisitor{
+							{User: entity.User{PublicID: "other-user"}, LastVisitedAt: lastVisit},
 						},
 					},
-				}, nil)
+				}, int64(2), nil)
 			},
 			expected: []model.Place{
 				{
 					ID:      "place123",
 					Name:    "Cafe One",
 					Address: "First Street",
-					Visited: true,
+					Visited: true, LastVisitedAt: &lastVisit,
 				},
 				{
 					ID:      "place456",
@@ -175,12 +184,37 @@ func TestPlaceService_GetByNameOrAddress(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name:   "success - highlighted match",
+			query:  "cafe",
+			userID: "user123",
+			setupMocks: func(repo *storage.MockPlaceRepository) {
+				repo.EXPECT().GetByNameOrAddressFull(mock.Anything, "cafe", place_sort.Relevance, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return([]entity.Place{
+					{
+						PublicID:        "place123",
+						Name:            "Cafe <One>",
+						Address:         "Cafe Street",
+						NameHeadline:    "\x02Cafe\x03 <One>",
+						AddressHeadline: "Cafe Street",
+					},
+				}, int64(1), nil)
+			},
+			expected: []model.Place{
+				{
+					ID:            "place123",
+					Name:          "Cafe <One>",
+					Address:       "Cafe Street",
+					NameHighlight: "<mark>Cafe</mark> &lt;One&gt;",
+				},
+			},
+			expectError: false,
+		},
 		{
 			name:   "empty result",
 			query:  "nonexistent",
 			userID: "user123",
 			setupMocks: func(repo *storage.MockPlaceRepository) {
-				repo.EXPECT().GetByNameOrAddressFull("nonexistent").Return([]entity.Place{}, nil)
+				repo.EXPECT().GetByNameOrAddressFull(mock.Anything, "nonexistent", place_sort.Relevance, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return([]entity.Place{}, int64(0), nil)
 			},
 			expected:    []model.Place(nil),
 			expectError: false,
@@ -190,7 +224,7 @@ func TestPlaceService_GetByNameOrAddress(t *testing.T) {
 			query:  "error",
 			userID: "user123",
 			setupMocks: func(repo *storage.MockPlaceRepository) {
-				repo.EXPECT().GetByNameOrAddressFull("error").Return(nil, errors.New("database error"))
+				repo.EXPECT().GetByNameOrAddressFull(mock.Anything, "error", place_sort.Relevance, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return(nil, int64(0), errors.New("database error"))
 			},
 			expected:    []model.Place{},
 			expectError: true,
@@ -202,7 +236,7 @@ func TestPlaceService_GetByNameOrAddress(t *testing.T) {
 			_, placeSvc, _, _, placeRepo, _ := setupServiceTest(t)
 			tt.setupMocks(placeRepo)
 
-			result, err := placeSvc.GetByNameOrAddress(tt.query, tt.userID)
+			result, _, err := placeSvc.GetByNameOrAddress(context.Background(), tt.query, "", tt.userID, model.Page{})
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -215,11 +249,198 @@ func TestPlaceService_GetByNameOrAddress(t *testing.T) {
 	}
 }
 
+func TestPlaceService_GetByNameOrAddress_Pagination(t *testing.T) {
+	t.Parallel()
+
+	_, placeSvc, _, _, placeRepo, _ := setupServiceTest(t)
+
+	createdAt := time.Date(2026, 5, 17, 9, 30, 0, 0, time.UTC)
+	after := cursor.Cursor{Scores: []float64{0.8}, Time: createdAt, ID: "place123"}
+	second := entity.Place{ID: uuid.New(), PublicID: "place456", Name: "Cafe Two", CreatedAt: createdAt, Rank: 0.5}
+	third := entity.Place{ID: uuid.New(), PublicID: "place789", Name: "Cafe Three", CreatedAt: createdAt, Rank: 0.2}
+
+	placeRepo.EXPECT().GetByNameOrAddressFull(mock.Anything, "cafe", place_sort.Relevance, 2, &after).Return([]entity.Place{second, third}, int64(3), nil)
+
+	result, info, err := placeSvc.GetByNameOrAddress(context.Background(), "cafe", "", "user123", model.Page{Limit: 1, Cursor: cursor.Encode(after)})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "place456", result[0].ID)
+	assert.Equal(t, int64(3), info.Total)
+	assert.Equal(t, cursor.Encode(cursor.Cursor{Scores: []float64{0.5}, Time: createdAt, ID: second.PublicID}), info.NextCursor)
+
+	_, _, err = placeSvc.GetByNameOrAddress(context.Background(), "cafe", "", "user123", model.Page{Cursor: "not-a-cursor"})
+	assert.ErrorIs(t, err, service.ErrInvalidPage)
+
+	// A cursor of a list sorted otherwise does not carry the same keys.
+	_, _, err = placeSvc.GetByNameOrAddress(context.Background(), "cafe", place_sort.Rating, "user123", model.Page{Cursor: cursor.Encode(after)})
+	assert.ErrorIs(t, err, service.ErrInvalidPage)
+}
+
+func TestPlaceService_GetByNameOrAddress_TiedRatings(t *testing.T) {
+	t.Parallel()
+
+	_, placeSvc, _, _, placeRepo, _ := setupServiceTest(t)
+
+	// The places are tied on an average that a double cannot represent
+	// exactly, which the repository compares as the double the cursor
+	// carries.
+	createdAt := time.Date(2026, 5, 17, 9, 30, 0, 0, time.UTC)
+	places := []entity.Place{
+		{PublicID: "place3", CreatedAt: createdAt, RatingAverage: 11.0 / 3, RatingCount: 3},
+		{PublicID: "place2", CreatedAt: createdAt, RatingAverage: 11.0 / 3, RatingCount: 3},
+		{PublicID: "place1", CreatedAt: createdAt, RatingAverage: 11.0 / 3, RatingCount: 3},
+	}
+	placeRepo.EXPECT().GetByNameOrAddressFull(mock.Anything, "", place_sort.Rating, 2, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ string, _ types.PlaceSort, limit int, after *cursor.Cursor) ([]entity.Place, int64, error) {
+			past := []entity.Place{}
+			for _, p := range places {
+				if after == nil || p.RatingAverage < after.Scores[0] ||
+					p.RatingAverage == after.Scores[0] && float64(p.RatingCount) < after.Scores[1] ||
+					p.RatingAverage == after.Scores[0] && float64(p.RatingCount) == after.Scores[1] && p.PublicID < after.ID {
+					past = append(past, p)
+				}
+			}
+			return past[:min(limit, len(past))], int64(len(places)), nil
+		})
+
+	ids := []string{}
+	page := model.Page{Limit: 1}
+	for range places {
+		result, info, err := placeSvc.GetByNameOrAddress(context.Background(), "", place_sort.Rating, "user123", page)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		ids = append(ids, result[0].ID)
+		page.Cursor = info.NextCursor
+	}
+
+	assert.Equal(t, []string{"place3", "place2", "place1"}, ids)
+	assert.Empty(t, page.Cursor)
+}
+
+func TestPlaceService_GetByNameOrAddress_Sort(t *testing.T) {
+	t.Parallel()
+
+	_, placeSvc, _, _, placeRepo, _ := setupServiceTest(t)
+
+	placeRepo.EXPECT().GetByNameOrAddressFull(mock.Anything, "cafe", place_sort.Rating, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return([]entity.Place{
+		{PublicID: "place456", Name: "Cafe Two", RatingAverage: 4.5, RatingCount: 2},
+	}, int64(1), nil)
+
+	result, _, err := placeSvc.GetByNameOrAddress(context.Background(), "cafe", place_sort.Rating, "user123", model.Page{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Place{{ID: "place456", Name: "Cafe Two", RatingAverage: 4.5, RatingCount: 2}}, result)
+
+	_, _, err = placeSvc.GetByNameOrAddress(context.Background(), "cafe", "DISTANCE", "user123", model.Page{})
+	assert.ErrorIs(t, err, service.ErrInvalidSort)
+}
+
+func TestPlaceService_GetNearby(t *testing.T) {
+	t.Parallel()
+
+	louvreLat, louvreLng := 48.8606, 2.3376
+	palaisLat, palaisLng := 48.8637, 2.3371
+
+	tests := []struct {
+		name        string
+		radius      float64
+		userID      string
+		setupMocks  func(*storage.MockPlaceRepository)
+		expected    []model.Place
+		expectError bool
+	}{
+		{
+			name:   "success - sorted by distance",
+			radius: 1000,
+			userID: "user123",
+			setupMocks: func(repo *storage.MockPlaceRepository) {
+				repo.EXPECT().GetNearbyFull(mock.Anything, 48.8606, 2.3376, float64(1000), maxNearbyPlaces).Return([]entity.Place{
+					{
+						PublicID:  "place123",
+						Name:      "Louvre",
+						Latitude:  &louvreLat,
+						Longitude: &louvreLng,
+						Distance:  0,
+						Visitors: []entity.PlaceVisitor{
+							{User: entity.User{PublicID: "user123"}, LastVisitedAt: lastVisit},
+						},
+					},
+					{
+						PublicID:  "place456",
+						Name:      "Palais Royal",
+						Latitude:  &palaisLat,
+						Longitude: &palaisLng,
+						Distance:  347.5,
+					},
+				}, nil)
+			},
+			expected: []model.Place{
+				{
+					ID:        "place123",
+					Name:      "Louvre",
+					Latitude:  &louvreLat,
+					Longitude: &louvreLng,
+					Distance:  0,
+					Visited:   true, LastVisitedAt: &lastVisit,
+				},
+				{
+					ID:        "place456",
+					Name:      "Palais Royal",
+					Latitude:  &palaisLat,
+					Longitude: &palaisLng,
+					Distance:  347.5,
+					Visited:   false,
+				},
+			},
+			expectError: false,
+		},
+		{
+			name:        "radius out of range",
+			radius:      maxNearbyRadius + 1,
+			userID:      "user123",
+			setupMocks:  func(repo *storage.MockPlaceRepository) {},
+			expected:    []model.Place{},
+			expectError: true,
+		},
+		{
+			name:   "repository error",
+			radius: 1000,
+			userID: "user123",
+			setupMocks: func(repo *storage.MockPlaceRepository) {
+				repo.EXPECT().GetNearbyFull(mock.Anything, 48.8606, 2.3376, float64(1000), maxNearbyPlaces).Return(nil, errors.New("database error"))
+			},
+			expected:    []model.Place{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, placeSvc, _, _, placeRepo, _ := setupServiceTest(t)
+			tt.setupMocks(placeRepo)
+
+			result, err := placeSvc.GetNearby(context.Background(), 48.8606, 2.3376, tt.radius, tt.userID)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expected, result)
+			placeRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestPlaceService_Create(t *testing.T) {
 	t.Parallel()
 
 	userID := "user123"
 	userUUID := uuid.New()
+	lat, lng := 48.86, 2.33
+	badLat := 91.0
 
 	tests := []struct {
 		name        string
@@ -238,18 +459,17 @@ func TestPlaceService_Create(t *testing.T) {
 				Visited: true,
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{
 					ID:       userUUID,
 					PublicID: userID,
 					Username: "testuser",
 				}, nil)
+				placeRepo.EXPECT().GetDuplicatesFull(mock.Anything, mock.Anything, mock.Anything, (*float64)(nil), (*float64)(nil)).Return([]entity.Place{}, nil)
 
-				placeRepo.EXPECT().Create(mock.AnythingOfType("entity.Place")).Run(func(p entity.Place) {
+				placeRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("entity.Place")).Run(func(_ context.Context, p entity.Place) {
 					assert.Equal(t, "New Place", p.Name)
 					assert.Equal(t, "New Address", p.Address)
 					assert.Equal(t, userUUID, p.AuthorID)
-					assert.Len(t, p.Visitors, 1)
-					assert.Equal(t, userID, p.Visitors[0].PublicID)
 				}).Return(nil)
 			},
 			expected: model.Place{
@@ -268,17 +488,17 @@ func TestPlaceService_Create(t *testing.T) {
 				Visited: false,
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{
 					ID:       userUUID,
 					PublicID: userID,
 					Username: "testuser",
 				}, nil)
+				placeRepo.EXPECT().GetDuplicatesFull(mock.Anything, mock.Anything, mock.Anything, (*float64)(nil), (*float64)(nil)).Return([]entity.Place{}, nil)
 
-				placeRepo.EXPECT().Create(mock.AnythingOfType("entity.Place")).Run(func(p entity.Place) {
+				placeRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("entity.Place")).Run(func(_ context.Context, p entity.Place) {
 					assert.Equal(t, "Another Place", p.Name)
 					assert.Equal(t, "Another Address", p.Address)
 					assert.Equal(t, userUUID, p.AuthorID)
-					assert.Len(t, p.Visitors, 0)
 				}).Return(nil)
 			},
 			expected: model.Place{
@@ -296,7 +516,7 @@ func TestPlaceService_Create(t *testing.T) {
 				Address: "New Address",
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID("nonexistent").Return(entity.User{}, errors.New("user not found"))
+				userRepo.EXPECT().GetByPublicID(mock.Anything, "nonexistent").Return(entity.User{}, errors.New("user not found"))
 			},
 			expected:    model.Place{},
 			expectError: true,
@@ -309,17 +529,79 @@ func TestPlaceService_Create(t *testing.T) {
 				Address: "Error Address",
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{
 					ID:       userUUID,
 					PublicID: userID,
 					Username: "testuser",
 				}, nil)
+				placeRepo.EXPECT().GetDuplicatesFull(mock.Anything, mock.Anything, mock.Anything, (*float64)(nil), (*float64)(nil)).Return([]entity.Place{}, nil)
 
-				placeRepo.EXPECT().Create(mock.AnythingOfType("entity.Place")).Return(errors.New("database error"))
+				placeRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("entity.Place")).Return(errors.New("database error"))
+			},
+			expected:    model.Place{},
+			expectError: true,
+		},
+		{
+			name:   "possible duplicate",
+			userID: userID,
+			input: model.PlaceCreate{
+				Name:      "louvre museum",
+				Address:   "Rue de Rivoli",
+				Latitude:  &lat,
+				Longitude: &lng,
+			},
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetDuplicatesFull(mock.Anything, "louvre museum", "Rue de Rivoli", &lat, &lng).Return([]entity.Place{{PublicID: "louvre", Name: "Louvre"}}, nil)
+			},
+			expected:    model.Place{},
+			expectError: true,
+		},
+		{
+			name:   "latitude out of range",
+			userID: userID,
+			input: model.PlaceCreate{
+				Name:      "North",
+				Latitude:  &badLat,
+				Longitude: &lng,
+			},
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
 			},
 			expected:    model.Place{},
 			expectError: true,
 		},
+		{
+			name:   "longitude missing",
+			userID: userID,
+			input: model.PlaceCreate{
+				Name:     "Somewhere",
+				Latitude: &lat,
+			},
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+			},
+			expected:    model.Place{},
+			expectError: true,
+		},
+		{
+			name:   "success - duplicates ignored",
+			userID: userID,
+			input: model.PlaceCreate{
+				Name:             "Louvre",
+				Address:          "Rue de Rivoli",
+				IgnoreDuplicates: true,
+			},
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("entity.Place")).Return(nil)
+			},
+			expected: model.Place{
+				Name:    "Louvre",
+				Address: "Rue de Rivoli",
+			},
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -327,7 +609,7 @@ func TestPlaceService_Create(t *testing.T) {
 			_, placeSvc, _, _, placeRepo, userRepo := setupServiceTest(t)
 			tt.setupMocks(placeRepo, userRepo)
 
-			result, err := placeSvc.Create(tt.userID, tt.input)
+			result, err := placeSvc.Create(context.Background(), tt.userID, tt.input)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -347,6 +629,8 @@ func TestPlaceService_UpdateByID(t *testing.T) {
 	userID := "user123"
 	placeID := "place123"
 	userUUID := uuid.New()
+	currentVersion := int64(2)
+	staleVersion := int64(1)
 
 	tests := []struct {
 		name        string
@@ -356,81 +640,81 @@ func TestPlaceService_UpdateByID(t *testing.T) {
 		setupMocks  func(*storage.MockPlaceRepository, *storage.MockUserRepository)
 		expected    model.Place
 		expectError bool
+		expectedErr error
 	}{
 		{
-			name:    "success - add visit",
+			name:    "success - not visited",
 			placeID: placeID,
 			userID:  userID,
 			input: model.PlaceUpdate{
 				Name:    "Updated Place",
 				Address: "Updated Address",
-				Visited: true,
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{
 					ID:       userUUID,
 					PublicID: userID,
 					Username: "testuser",
 				}, nil)
 
-				placeRepo.EXPECT().GetByPublicIDFull(placeID).Return(entity.Place{
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, placeID).Return(entity.Place{
 					PublicID: placeID,
 					Name:     "Original Place",
 					Address:  "Original Address",
-					Visitors: []entity.User{},
+					Visitors: []entity.PlaceVisitor{},
+					AuthorID: userUUID,
 				}, nil)
 
-				placeRepo.EXPECT().Update(mock.AnythingOfType("entity.Place")).Run(func(p entity.Place) {
+				placeRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("entity.Place")).Run(func(_ context.Context, p entity.Place) {
 					assert.Equal(t, "Updated Place", p.Name)
 					assert.Equal(t, "Updated Address", p.Address)
-					assert.Len(t, p.Visitors, 1)
-					assert.Equal(t, userID, p.Visitors[0].PublicID)
 				}).Return(nil)
 			},
 			expected: model.Place{
 				ID:      placeID,
 				Name:    "Updated Place",
 				Address: "Updated Address",
-				Visited: true,
+				Visited: false,
+				Version: 1,
 			},
 			expectError: false,
 		},
 		{
-			name:    "success - remove visit",
+			name:    "success - visited",
 			placeID: placeID,
 			userID:  userID,
 			input: model.PlaceUpdate{
 				Name:    "Updated Place",
 				Address: "Updated Address",
-				Visited: false,
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{
 					ID:       userUUID,
 					PublicID: userID,
 					Username: "testuser",
 				}, nil)
 
-				placeRepo.EXPECT().GetByPublicIDFull(placeID).Return(entity.Place{
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, placeID).Return(entity.Place{
 					PublicID: placeID,
 					Name:     "Original Place",
 					Address:  "Original Address",
-					Visitors: []entity.User{
-						{PublicID: userID},
+					Visitors: []entity.PlaceVisitor{
+						{User: entity.User{PublicID: userID}, LastVisitedAt: lastVisit},
 					},
+					AuthorID: userUUID,
 				}, nil)
 
-				placeRepo.EXPECT().Update(mock.AnythingOfType("entity.Place")).Run(func(p entity.Place) {
+				placeRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("entity.Place")).Run(func(_ context.Context, p entity.Place) {
 					assert.Equal(t, "Updated Place", p.Name)
 					assert.Equal(t, "Updated Address", p.Address)
-					assert.Len(t, p.Visitors, 0)
 				}).Return(nil)
 			},
 			expected: model.Place{
 				ID:      placeID,
 				Name:    "Updated Place",
 				Address: "Updated Address",
-				Visited: false,
+				Visited: true,
+				Version: 1,
 			},
 			expectError: false,
 		},
@@ -443,7 +727,7 @@ func TestPlaceService_UpdateByID(t *testing.T) {
 				Address: "Updated Address",
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID("nonexistent").Return(entity.User{}, errors.New("user not found"))
+				userRepo.EXPECT().GetByPublicID(mock.Anything, "nonexistent").Return(entity.User{}, errors.New("user not found"))
 			},
 			expected:    model.Place{},
 			expectError: true,
@@ -457,13 +741,13 @@ func TestPlaceService_UpdateByID(t *testing.T) {
 				Address: "Updated Address",
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{
 					ID:       userUUID,
 					PublicID: userID,
 					Username: "testuser",
 				}, nil)
 
-				placeRepo.EXPECT().GetByPublicIDFull("nonexistent").Return(entity.Place{}, errors.New("place not found"))
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, "nonexistent").Return(entity.Place{}, errors.New("place not found"))
 			},
 			expected:    model.Place{},
 			expectError: true,
@@ -475,26 +759,98 @@ func TestPlaceService_UpdateByID(t *testing.T) {
 			input: model.PlaceUpdate{
 				Name:    "Error Place",
 				Address: "Error Address",
-				Visited: true,
 			},
 			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{
 					ID:       userUUID,
 					PublicID: userID,
 					Username: "testuser",
 				}, nil)
 
-				placeRepo.EXPECT().GetByPublicIDFull(placeID).Return(entity.Place{
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, placeID).Return(entity.Place{
 					PublicID: placeID,
 					Name:     "Original Place",
 					Address:  "Original Address",
+					AuthorID: userUUID,
 				}, nil)
 
-				placeRepo.EXPECT().Update(mock.AnythingOfType("entity.Place")).Return(errors.New("database error"))
+				placeRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("entity.Place")).Return(errors.New("database error"))
 			},
 			expected:    model.Place{},
 			expectError: true,
 		},
+		{
+			name:    "success - version matches",
+			placeID: placeID,
+			userID:  userID,
+			input: model.PlaceUpdate{
+				Name:     "Updated Place",
+				Address:  "Updated Address",
+				Versions: []int64{currentVersion},
+			},
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, placeID).Return(entity.Place{
+					PublicID: placeID,
+					Name:     "Original Place",
+					Address:  "Original Address",
+					Version:  2,
+					AuthorID: userUUID,
+				}, nil)
+
+				placeRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("entity.Place")).Run(func(_ context.Context, p entity.Place) {
+					assert.Equal(t, int64(2), p.Version)
+				}).Return(nil)
+			},
+			expected: model.Place{
+				ID:      placeID,
+				Name:    "Updated Place",
+				Address: "Updated Address",
+				Version: 3,
+			},
+		},
+		{
+			name:    "version mismatch",
+			placeID: placeID,
+			userID:  userID,
+			input: model.PlaceUpdate{
+				Name:     "Updated Place",
+				Versions: []int64{staleVersion},
+			},
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, placeID).Return(entity.Place{
+					PublicID: placeID,
+					Version:  2,
+					AuthorID: userUUID,
+				}, nil)
+			},
+			expectError: true,
+			expectedErr: service.ErrVersionMismatch,
+		},
+		{
+			name:    "stale write",
+			placeID: placeID,
+			userID:  userID,
+			input: model.PlaceUpdate{
+				Name: "Updated Place",
+			},
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, placeID).Return(entity.Place{
+					PublicID: placeID,
+					Version:  2,
+					AuthorID: userUUID,
+				}, nil)
+
+				placeRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("entity.Place")).Return(storage.ErrStale)
+			},
+			expectError: true,
+			expectedErr: service.ErrVersionMismatch,
+		},
 	}
 
 	for _, tt := range tests {
@@ -502,16 +858,402 @@ func TestPlaceService_UpdateByID(t *testing.T) {
 			_, placeSvc, _, _, placeRepo, userRepo := setupServiceTest(t)
 			tt.setupMocks(placeRepo, userRepo)
 
-			result, err := placeSvc.UpdateByID(tt.placeID, tt.userID, tt.input)
+			result, err := placeSvc.UpdateByID(context.Background(), tt.placeID, tt.userID, tt.input)
 
 			if tt.expectError {
 				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expected.ID, result.ID)
 				assert.Equal(t, tt.expected.Name, result.Name)
 				assert.Equal(t, tt.expected.Address, result.Address)
+				assert.Equal(t, tt.expected.Version, result.Version)
+				assert.Equal(t, tt.expected.Visited, result.Visited)
 			}
 		})
 	}
 }
+
+func TestPlaceService_DeleteByID(t *testing.T) {
+	t.Parallel()
+
+	userID := "user123"
+	placeID := "place123"
+	userUUID := uuid.New()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*storage.MockPlaceRepository, *storage.MockUserRepository)
+		expectError bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, placeID).Return(entity.Place{PublicID: placeID, AuthorID: userUUID}, nil)
+				placeRepo.EXPECT().Delete(mock.Anything, mock.MatchedBy(func(p entity.Place) bool {
+					return p.PublicID == placeID
+				})).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "user is not author",
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, placeID).Return(entity.Place{PublicID: placeID, AuthorID: uuid.New()}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "place not found",
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, placeID).Return(entity.Place{}, errors.New("place not found"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, placeSvc, _, _, placeRepo, userRepo := setupServiceTest(t)
+			tt.setupMocks(placeRepo, userRepo)
+
+			err := placeSvc.DeleteByID(context.Background(), placeID, userID)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			placeRepo.AssertExpectations(t)
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlaceService_RestoreByID(t *testing.T) {
+	t.Parallel()
+
+	userID := "user123"
+	placeID := "place123"
+	userUUID := uuid.New()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*storage.MockPlaceRepository, *storage.MockUserRepository)
+		expected    model.Place
+		expectError bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetDeletedByPublicID(mock.Anything, placeID).Return(entity.Place{PublicID: placeID, AuthorID: userUUID}, nil)
+				placeRepo.EXPECT().Restore(mock.Anything, mock.AnythingOfType("entity.Place")).Return(nil)
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, placeID).Return(entity.Place{PublicID: placeID, Name: "Restored Place"}, nil)
+			},
+			expected: model.Place{
+				ID:   placeID,
+				Name: "Restored Place",
+			},
+			expectError: false,
+		},
+		{
+			name: "user is not author",
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetDeletedByPublicID(mock.Anything, placeID).Return(entity.Place{PublicID: placeID, AuthorID: uuid.New()}, nil)
+			},
+			expected:    model.Place{},
+			expectError: true,
+		},
+		{
+			name: "place is not deleted",
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetDeletedByPublicID(mock.Anything, placeID).Return(entity.Place{}, errors.New("record not found"))
+			},
+			expected:    model.Place{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, placeSvc, _, _, placeRepo, userRepo := setupServiceTest(t)
+			tt.setupMocks(placeRepo, userRepo)
+
+			result, err := placeSvc.RestoreByID(context.Background(), placeID, userID)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expected, result)
+			placeRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlaceService_Create_RecordsVisit(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+
+	placeRepo := new(storage.MockPlaceRepository)
+	userRepo := new(storage.MockUserRepository)
+	activityRepo := new(storage.MockActivityRepository)
+	visitRepo := new(storage.MockVisitRepository)
+	placeSvc := NewPlaceService(placeRepo, userRepo, activityRepo, visitRepo, inlineUnitOfWork{})
+
+	userRepo.EXPECT().GetByPublicID(mock.Anything, "user123").Return(entity.User{ID: userUUID, PublicID: "user123"}, nil)
+	placeRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("entity.Place")).Return(nil)
+	visitRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(v entity.Visit) bool {
+		return v.UserID == userUUID && v.Note == "" && v.Rating == nil
+	})).Return(nil).Once()
+	activityRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(a entity.Activity) bool {
+		return a.Type == activity_type.PlaceVisited && a.UserID == userUUID && a.PackID == nil && a.PlaceID != nil
+	})).Return(nil).Once()
+
+	_, err := placeSvc.Create(context.Background(), "user123", model.PlaceCreate{
+		Name:             "Louvre",
+		Visited:          true,
+		IgnoreDuplicates: true,
+	})
+
+	assert.NoError(t, err)
+	activityRepo.AssertExpectations(t)
+	visitRepo.AssertExpectations(t)
+}
+
+func TestPlaceService_Create_CountryCode(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+
+	tests := []struct {
+		name        string
+		countryCode string
+		expected    string
+		expectedErr error
+	}{
+		{name: "unknown", countryCode: "", expected: ""},
+		{name: "normalized", countryCode: " fr ", expected: "FR"},
+		{name: "too long", countryCode: "FRA", expectedErr: service.ErrInvalidCountry},
+		{name: "not letters", countryCode: "F1", expectedErr: service.ErrInvalidCountry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, placeSvc, _, _, placeRepo, userRepo := setupServiceTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user123").Return(entity.User{ID: userUUID, PublicID: "user123"}, nil)
+			if tt.expectedErr == nil {
+				placeRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(p entity.Place) bool {
+					return p.CountryCode == tt.expected
+				})).Return(nil)
+			}
+
+			result, err := placeSvc.Create(context.Background(), "user123", model.PlaceCreate{
+				Name:             "Louvre",
+				CountryCode:      tt.countryCode,
+				IgnoreDuplicates: true,
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result.CountryCode)
+			}
+		})
+	}
+}
+
+func TestPlaceService_GetDuplicates(t *testing.T) {
+	t.Parallel()
+
+	userID := "user123"
+
+	_, placeSvc, _, _, placeRepo, _ := setupServiceTest(t)
+	placeRepo.EXPECT().GetDuplicatesFull(mock.Anything, "Louvre", "Rue de Rivoli", (*float64)(nil), (*float64)(nil)).Return([]entity.Place{
+		{PublicID: "place1", Name: "Louvre Museum", Visitors: []entity.PlaceVisitor{{User: entity.User{PublicID: userID}, LastVisitedAt: lastVisit}}},
+		{PublicID: "place2", Name: "Le Louvre"},
+	}, nil)
+
+	result, err := placeSvc.GetDuplicates(context.Background(), model.PlaceCreate{Name: "Louvre", Address: "Rue de Rivoli"}, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Place{
+		{ID: "place1", Name: "Louvre Museum", Visited: true, LastVisitedAt: &lastVisit},
+		{ID: "place2", Name: "Le Louvre"},
+	}, result)
+	placeRepo.AssertExpectations(t)
+}
+
+func TestPlaceService_MergeByID(t *testing.T) {
+	t.Parallel()
+
+	userID := "user123"
+	placeID := "place123"
+	intoID := "place456"
+	userUUID := uuid.New()
+	place := entity.Place{ID: uuid.New(), PublicID: placeID, AuthorID: userUUID}
+	into := entity.Place{ID: uuid.New(), PublicID: intoID, Name: "Louvre"}
+	hiddenAt := time.Now()
+
+	tests := []struct {
+		name        string
+		intoID      string
+		setupMocks  func(*storage.MockPlaceRepository, *storage.MockUserRepository)
+		expected    model.Place
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			intoID: intoID,
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, placeID).Return(place, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, intoID).Return(into, nil)
+				placeRepo.EXPECT().Merge(mock.Anything, place, into).Return(nil)
+				placeRepo.EXPECT().GetByPublicIDFull(mock.Anything, intoID).Return(entity.Place{
+					PublicID: intoID,
+					Name:     "Louvre",
+					Visitors: []entity.PlaceVisitor{{User: entity.User{PublicID: userID}, LastVisitedAt: lastVisit}},
+				}, nil)
+			},
+			expected: model.Place{ID: intoID, Name: "Louvre", Visited: true, LastVisitedAt: &lastVisit},
+		},
+		{
+			name:   "user is not author",
+			intoID: intoID,
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: uuid.New(), PublicID: userID}, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, placeID).Return(place, nil)
+			},
+			expectedErr: service.ErrNotAuthor,
+		},
+		{
+			name:   "merge into itself",
+			intoID: placeID,
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, placeID).Return(place, nil)
+			},
+			expectedErr: service.ErrSelfMerge,
+		},
+		{
+			name:   "surviving place is hidden",
+			intoID: intoID,
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, placeID).Return(place, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, intoID).Return(entity.Place{ID: into.ID, PublicID: intoID, HiddenAt: &hiddenAt}, nil)
+			},
+			expectedErr: service.ErrPlaceNotFound,
+		},
+		{
+			name:   "surviving place not found",
+			intoID: intoID,
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, placeID).Return(place, nil)
+				placeRepo.EXPECT().GetByPublicID(mock.Anything, intoID).Return(entity.Place{}, storage.ErrNotFound)
+			},
+			expectedErr: service.ErrPlaceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, placeSvc, _, _, placeRepo, userRepo := setupServiceTest(t)
+			tt.setupMocks(placeRepo, userRepo)
+
+			result, err := placeSvc.MergeByID(context.Background(), placeID, userID, model.PlaceMerge{IntoID: tt.intoID})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expected, result)
+			placeRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlaceService_Errors(t *testing.T) {
+	t.Parallel()
+
+	authorUUID := uuid.New()
+
+	tests := []struct {
+		name       string
+		setupMocks func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository)
+		call       func(svc service.PlaceService) error
+		wantErr    *service.Error
+	}{
+		{
+			name: "missing place is not found",
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, _ *storage.MockUserRepository) {
+				placeRepo.On("GetByPublicIDFull", mock.Anything, "place1").Return(entity.Place{}, fmt.Errorf("%w: record not found", storage.ErrNotFound))
+			},
+			call: func(svc service.PlaceService) error {
+				_, err := svc.GetByID(context.Background(), "place1", "user1")
+				return err
+			},
+			wantErr: service.ErrPlaceNotFound,
+		},
+		{
+			name: "missing user is not found",
+			setupMocks: func(_ *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{}, fmt.Errorf("%w: record not found", storage.ErrNotFound))
+			},
+			call: func(svc service.PlaceService) error {
+				return svc.DeleteByID(context.Background(), "place1", "user1")
+			},
+			wantErr: service.ErrUserNotFound,
+		},
+		{
+			name: "other user is not author",
+			setupMocks: func(placeRepo *storage.MockPlaceRepository, userRepo *storage.MockUserRepository) {
+				userRepo.On("GetByPublicID", mock.Anything, "user1").Return(entity.User{ID: uuid.New(), PublicID: "user1"}, nil)
+				placeRepo.On("GetByPublicIDFull", mock.Anything, "place1").Return(entity.Place{PublicID: "place1", AuthorID: authorUUID}, nil)
+			},
+			call: func(svc service.PlaceService) error {
+				_, err := svc.UpdateByID(context.Background(), "place1", "user1", model.PlaceUpdate{Name: "New"})
+				return err
+			},
+			wantErr: service.ErrNotAuthor,
+		},
+		{
+			name:       "radius is invalid",
+			setupMocks: func(_ *storage.MockPlaceRepository, _ *storage.MockUserRepository) {},
+			call: func(svc service.PlaceService) error {
+				_, err := svc.GetNearby(context.Background(), 0, 0, maxNearbyRadius+1, "user1")
+				return err
+			},
+			wantErr: service.ErrInvalidRadius,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, placeSvc, _, _, placeRepo, userRepo := setupServiceTest(t)
+			tt.setupMocks(placeRepo, userRepo)
+
+			err := tt.call(placeSvc)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/utils/cursor"
+	"locpack-backend/pkg/utils/random"
+)
+
+const (
+	minRating           = 1
+	maxRating           = 5
+	maxReviewTextLength = 2000
+)
+
+type reviewServiceImpl struct {
+	reviewRepository storage.ReviewRepository
+	placeRepository  storage.PlaceRepository
+	userRepository   storage.UserRepository
+}
+
+func NewReviewService(
+	reviewRepository storage.ReviewRepository,
+	placeRepository storage.PlaceRepository,
+	userRepository storage.UserRepository,
+) service.ReviewService {
+	return &reviewServiceImpl{reviewRepository, placeRepository, userRepository}
+}
+
+func (s *reviewServiceImpl) GetByPlaceID(ctx context.Context, placeID string, page model.Page) ([]model.Review, model.PageInfo, error) {
+	limit, after, err := pageBounds(page, 0)
+	if err != nil {
+		return []model.Review{}, model.PageInfo{}, err
+	}
+
+	placeEntity, err := getVisiblePlace(ctx, s.placeRepository, placeID)
+	if err != nil {
+		return []model.Review{}, model.PageInfo{}, err
+	}
+
+	reviewsEntities, total, err := s.reviewRepository.GetByPlaceIDFull(ctx, placeEntity.ID, limit+1, after)
+	if err != nil {
+		return []model.Review{}, model.PageInfo{}, err
+	}
+	reviewsEntities, info := pageOf(reviewsEntities, limit, total, func(e entity.Review) cursor.Cursor {
+		return cursor.Cursor{Time: e.CreatedAt, ID: e.PublicID}
+	})
+
+	reviews := []model.Review{}
+	for _, reviewEntity := range reviewsEntities {
+		reviews = append(reviews, mapReviewEntityToModel(reviewEntity, reviewEntity.Author))
+	}
+
+	return reviews, info, nil
+}
+
+// Create fails with ErrReviewExists when the user has already reviewed the
+// place.
+func (s *reviewServiceImpl) Create(ctx context.Context, placeID string, userID string, rc model.ReviewCreate) (model.Review, error) {
+	text, err := validateReview(rc.Rating, rc.Text)
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return model.Review{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	placeEntity, err := getVisiblePlace(ctx, s.placeRepository, placeID)
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	now := time.Now()
+	reviewEntity := entity.Review{
+		ID:        random.GenerateID(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		PublicID:  random.GeneratePublicID(),
+		PlaceID:   placeEntity.ID,
+		AuthorID:  userEntity.ID,
+		Rating:    rc.Rating,
+		Text:      text,
+	}
+
+	err = s.reviewRepository.Create(ctx, reviewEntity)
+	if errors.Is(err, storage.ErrDuplicate) {
+		return model.Review{}, service.ErrReviewExists.Wrap(err)
+	}
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	return mapReviewEntityToModel(reviewEntity, userEntity), nil
+}
+
+// UpdateByID replaces the rating and the text of a review, which is allowed
+// only to its author.
+func (s *reviewServiceImpl) UpdateByID(ctx context.Context, placeID string, reviewID string, userID string, ru model.ReviewUpdate) (model.Review, error) {
+	text, err := validateReview(ru.Rating, ru.Text)
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	userEntity, reviewEntity, err := s.getOwnReview(ctx, placeID, reviewID, userID)
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	reviewEntity.Rating = ru.Rating
+	reviewEntity.Text = text
+	reviewEntity.UpdatedAt = time.Now()
+
+	err = s.reviewRepository.Update(ctx, reviewEntity)
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	return mapReviewEntityToModel(reviewEntity, userEntity), nil
+}
+
+// DeleteByID deletes a review of its author. Moderators remove reviews of
+// other users with ModerationService.DeleteReview.
+func (s *reviewServiceImpl) DeleteByID(ctx context.Context, placeID string, reviewID string, userID string) error {
+	_, reviewEntity, err := s.getOwnReview(ctx, placeID, reviewID, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.reviewRepository.Delete(ctx, reviewEntity)
+}
+
+func (s *reviewServiceImpl) getOwnReview(ctx context.Context, placeID string, reviewID string, userID string) (entity.User, entity.Review, error) {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return entity.User{}, entity.Review{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	placeEntity, err := getVisiblePlace(ctx, s.placeRepository, placeID)
+	if err != nil {
+		return entity.User{}, entity.Review{}, err
+	}
+
+	reviewEntity, err := s.reviewRepository.GetByPublicID(ctx, reviewID)
+	if err != nil {
+		return entity.User{}, entity.Review{}, storageError(err, service.ErrReviewNotFound)
+	}
+
+	if reviewEntity.PlaceID != placeEntity.ID {
+		return entity.User{}, entity.Review{}, service.ErrReviewNotFound
+	}
+	if reviewEntity.AuthorID != userEntity.ID {
+		return entity.User{}, entity.Review{}, service.ErrNotAuthor
+	}
+
+	return userEntity, reviewEntity, nil
+}
+
+// validateReview returns the trimmed text of a review.
+func validateReview(rating int, text string) (string, error) {
+	if rating < minRating || rating > maxRating {
+		return "", service.ErrInvalidRating
+	}
+
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) > maxReviewTextLength {
+		return "", service.ErrInvalidReview
+	}
+
+	return text, nil
+}
+
+func mapReviewEntityToModel(reviewEntity entity.Review, authorEntity entity.User) model.Review {
+	return model.Review{
+		ID:        reviewEntity.PublicID,
+		CreatedAt: reviewEntity.CreatedAt,
+		UpdatedAt: reviewEntity.UpdatedAt,
+		Author: model.User{
+			ID:          authorEntity.PublicID,
+			Username:    authorEntity.Username,
+			DisplayName: authorEntity.DisplayName,
+			AvatarURL:   authorEntity.AvatarURL,
+		},
+		Rating: reviewEntity.Rating,
+		Text:   reviewEntity.Text,
+	}
+}
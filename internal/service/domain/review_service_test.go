@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/utils/cursor"
+)
+
+func TestReviewService_GetByPlaceID(t *testing.T) {
+	t.Parallel()
+
+	placeUUID := uuid.New()
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		place       entity.Place
+		placeErr    error
+		expected    []model.Review
+		expectedErr error
+	}{
+		{
+			name:  "success",
+			place: entity.Place{ID: placeUUID, PublicID: "place1"},
+			expected: []model.Review{{
+				ID:        "review1",
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+				Author:    model.User{ID: "user1", Username: "alice"},
+				Rating:    4,
+				Text:      "Nice view",
+			}},
+		},
+		{
+			name:        "hidden place",
+			place:       entity.Place{ID: placeUUID, PublicID: "place1", HiddenAt: &createdAt},
+			expected:    []model.Review{},
+			expectedErr: service.ErrPlaceNotFound,
+		},
+		{
+			name:        "place not found",
+			placeErr:    storage.ErrNotFound,
+			expected:    []model.Review{},
+			expectedErr: service.ErrPlaceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewSvc, reviewRepo, placeRepo, _ := setupReviewTest(t)
+			placeRepo.EXPECT().GetByPublicID(mock.Anything, "place1").Return(tt.place, tt.placeErr)
+			reviewRepo.EXPECT().GetByPlaceIDFull(mock.Anything, placeUUID, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return([]entity.Review{{
+				PublicID:  "review1",
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+				Author:    entity.User{PublicID: "user1", Username: "alice"},
+				Rating:    4,
+				Text:      "Nice view",
+			}}, int64(3), nil).Maybe()
+
+			result, info, err := reviewSvc.GetByPlaceID(context.Background(), "place1", model.Page{})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(3), info.Total)
+				assert.Empty(t, info.NextCursor)
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestReviewService_Create(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	placeUUID := uuid.New()
+
+	tests := []struct {
+		name        string
+		review      model.ReviewCreate
+		createErr   error
+		expectedErr error
+	}{
+		{name: "success", review: model.ReviewCreate{Rating: 5, Text: "  Great coffee  "}},
+		{name: "rating too low", review: model.ReviewCreate{Rating: 0}, expectedErr: service.ErrInvalidRating},
+		{name: "rating too high", review: model.ReviewCreate{Rating: 6}, expectedErr: service.ErrInvalidRating},
+		{name: "text too long", review: model.ReviewCreate{Rating: 3, Text: strings.Repeat("a", maxReviewTextLength+1)}, expectedErr: service.ErrInvalidReview},
+		{name: "already reviewed", review: model.ReviewCreate{Rating: 3}, createErr: storage.ErrDuplicate, expectedErr: service.ErrReviewExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewSvc, reviewRepo, placeRepo, userRepo := setupReviewTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1", Username: "alice"}, nil).Maybe()
+			placeRepo.EXPECT().GetByPublicID(mock.Anything, "place1").Return(entity.Place{ID: placeUUID, PublicID: "place1"}, nil).Maybe()
+			reviewRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(r entity.Review) bool {
+				return r.PlaceID == placeUUID && r.AuthorID == userUUID && r.Rating == tt.review.Rating
+			})).Return(tt.createErr).Maybe()
+
+			result, err := reviewSvc.Create(context.Background(), "place1", "user1", tt.review)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				if tt.createErr == nil {
+					reviewRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEmpty(t, result.ID)
+			assert.Equal(t, model.User{ID: "user1", Username: "alice"}, result.Author)
+			assert.Equal(t, 5, result.Rating)
+			assert.Equal(t, "Great coffee", result.Text)
+		})
+	}
+}
+
+func TestReviewService_UpdateByID(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	placeUUID := uuid.New()
+
+	tests := []struct {
+		name        string
+		review      entity.Review
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			review: entity.Review{PublicID: "review1", PlaceID: placeUUID, AuthorID: userUUID, Rating: 2},
+		},
+		{
+			name:        "not author",
+			review:      entity.Review{PublicID: "review1", PlaceID: placeUUID, AuthorID: uuid.New(), Rating: 2},
+			expectedErr: service.ErrNotAuthor,
+		},
+		{
+			name:        "review of another place",
+			review:      entity.Review{PublicID: "review1", PlaceID: uuid.New(), AuthorID: userUUID, Rating: 2},
+			expectedErr: service.ErrReviewNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewSvc, reviewRepo, placeRepo, userRepo := setupReviewTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1"}, nil)
+			placeRepo.EXPECT().GetByPublicID(mock.Anything, "place1").Return(entity.Place{ID: placeUUID, PublicID: "place1"}, nil)
+			reviewRepo.EXPECT().GetByPublicID(mock.Anything, "review1").Return(tt.review, nil)
+			if tt.expectedErr == nil {
+				reviewRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(r entity.Review) bool {
+					return r.Rating == 4 && r.Text == "Better now"
+				})).Return(nil).Once()
+			}
+
+			result, err := reviewSvc.UpdateByID(context.Background(), "place1", "review1", "user1", model.ReviewUpdate{Rating: 4, Text: "Better now"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 4, result.Rating)
+				assert.Equal(t, "Better now", result.Text)
+			}
+			reviewRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_DeleteByID(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	placeUUID := uuid.New()
+
+	tests := []struct {
+		name        string
+		review      entity.Review
+		reviewErr   error
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			review: entity.Review{PublicID: "review1", PlaceID: placeUUID, AuthorID: userUUID},
+		},
+		{
+			name:        "not author",
+			review:      entity.Review{PublicID: "review1", PlaceID: placeUUID, AuthorID: uuid.New()},
+			expectedErr: service.ErrNotAuthor,
+		},
+		{
+			name:        "review not found",
+			reviewErr:   storage.ErrNotFound,
+			expectedErr: service.ErrReviewNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewSvc, reviewRepo, placeRepo, userRepo := setupReviewTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1"}, nil)
+			placeRepo.EXPECT().GetByPublicID(mock.Anything, "place1").Return(entity.Place{ID: placeUUID, PublicID: "place1"}, nil)
+			reviewRepo.EXPECT().GetByPublicID(mock.Anything, "review1").Return(tt.review, tt.reviewErr)
+			if tt.expectedErr == nil {
+				reviewRepo.EXPECT().Delete(mock.Anything, tt.review).Return(nil).Once()
+			}
+
+			err := reviewSvc.DeleteByID(context.Background(), "place1", "review1", "user1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			reviewRepo.AssertExpectations(t)
+		})
+	}
+}
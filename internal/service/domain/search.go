@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"html"
+	"strings"
+
+	"locpack-backend/internal/storage"
+)
+
+var highlightReplacer = strings.NewReplacer(
+	storage.HighlightStart, "<mark>",
+	storage.HighlightStop, "</mark>",
+)
+
+// highlight escapes a search headline as HTML and wraps its matched words
+// in <mark> elements. It is empty when no word matched, for example when a
+// result was found only by similarity.
+func highlight(headline string) string {
+	if !strings.Contains(headline, storage.HighlightStart) {
+		return ""
+	}
+	return highlightReplacer.Replace(html.EscapeString(headline))
+}
@@ -1,10 +1,14 @@
 package domain
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
 	"locpack-backend/internal/storage"
+	"locpack-backend/pkg/utils/signature"
 )
 
 func setupServiceTest(t *testing.T) (*packServiceImpl, *placeServiceImpl, *userServiceImpl, *storage.MockPackRepository, *storage.MockPlaceRepository, *storage.MockUserRepository) {
@@ -14,9 +18,114 @@ func setupServiceTest(t *testing.T) (*packServiceImpl, *placeServiceImpl, *userS
 	packRepo := new(storage.MockPackRepository)
 	placeRepo := new(storage.MockPlaceRepository)
 	userRepo := new(storage.MockUserRepository)
-	packSvc := NewPackService(packRepo, placeRepo, userRepo).(*packServiceImpl)
-	placeSvc := NewPlaceService(placeRepo, userRepo).(*placeServiceImpl)
-	userSvc := NewUserService(userRepo).(*userServiceImpl)
+	packSvc := NewPackService(packRepo, placeRepo, userRepo, new(storage.MockPackCollaboratorRepository), new(storage.MockShareLinkRepository), anyActivities(), testSigner(t), inlineUnitOfWork{}).(*packServiceImpl)
+	placeSvc := NewPlaceService(placeRepo, userRepo, anyActivities(), anyVisits(), inlineUnitOfWork{}).(*placeServiceImpl)
+	userSvc := NewUserService(userRepo, new(storage.MockUserFollowRepository), new(storage.MockActivityRepository)).(*userServiceImpl)
 
 	return packSvc, placeSvc, userSvc, packRepo, placeRepo, userRepo
 }
+
+func setupCollaboratorTest(t *testing.T) (*packServiceImpl, *storage.MockPackRepository, *storage.MockUserRepository, *storage.MockPackCollaboratorRepository) {
+	t.Helper()
+
+	packRepo := new(storage.MockPackRepository)
+	userRepo := new(storage.MockUserRepository)
+	collaboratorRepo := new(storage.MockPackCollaboratorRepository)
+	packSvc := NewPackService(packRepo, new(storage.MockPlaceRepository), userRepo, collaboratorRepo, new(storage.MockShareLinkRepository), anyActivities(), testSigner(t), inlineUnitOfWork{}).(*packServiceImpl)
+
+	return packSvc, packRepo, userRepo, collaboratorRepo
+}
+
+func setupShareLinkTest(t *testing.T) (*packServiceImpl, *storage.MockPackRepository, *storage.MockUserRepository, *storage.MockPackCollaboratorRepository, *storage.MockShareLinkRepository) {
+	t.Helper()
+
+	packRepo := new(storage.MockPackRepository)
+	userRepo := new(storage.MockUserRepository)
+	collaboratorRepo := new(storage.MockPackCollaboratorRepository)
+	shareLinkRepo := new(storage.MockShareLinkRepository)
+	packSvc := NewPackService(packRepo, new(storage.MockPlaceRepository), userRepo, collaboratorRepo, shareLinkRepo, anyActivities(), testSigner(t), inlineUnitOfWork{}).(*packServiceImpl)
+
+	return packSvc, packRepo, userRepo, collaboratorRepo, shareLinkRepo
+}
+
+func setupFeedTest(t *testing.T) (*userServiceImpl, *storage.MockUserRepository, *storage.MockUserFollowRepository, *storage.MockActivityRepository) {
+	t.Helper()
+
+	userRepo := new(storage.MockUserRepository)
+	followRepo := new(storage.MockUserFollowRepository)
+	activityRepo := new(storage.MockActivityRepository)
+	userSvc := NewUserService(userRepo, followRepo, activityRepo).(*userServiceImpl)
+
+	return userSvc, userRepo, followRepo, activityRepo
+}
+
+func setupReviewTest(t *testing.T) (*reviewServiceImpl, *storage.MockReviewRepository, *storage.MockPlaceRepository, *storage.MockUserRepository) {
+	t.Helper()
+
+	reviewRepo := new(storage.MockReviewRepository)
+	placeRepo := new(storage.MockPlaceRepository)
+	userRepo := new(storage.MockUserRepository)
+	reviewSvc := NewReviewService(reviewRepo, placeRepo, userRepo).(*reviewServiceImpl)
+
+	return reviewSvc, reviewRepo, placeRepo, userRepo
+}
+
+func setupVisitTest(t *testing.T) (*visitServiceImpl, *storage.MockVisitRepository, *storage.MockPlaceRepository, *storage.MockPackRepository, *storage.MockUserRepository, *storage.MockActivityRepository) {
+	t.Helper()
+
+	visitRepo := new(storage.MockVisitRepository)
+	placeRepo := new(storage.MockPlaceRepository)
+	packRepo := new(storage.MockPackRepository)
+	userRepo := new(storage.MockUserRepository)
+	activityRepo := new(storage.MockActivityRepository)
+	visitSvc := NewVisitService(visitRepo, placeRepo, packRepo, userRepo, activityRepo, inlineUnitOfWork{}).(*visitServiceImpl)
+
+	return visitSvc, visitRepo, placeRepo, packRepo, userRepo, activityRepo
+}
+
+func setupMediaTest(t *testing.T) (*mediaServiceImpl, *storage.MockMediaRepository, *storage.MockPlaceRepository, *storage.MockPackRepository, *storage.MockUserRepository, *memoryBlobs) {
+	t.Helper()
+
+	mediaRepo := new(storage.MockMediaRepository)
+	placeRepo := new(storage.MockPlaceRepository)
+	packRepo := new(storage.MockPackRepository)
+	userRepo := new(storage.MockUserRepository)
+	blobs := &memoryBlobs{files: map[string][]byte{}}
+	mediaSvc := NewMediaService(mediaRepo, placeRepo, packRepo, userRepo, blobs, inlineUnitOfWork{}).(*mediaServiceImpl)
+
+	return mediaSvc, mediaRepo, placeRepo, packRepo, userRepo, blobs
+}
+
+// anyActivities accepts recorded activities, for tests that do not check
+// them.
+func anyActivities() *storage.MockActivityRepository {
+	activityRepo := new(storage.MockActivityRepository)
+	activityRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return activityRepo
+}
+
+// lastVisit is when users in tests last visited the places they have visited.
+var lastVisit = time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+
+func anyVisits() *storage.MockVisitRepository {
+	visitRepo := new(storage.MockVisitRepository)
+	visitRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return visitRepo
+}
+
+func testSigner(t *testing.T) *signature.Signer {
+	t.Helper()
+
+	signer, err := signature.New([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+// inlineUnitOfWork runs the work without a transaction.
+type inlineUnitOfWork struct{}
+
+func (inlineUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+)
+
+// atomically runs fn in a unit of work, so that its repository calls are
+// committed together or not at all.
+func atomically[T any](ctx context.Context, unitOfWork storage.UnitOfWork, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
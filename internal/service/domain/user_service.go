@@ -1,25 +1,45 @@
 package domain
 
 import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
 	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/utils/cursor"
 
 	"github.com/jinzhu/copier"
 )
 
+const (
+	maxDisplayNameLength = 50
+	maxBioLength         = 500
+	maxHomeCityLength    = 100
+)
+
 type userServiceImpl struct {
-	repository storage.UserRepository
+	repository         storage.UserRepository
+	followRepository   storage.UserFollowRepository
+	activityRepository storage.ActivityRepository
 }
 
-func NewUserService(repository storage.UserRepository) service.UserService {
-	return &userServiceImpl{repository}
+func NewUserService(
+	repository storage.UserRepository,
+	followRepository storage.UserFollowRepository,
+	activityRepository storage.ActivityRepository,
+) service.UserService {
+	return &userServiceImpl{repository, followRepository, activityRepository}
 }
 
-func (s *userServiceImpl) GetByID(id string) (model.User, error) {
-	userEntity, err := s.repository.GetByPublicID(id)
+func (s *userServiceImpl) GetByID(ctx context.Context, id string) (model.User, error) {
+	userEntity, err := s.repository.GetByPublicIDWithStats(ctx, id)
 	if err != nil {
-		return model.User{}, err
+		return model.User{}, storageError(err, service.ErrUserNotFound)
 	}
 
 	user := model.User{}
@@ -27,6 +47,166 @@ func (s *userServiceImpl) GetByID(id string) (model.User, error) {
 	if err != nil {
 		return model.User{}, err
 	}
+	user.Stats = &model.UserStats{
+		CreatedPacks:         userEntity.CreatedPackCount,
+		Followers:            userEntity.FollowerCount,
+		Following:            userEntity.FollowingCount,
+		VisitedPlaces:        userEntity.VisitedPlaceCount,
+		Countries:            userEntity.CountryCount,
+		UnknownCountryPlaces: userEntity.UnknownCountryPlaceCount,
+	}
 
 	return user, err
 }
+
+// UpdateByID replaces the profile of the user. Empty fields clear the
+// corresponding profile field.
+func (s *userServiceImpl) UpdateByID(ctx context.Context, id string, uu model.UserUpdate) (model.User, error) {
+	userEntity, err := s.repository.GetByPublicID(ctx, id)
+	if err != nil {
+		return model.User{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	uu.DisplayName = strings.TrimSpace(uu.DisplayName)
+	uu.Bio = strings.TrimSpace(uu.Bio)
+	uu.HomeCity = strings.TrimSpace(uu.HomeCity)
+	uu.AvatarURL = strings.TrimSpace(uu.AvatarURL)
+
+	if utf8.RuneCountInString(uu.DisplayName) > maxDisplayNameLength ||
+		utf8.RuneCountInString(uu.Bio) > maxBioLength ||
+		utf8.RuneCountInString(uu.HomeCity) > maxHomeCityLength {
+		return model.User{}, service.ErrInvalidProfile
+	}
+	if len(uu.AvatarURL) != 0 && !isWebURL(uu.AvatarURL) {
+		return model.User{}, service.ErrInvalidAvatar
+	}
+
+	userEntity.DisplayName = uu.DisplayName
+	userEntity.Bio = uu.Bio
+	userEntity.HomeCity = uu.HomeCity
+	userEntity.AvatarURL = uu.AvatarURL
+
+	err = s.repository.Update(ctx, userEntity)
+	if err != nil {
+		return model.User{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// CheckNotBanned fails for banned users. Users missing from the database
+// are not banned, because they cannot own any content yet.
+func (s *userServiceImpl) CheckNotBanned(ctx context.Context, id string) error {
+	userEntity, err := s.repository.GetByPublicID(ctx, id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if userEntity.BannedAt != nil {
+		return service.ErrUserBanned
+	}
+
+	return nil
+}
+
+// Follow makes the user with userID follow the user with id. Following a
+// user twice has no effect.
+func (s *userServiceImpl) Follow(ctx context.Context, id string, userID string) error {
+	follow, err := s.getFollow(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.followRepository.Create(ctx, follow)
+}
+
+func (s *userServiceImpl) Unfollow(ctx context.Context, id string, userID string) error {
+	follow, err := s.getFollow(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.followRepository.Delete(ctx, follow)
+}
+
+// GetFeed returns the activities of the users followed by the user, newest
+// first.
+func (s *userServiceImpl) GetFeed(ctx context.Context, userID string, page model.Page) ([]model.Activity, model.PageInfo, error) {
+	limit, after, err := pageBounds(page, 0)
+	if err != nil {
+		return []model.Activity{}, model.PageInfo{}, err
+	}
+
+	activitiesEntities, total, err := s.activityRepository.GetFeedFull(ctx, userID, limit+1, after)
+	if err != nil {
+		return []model.Activity{}, model.PageInfo{}, err
+	}
+	activitiesEntities, info := pageOf(activitiesEntities, limit, total, func(e entity.Activity) cursor.Cursor {
+		return cursor.Cursor{Time: e.CreatedAt, ID: e.PublicID}
+	})
+
+	activities := []model.Activity{}
+	for _, activityEntity := range activitiesEntities {
+		activity := model.Activity{
+			ID:        activityEntity.PublicID,
+			CreatedAt: activityEntity.CreatedAt,
+			Type:      activityEntity.Type,
+			User: model.User{
+				ID:       activityEntity.User.PublicID,
+				Username: activityEntity.User.Username,
+			},
+		}
+		if activityEntity.Pack != nil {
+			activity.Pack = &model.Pack{
+				ID:         activityEntity.Pack.PublicID,
+				Name:       activityEntity.Pack.Name,
+				Visibility: activityEntity.Pack.Visibility,
+				Version:    activityEntity.Pack.Version,
+			}
+		}
+		if activityEntity.Place != nil {
+			activity.Place = &model.Place{
+				ID:        activityEntity.Place.PublicID,
+				Name:      activityEntity.Place.Name,
+				Address:   activityEntity.Place.Address,
+				Latitude:  activityEntity.Place.Latitude,
+				Longitude: activityEntity.Place.Longitude,
+				Version:   activityEntity.Place.Version,
+			}
+		}
+
+		activities = append(activities, activity)
+	}
+
+	return activities, info, nil
+}
+
+func (s *userServiceImpl) getFollow(ctx context.Context, id string, userID string) (entity.UserFollow, error) {
+	userEntity, err := s.repository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return entity.UserFollow{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	followeeEntity, err := s.repository.GetByPublicID(ctx, id)
+	if err != nil {
+		return entity.UserFollow{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	if followeeEntity.ID == userEntity.ID {
+		return entity.UserFollow{}, service.ErrSelfFollow
+	}
+
+	return entity.UserFollow{FollowerID: userEntity.ID, FolloweeID: followeeEntity.ID}, nil
+}
+
+func isWebURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && len(u.Host) != 0
+}
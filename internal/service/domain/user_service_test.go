@@ -1,15 +1,22 @@
 package domain
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"locpack-backend/internal/service"
 	"locpack-backend/internal/service/model"
 	"locpack-backend/internal/storage"
 	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/activity_type"
+	"locpack-backend/pkg/enum/pack_visibility"
+	"locpack-backend/pkg/utils/cursor"
 )
 
 func TestUserService_GetByID(t *testing.T) {
@@ -29,15 +36,31 @@ func TestUserService_GetByID(t *testing.T) {
 			name:   "success",
 			userID: userID,
 			setupMocks: func(userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
-					ID:       userUUID,
-					PublicID: userID,
-					Username: "test-user",
+				userRepo.EXPECT().GetByPublicIDWithStats(mock.Anything, userID).Return(entity.User{
+					ID:             userUUID,
+					PublicID:       userID,
+					Username:       "test-user",
+					FollowerCount:  3,
+					FollowingCount: 1,
+
+					CreatedPackCount:  2,
+					VisitedPlaceCount: 5,
+					CountryCount:      2,
+
+					UnknownCountryPlaceCount: 1,
 				}, nil)
 			},
 			expected: model.User{
 				ID:       userID,
 				Username: "test-user",
+				Stats: &model.UserStats{
+					CreatedPacks:         2,
+					Followers:            3,
+					Following:            1,
+					VisitedPlaces:        5,
+					Countries:            2,
+					UnknownCountryPlaces: 1,
+				},
 			},
 			expectError: false,
 		},
@@ -45,7 +68,7 @@ func TestUserService_GetByID(t *testing.T) {
 			name:   "user not found",
 			userID: "not-exist",
 			setupMocks: func(userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID("not-exist").Return(entity.User{}, errors.New("user not found"))
+				userRepo.EXPECT().GetByPublicIDWithStats(mock.Anything, "not-exist").Return(entity.User{}, errors.New("user not found"))
 			},
 			expected:    model.User{},
 			expectError: true,
@@ -57,7 +80,7 @@ func TestUserService_GetByID(t *testing.T) {
 			_, _, userSvc, _, _, userRepo := setupServiceTest(t)
 			tt.setupMocks(userRepo)
 
-			result, err := userSvc.GetByID(tt.userID)
+			result, err := userSvc.GetByID(context.Background(), tt.userID)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -65,16 +88,138 @@ func TestUserService_GetByID(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expected.ID, result.ID)
 				assert.Equal(t, tt.expected.Username, result.Username)
+				assert.Equal(t, tt.expected.Stats, result.Stats)
 			}
 		})
 	}
 }
 
+func TestUserService_Follow(t *testing.T) {
+	t.Parallel()
+
+	user := entity.User{ID: uuid.New(), PublicID: "user1"}
+	followee := entity.User{ID: uuid.New(), PublicID: "user2"}
+
+	tests := []struct {
+		name        string
+		followeeID  string
+		setupMocks  func(*storage.MockUserRepository, *storage.MockUserFollowRepository)
+		expectedErr error
+	}{
+		{
+			name:       "success",
+			followeeID: "user2",
+			setupMocks: func(userRepo *storage.MockUserRepository, followRepo *storage.MockUserFollowRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(user, nil)
+				userRepo.EXPECT().GetByPublicID(mock.Anything, "user2").Return(followee, nil)
+				followRepo.EXPECT().Create(mock.Anything, entity.UserFollow{FollowerID: user.ID, FolloweeID: followee.ID}).Return(nil)
+			},
+		},
+		{
+			name:       "followee not found",
+			followeeID: "user3",
+			setupMocks: func(userRepo *storage.MockUserRepository, followRepo *storage.MockUserFollowRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(user, nil)
+				userRepo.EXPECT().GetByPublicID(mock.Anything, "user3").Return(entity.User{}, storage.ErrNotFound)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+		{
+			name:       "follow themselves",
+			followeeID: "user1",
+			setupMocks: func(userRepo *storage.MockUserRepository, followRepo *storage.MockUserFollowRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(user, nil)
+			},
+			expectedErr: service.ErrSelfFollow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userSvc, userRepo, followRepo, _ := setupFeedTest(t)
+			tt.setupMocks(userRepo, followRepo)
+
+			err := userSvc.Follow(context.Background(), tt.followeeID, "user1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			userRepo.AssertExpectations(t)
+			followRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserService_Unfollow(t *testing.T) {
+	t.Parallel()
+
+	user := entity.User{ID: uuid.New(), PublicID: "user1"}
+	followee := entity.User{ID: uuid.New(), PublicID: "user2"}
+
+	userSvc, userRepo, followRepo, _ := setupFeedTest(t)
+	userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(user, nil)
+	userRepo.EXPECT().GetByPublicID(mock.Anything, "user2").Return(followee, nil)
+	followRepo.EXPECT().Delete(mock.Anything, entity.UserFollow{FollowerID: user.ID, FolloweeID: followee.ID}).Return(nil)
+
+	err := userSvc.Unfollow(context.Background(), "user2", "user1")
+
+	assert.NoError(t, err)
+	followRepo.AssertExpectations(t)
+}
+
+func TestUserService_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+
+	userSvc, _, _, activityRepo := setupFeedTest(t)
+	activityRepo.EXPECT().GetFeedFull(mock.Anything, "user1", 2, (*cursor.Cursor)(nil)).Return([]entity.Activity{
+		{
+			ID:        uuid.New(),
+			CreatedAt: createdAt,
+			PublicID:  "activity1",
+			Type:      activity_type.PlaceAdded,
+			User:      entity.User{PublicID: "user2", Username: "john"},
+			Pack:      &entity.Pack{PublicID: "pack1", Name: "Paris", Visibility: pack_visibility.Public, Version: 2},
+			Place:     &entity.Place{PublicID: "place1", Name: "Louvre", Version: 1},
+		},
+		{
+			ID:        uuid.New(),
+			CreatedAt: createdAt.Add(-time.Hour),
+			PublicID:  "activity2",
+			Type:      activity_type.PackCreated,
+			User:      entity.User{PublicID: "user2", Username: "john"},
+			Pack:      &entity.Pack{PublicID: "pack1", Name: "Paris", Visibility: pack_visibility.Public, Version: 1},
+		},
+	}, int64(2), nil)
+
+	activities, pageInfo, err := userSvc.GetFeed(context.Background(), "user1", model.Page{Limit: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Activity{
+		{
+			ID:        "activity1",
+			CreatedAt: createdAt,
+			Type:      activity_type.PlaceAdded,
+			User:      model.User{ID: "user2", Username: "john"},
+			Pack:      &model.Pack{ID: "pack1", Name: "Paris", Visibility: pack_visibility.Public, Version: 2},
+			Place:     &model.Place{ID: "place1", Name: "Louvre", Version: 1},
+		},
+	}, activities)
+	assert.Equal(t, int64(2), pageInfo.Total)
+	assert.Equal(t, cursor.Encode(cursor.Cursor{Time: createdAt, ID: "activity1"}), pageInfo.NextCursor)
+	activityRepo.AssertExpectations(t)
+}
+
 func TestUserService_UpdateByID(t *testing.T) {
 	t.Parallel()
 
 	userID := "user-123"
 	userUUID := uuid.New()
+	updateErr := errors.New("update failed")
 
 	tests := []struct {
 		name        string
@@ -82,60 +227,86 @@ func TestUserService_UpdateByID(t *testing.T) {
 		input       model.UserUpdate
 		setupMocks  func(*storage.MockUserRepository)
 		expected    model.User
-		expectError bool
+		expectedErr error
 	}{
 		{
 			name:   "success",
 			userID: userID,
 			input: model.UserUpdate{
-				Username: "updated-user",
+				DisplayName: " Updated User ",
+				Bio:         "Walks a lot",
+				HomeCity:    "Lisbon",
+				AvatarURL:   "https://example.com/avatar.png",
 			},
 			setupMocks: func(userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
-					ID:       userUUID,
-					PublicID: userID,
-					Username: "old-user",
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{
+					ID:          userUUID,
+					PublicID:    userID,
+					Username:    "test-user",
+					DisplayName: "Old User",
 				}, nil)
 
-				userRepo.EXPECT().Update(mock.MatchedBy(func(u entity.User) bool {
-					return u.Username == "updated-user" && u.PublicID == "updated-user"
+				userRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u entity.User) bool {
+					return u.ID == userUUID && u.Username == "test-user" && u.DisplayName == "Updated User" &&
+						u.Bio == "Walks a lot" && u.HomeCity == "Lisbon" && u.AvatarURL == "https://example.com/avatar.png"
 				})).Return(nil)
+
+				userRepo.EXPECT().GetByPublicIDWithStats(mock.Anything, userID).Return(entity.User{
+					ID:          userUUID,
+					PublicID:    userID,
+					Username:    "test-user",
+					DisplayName: "Updated User",
+					Bio:         "Walks a lot",
+					HomeCity:    "Lisbon",
+					AvatarURL:   "https://example.com/avatar.png",
+				}, nil)
 			},
 			expected: model.User{
-				ID:       "updated-user",
-				Username: "updated-user",
+				ID:          userID,
+				Username:    "test-user",
+				DisplayName: "Updated User",
+				Bio:         "Walks a lot",
+				HomeCity:    "Lisbon",
+				AvatarURL:   "https://example.com/avatar.png",
+				Stats:       &model.UserStats{},
 			},
-			expectError: false,
 		},
 		{
 			name:   "user not found",
 			userID: "not-exist",
-			input: model.UserUpdate{
-				Username: "irrelevant",
+			input:  model.UserUpdate{DisplayName: "irrelevant"},
+			setupMocks: func(userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, "not-exist").Return(entity.User{}, storage.ErrNotFound)
+			},
+			expectedErr: service.ErrUserNotFound,
+		},
+		{
+			name:   "display name too long",
+			userID: userID,
+			input:  model.UserUpdate{DisplayName: strings.Repeat("a", 51)},
+			setupMocks: func(userRepo *storage.MockUserRepository) {
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
 			},
+			expectedErr: service.ErrInvalidProfile,
+		},
+		{
+			name:   "avatar is not a web URL",
+			userID: userID,
+			input:  model.UserUpdate{AvatarURL: "javascript:alert(1)"},
 			setupMocks: func(userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID("not-exist").Return(entity.User{}, errors.New("not found"))
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
 			},
-			expected:    model.User{},
-			expectError: true,
+			expectedErr: service.ErrInvalidAvatar,
 		},
 		{
 			name:   "update fails",
 			userID: userID,
-			input: model.UserUpdate{
-				Username: "bad-user",
-			},
+			input:  model.UserUpdate{DisplayName: "Updated User"},
 			setupMocks: func(userRepo *storage.MockUserRepository) {
-				userRepo.EXPECT().GetByPublicID(userID).Return(entity.User{
-					ID:       userUUID,
-					PublicID: userID,
-					Username: "old-user",
-				}, nil)
-
-				userRepo.EXPECT().Update(mock.Anything).Return(errors.New("update failed"))
+				userRepo.EXPECT().GetByPublicID(mock.Anything, userID).Return(entity.User{ID: userUUID, PublicID: userID}, nil)
+				userRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(updateErr)
 			},
-			expected:    model.User{},
-			expectError: true,
+			expectedErr: updateErr,
 		},
 	}
 
@@ -144,14 +315,13 @@ func TestUserService_UpdateByID(t *testing.T) {
 			_, _, userSvc, _, _, userRepo := setupServiceTest(t)
 			tt.setupMocks(userRepo)
 
-			result, err := userSvc.UpdateByID(tt.userID, tt.input)
+			result, err := userSvc.UpdateByID(context.Background(), tt.userID, tt.input)
 
-			if tt.expectError {
-				assert.Error(t, err)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.expected.ID, result.ID)
-				assert.Equal(t, tt.expected.Username, result.Username)
+				assert.Equal(t, tt.expected, result)
 			}
 		})
 	}
@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/activity_type"
+	"locpack-backend/pkg/utils/cursor"
+	"locpack-backend/pkg/utils/random"
+)
+
+const maxVisitNoteLength = 2000
+
+type visitServiceImpl struct {
+	visitRepository    storage.VisitRepository
+	placeRepository    storage.PlaceRepository
+	packRepository     storage.PackRepository
+	userRepository     storage.UserRepository
+	activityRepository storage.ActivityRepository
+	unitOfWork         storage.UnitOfWork
+}
+
+func NewVisitService(
+	visitRepository storage.VisitRepository,
+	placeRepository storage.PlaceRepository,
+	packRepository storage.PackRepository,
+	userRepository storage.UserRepository,
+	activityRepository storage.ActivityRepository,
+	unitOfWork storage.UnitOfWork,
+) service.VisitService {
+	return &visitServiceImpl{visitRepository, placeRepository, packRepository, userRepository, activityRepository, unitOfWork}
+}
+
+// GetByUserID returns the visits of the user matching vf, latest first.
+func (s *visitServiceImpl) GetByUserID(ctx context.Context, userID string, vf model.VisitFilter, page model.Page) ([]model.Visit, model.PageInfo, error) {
+	if vf.From != nil && vf.To != nil && !vf.From.Before(*vf.To) {
+		return []model.Visit{}, model.PageInfo{}, service.ErrInvalidDateRange
+	}
+
+	limit, after, err := pageBounds(page, 0)
+	if err != nil {
+		return []model.Visit{}, model.PageInfo{}, err
+	}
+
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return []model.Visit{}, model.PageInfo{}, storageError(err, service.ErrUserNotFound)
+	}
+
+	visitsEntities, total, err := s.visitRepository.GetByUserIDFull(ctx, userEntity.ID, vf.From, vf.To, limit+1, after)
+	if err != nil {
+		return []model.Visit{}, model.PageInfo{}, err
+	}
+	visitsEntities, info := pageOf(visitsEntities, limit, total, func(e entity.Visit) cursor.Cursor {
+		return cursor.Cursor{Time: e.VisitedAt, ID: e.PublicID}
+	})
+
+	visits := []model.Visit{}
+	for _, visitEntity := range visitsEntities {
+		visits = append(visits, mapVisitEntityToModel(visitEntity))
+	}
+
+	return visits, info, nil
+}
+
+// Create checks the user in at the place. Any user can visit any visible
+// place, and visiting it again records another check-in. Followed packs whose
+// last place this was are marked as completed.
+func (s *visitServiceImpl) Create(ctx context.Context, placeID string, userID string, vc model.VisitCreate) (model.Visit, error) {
+	return atomically(ctx, s.unitOfWork, func(ctx context.Context) (model.Visit, error) {
+		userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+		if err != nil {
+			return model.Visit{}, storageError(err, service.ErrUserNotFound)
+		}
+
+		placeEntity, err := getVisiblePlace(ctx, s.placeRepository, placeID)
+		if err != nil {
+			return model.Visit{}, err
+		}
+
+		visitEntity, err := newVisit(userEntity, placeEntity, &vc)
+		if err != nil {
+			return model.Visit{}, err
+		}
+
+		err = checkIn(ctx, s.visitRepository, s.activityRepository, userEntity, placeEntity, visitEntity)
+		if err != nil {
+			return model.Visit{}, err
+		}
+
+		err = s.completePacks(ctx, userEntity, placeEntity)
+		if err != nil {
+			return model.Visit{}, err
+		}
+
+		visitEntity.Place = placeEntity
+		return mapVisitEntityToModel(visitEntity), nil
+	})
+}
+
+// DeleteByID deletes a single visit of the user. The place stays visited as
+// long as the user has other visits to it. Visits are private, so those of
+// other users are not found.
+func (s *visitServiceImpl) DeleteByID(ctx context.Context, visitID string, userID string) error {
+	userEntity, err := s.userRepository.GetByPublicID(ctx, userID)
+	if err != nil {
+		return storageError(err, service.ErrUserNotFound)
+	}
+
+	visitEntity, err := s.visitRepository.GetByPublicID(ctx, visitID)
+	if err != nil {
+		return storageError(err, service.ErrVisitNotFound)
+	}
+	if visitEntity.UserID != userEntity.ID {
+		return service.ErrVisitNotFound
+	}
+
+	return s.visitRepository.Delete(ctx, visitEntity)
+}
+
+// checkIn records the visit and tells the followers of the user about it.
+func checkIn(
+	ctx context.Context,
+	visitRepository storage.VisitRepository,
+	activityRepository storage.ActivityRepository,
+	userEntity entity.User,
+	placeEntity entity.Place,
+	visitEntity entity.Visit,
+) error {
+	err := visitRepository.Create(ctx, visitEntity)
+	if err != nil {
+		return err
+	}
+
+	return activityRepository.Create(ctx, newActivity(userEntity, activity_type.PlaceVisited, nil, &placeEntity))
+}
+
+// completePacks marks the packs followed by the user that are completed by
+// their visit to the place, and tells the followers of the user about them.
+func (s *visitServiceImpl) completePacks(ctx context.Context, userEntity entity.User, placeEntity entity.Place) error {
+	packsEntities, err := s.packRepository.CompleteByPlaceID(ctx, userEntity.ID, placeEntity.ID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, packEntity := range packsEntities {
+		err = s.activityRepository.Create(ctx, newActivity(userEntity, activity_type.PackCompleted, &packEntity, nil))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newVisit validates vc and returns a visit of the user to the place. A nil
+// vc is a check-in now without a note or a rating.
+func newVisit(userEntity entity.User, placeEntity entity.Place, vc *model.VisitCreate) (entity.Visit, error) {
+	now := time.Now()
+	visit := entity.Visit{
+		ID:        random.GenerateID(),
+		CreatedAt: now,
+		PublicID:  random.GeneratePublicID(),
+		UserID:    userEntity.ID,
+		PlaceID:   placeEntity.ID,
+		VisitedAt: now,
+	}
+	if vc == nil {
+		return visit, nil
+	}
+
+	if vc.VisitedAt != nil {
+		if vc.VisitedAt.After(now) {
+			return entity.Visit{}, service.ErrInvalidVisitDate
+		}
+		visit.VisitedAt = *vc.VisitedAt
+	}
+
+	if vc.Rating != nil && (*vc.Rating < minRating || *vc.Rating > maxRating) {
+		return entity.Visit{}, service.ErrInvalidRating
+	}
+	visit.Rating = vc.Rating
+
+	visit.Note = strings.TrimSpace(vc.Note)
+	if utf8.RuneCountInString(visit.Note) > maxVisitNoteLength {
+		return entity.Visit{}, service.ErrInvalidVisitNote
+	}
+
+	return visit, nil
+}
+
+// lastVisitedAt returns when the user last visited the place, or nil when
+// they have never visited it. It relies on the visitors of the place being
+// loaded.
+func lastVisitedAt(placeEntity entity.Place, userID string) *time.Time {
+	for _, visitor := range placeEntity.Visitors {
+		if strings.EqualFold(visitor.User.PublicID, userID) {
+			return &visitor.LastVisitedAt
+		}
+	}
+	return nil
+}
+
+func mapVisitEntityToModel(visitEntity entity.Visit) model.Visit {
+	return model.Visit{
+		ID:        visitEntity.PublicID,
+		VisitedAt: visitEntity.VisitedAt,
+		Note:      visitEntity.Note,
+		Rating:    visitEntity.Rating,
+		Place: model.Place{
+			ID:          visitEntity.Place.PublicID,
+			Name:        visitEntity.Place.Name,
+			Address:     visitEntity.Place.Address,
+			Latitude:    visitEntity.Place.Latitude,
+			Longitude:   visitEntity.Place.Longitude,
+			Visited:     true,
+			Version:     visitEntity.Place.Version,
+			CountryCode: visitEntity.Place.CountryCode,
+		},
+	}
+}
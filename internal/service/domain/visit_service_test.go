@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"locpack-backend/internal/service"
+	"locpack-backend/internal/service/model"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/enum/activity_type"
+	"locpack-backend/pkg/utils/cursor"
+)
+
+func TestVisitService_GetByUserID(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	rating := 5
+
+	tests := []struct {
+		name        string
+		filter      model.VisitFilter
+		userErr     error
+		expected    []model.Visit
+		expectedErr error
+	}{
+		{
+			name:   "success",
+			filter: model.VisitFilter{From: &from, To: &to},
+			expected: []model.Visit{{
+				ID:        "visit1",
+				VisitedAt: lastVisit,
+				Note:      "Great view",
+				Rating:    &rating,
+				Place:     model.Place{ID: "place1", Name: "Louvre", Visited: true, CountryCode: "FR"},
+			}},
+		},
+		{
+			name:        "empty range",
+			filter:      model.VisitFilter{From: &to, To: &from},
+			expected:    []model.Visit{},
+			expectedErr: service.ErrInvalidDateRange,
+		},
+		{
+			name:        "user not found",
+			userErr:     storage.ErrNotFound,
+			expected:    []model.Visit{},
+			expectedErr: service.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visitSvc, visitRepo, _, _, userRepo, _ := setupVisitTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID}, tt.userErr).Maybe()
+			visitRepo.EXPECT().GetByUserIDFull(mock.Anything, userUUID, tt.filter.From, tt.filter.To, defaultPageLimit+1, (*cursor.Cursor)(nil)).Return([]entity.Visit{{
+				PublicID:  "visit1",
+				VisitedAt: lastVisit,
+				Note:      "Great view",
+				Rating:    &rating,
+				Place:     entity.Place{PublicID: "place1", Name: "Louvre", CountryCode: "FR"},
+			}}, int64(2), nil).Maybe()
+
+			result, info, err := visitSvc.GetByUserID(context.Background(), "user1", tt.filter, model.Page{})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), info.Total)
+				assert.Empty(t, info.NextCursor)
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestVisitService_Create(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	placeUUID := uuid.New()
+	packUUID := uuid.New()
+	future := time.Now().Add(time.Hour)
+	rating := 4
+	badRating := 6
+
+	tests := []struct {
+		name        string
+		input       model.VisitCreate
+		place       entity.Place
+		placeErr    error
+		completed   []entity.Pack
+		expected    model.Visit
+		expectedErr error
+	}{
+		{
+			name:  "success",
+			input: model.VisitCreate{VisitedAt: &lastVisit, Note: " Sunny ", Rating: &rating},
+			place: entity.Place{ID: placeUUID, PublicID: "place1", Name: "Louvre"},
+			expected: model.Visit{
+				VisitedAt: lastVisit,
+				Note:      "Sunny",
+				Rating:    &rating,
+				Place:     model.Place{ID: "place1", Name: "Louvre", Visited: true},
+			},
+		},
+		{
+			name:      "success - completes followed pack",
+			input:     model.VisitCreate{VisitedAt: &lastVisit, Note: "Sunny"},
+			place:     entity.Place{ID: placeUUID, PublicID: "place1", Name: "Louvre"},
+			completed: []entity.Pack{{ID: packUUID, PublicID: "pack1"}},
+			expected: model.Visit{
+				VisitedAt: lastVisit,
+				Note:      "Sunny",
+				Place:     model.Place{ID: "place1", Name: "Louvre", Visited: true},
+			},
+		},
+		{
+			name:        "hidden place",
+			place:       entity.Place{ID: placeUUID, PublicID: "place1", HiddenAt: &lastVisit},
+			expectedErr: service.ErrPlaceNotFound,
+		},
+		{
+			name:        "place not found",
+			placeErr:    storage.ErrNotFound,
+			expectedErr: service.ErrPlaceNotFound,
+		},
+		{
+			name:        "visit in the future",
+			input:       model.VisitCreate{VisitedAt: &future},
+			place:       entity.Place{ID: placeUUID, PublicID: "place1"},
+			expectedErr: service.ErrInvalidVisitDate,
+		},
+		{
+			name:        "invalid rating",
+			input:       model.VisitCreate{Rating: &badRating},
+			place:       entity.Place{ID: placeUUID, PublicID: "place1"},
+			expectedErr: service.ErrInvalidRating,
+		},
+		{
+			name:        "note too long",
+			input:       model.VisitCreate{Note: strings.Repeat("a", maxVisitNoteLength+1)},
+			place:       entity.Place{ID: placeUUID, PublicID: "place1"},
+			expectedErr: service.ErrInvalidVisitNote,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visitSvc, visitRepo, placeRepo, packRepo, userRepo, activityRepo := setupVisitTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1"}, nil)
+			placeRepo.EXPECT().GetByPublicID(mock.Anything, "place1").Return(tt.place, tt.placeErr)
+			if tt.expectedErr == nil {
+				visitRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(v entity.Visit) bool {
+					return v.UserID == userUUID && v.PlaceID == placeUUID && v.VisitedAt.Equal(lastVisit) && v.Note == "Sunny"
+				})).Return(nil).Once()
+				activityRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(a entity.Activity) bool {
+					return a.Type == activity_type.PlaceVisited && a.UserID == userUUID && a.PlaceID != nil && *a.PlaceID == placeUUID
+				})).Return(nil).Once()
+				packRepo.EXPECT().CompleteByPlaceID(mock.Anything, userUUID, placeUUID, mock.Anything).Return(tt.completed, nil).Once()
+				for _, packEntity := range tt.completed {
+					activityRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(a entity.Activity) bool {
+						return a.Type == activity_type.PackCompleted && a.UserID == userUUID && a.PackID != nil && *a.PackID == packEntity.ID
+					})).Return(nil).Once()
+				}
+			}
+
+			result, err := visitSvc.Create(context.Background(), "place1", "user1", tt.input)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, result.ID)
+				result.ID = ""
+			}
+			assert.Equal(t, tt.expected, result)
+			visitRepo.AssertExpectations(t)
+			packRepo.AssertExpectations(t)
+			activityRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVisitService_DeleteByID(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New()
+	visit := entity.Visit{ID: uuid.New(), PublicID: "visit1", UserID: userUUID, PlaceID: uuid.New()}
+
+	tests := []struct {
+		name        string
+		visit       entity.Visit
+		visitErr    error
+		expectedErr error
+	}{
+		{
+			name:  "success",
+			visit: visit,
+		},
+		{
+			name:        "visit not found",
+			visitErr:    storage.ErrNotFound,
+			expectedErr: service.ErrVisitNotFound,
+		},
+		{
+			name:        "visit of another user",
+			visit:       entity.Visit{ID: uuid.New(), PublicID: "visit1", UserID: uuid.New(), PlaceID: visit.PlaceID},
+			expectedErr: service.ErrVisitNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visitSvc, visitRepo, _, _, userRepo, _ := setupVisitTest(t)
+			userRepo.EXPECT().GetByPublicID(mock.Anything, "user1").Return(entity.User{ID: userUUID, PublicID: "user1"}, nil)
+			visitRepo.EXPECT().GetByPublicID(mock.Anything, "visit1").Return(tt.visit, tt.visitErr)
+			if tt.expectedErr == nil {
+				visitRepo.EXPECT().Delete(mock.Anything, tt.visit).Return(nil).Once()
+			}
+
+			err := visitSvc.DeleteByID(context.Background(), "visit1", "user1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			visitRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+// Kind classifies domain errors so that the transport layer can map them
+// to responses without knowing every individual case.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindForbidden
+	KindConflict
+	KindInvalid
+	KindUnauthorized
+	KindUpstream
+	KindUnavailable
+	KindTimeout
+	KindPrecondition
+	KindTooLarge
+	KindUnsupported
+)
+
+// Error is a domain error with a stable machine-readable code.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is a domain error with the same code, so that
+// wrapped copies still match the catalogue values with errors.Is.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// Wrap returns a copy of the error that keeps err as its cause.
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+	return &wrapped
+}
+
+var (
+	ErrInternal = &Error{Kind: KindInternal, Code: "internal_error", Message: "Internal error"}
+
+	ErrUserNotFound   = &Error{Kind: KindNotFound, Code: "user_not_found", Message: "User not found"}
+	ErrPackNotFound   = &Error{Kind: KindNotFound, Code: "pack_not_found", Message: "Pack not found"}
+	ErrPlaceNotFound  = &Error{Kind: KindNotFound, Code: "place_not_found", Message: "Place not found"}
+	ErrPlaceNotInPack = &Error{Kind: KindNotFound, Code: "place_not_in_pack", Message: "Place is not in pack"}
+
+	ErrCollaboratorNotFound = &Error{Kind: KindNotFound, Code: "collaborator_not_found", Message: "Collaborator not found"}
+	ErrShareLinkNotFound    = &Error{Kind: KindNotFound, Code: "share_link_not_found", Message: "Share link not found or expired"}
+	ErrInvitationNotFound   = &Error{Kind: KindNotFound, Code: "invitation_not_found", Message: "Invitation not found"}
+	ErrMediaNotFound        = &Error{Kind: KindNotFound, Code: "media_not_found", Message: "Media not found"}
+	ErrReviewNotFound       = &Error{Kind: KindNotFound, Code: "review_not_found", Message: "Review not found"}
+	ErrVisitNotFound        = &Error{Kind: KindNotFound, Code: "visit_not_found", Message: "Visit not found"}
+
+	ErrNotAuthor            = &Error{Kind: KindForbidden, Code: "not_author", Message: "User is not author"}
+	ErrAlreadyAuthenticated = &Error{Kind: KindForbidden, Code: "already_authenticated", Message: "User is already authenticated"}
+	ErrMissingRole          = &Error{Kind: KindForbidden, Code: "missing_role", Message: "User does not have the required role"}
+	ErrUserBanned           = &Error{Kind: KindForbidden, Code: "user_banned", Message: "User is banned"}
+	ErrNotEditor            = &Error{Kind: KindForbidden, Code: "not_editor", Message: "User cannot edit this pack"}
+
+	ErrPackFollowOnly    = &Error{Kind: KindConflict, Code: "pack_follow_only", Message: "It is possible only to follow this pack"}
+	ErrPackUnfollowOnly  = &Error{Kind: KindConflict, Code: "pack_unfollow_only", Message: "It is possible only to unfollow this pack"}
+	ErrUserExists        = &Error{Kind: KindConflict, Code: "user_exists", Message: "User already exists"}
+	ErrPossibleDuplicate = &Error{Kind: KindConflict, Code: "possible_duplicate", Message: "Similar places already exist"}
+	ErrMediaLimit        = &Error{Kind: KindConflict, Code: "media_limit", Message: "Place already has the maximum number of photos"}
+	ErrReviewExists      = &Error{Kind: KindConflict, Code: "review_exists", Message: "User has already reviewed this place"}
+
+	ErrInvalidPage       = &Error{Kind: KindInvalid, Code: "invalid_page", Message: "Page is invalid"}
+	ErrInvalidRadius     = &Error{Kind: KindInvalid, Code: "invalid_radius", Message: "Radius is out of range"}
+	ErrInvalidLocation   = &Error{Kind: KindInvalid, Code: "invalid_location", Message: "Latitude must be between -90 and 90 and longitude between -180 and 180, both set or both empty"}
+	ErrInvalidPosition   = &Error{Kind: KindInvalid, Code: "invalid_position", Message: "Position is out of range"}
+	ErrInvalidDuration   = &Error{Kind: KindInvalid, Code: "invalid_duration", Message: "Planned duration must not be negative"}
+	ErrSelfModeration    = &Error{Kind: KindInvalid, Code: "self_moderation", Message: "Moderators cannot ban themselves"}
+	ErrInvalidVisibility = &Error{Kind: KindInvalid, Code: "invalid_visibility", Message: "Visibility must be PRIVATE, UNLISTED or PUBLIC"}
+	ErrInvalidExpiry     = &Error{Kind: KindInvalid, Code: "invalid_expiry", Message: "Expiry must be in the future"}
+	ErrInvalidRole       = &Error{Kind: KindInvalid, Code: "invalid_role", Message: "Role must be EDITOR or VIEWER"}
+	ErrSelfInvitation    = &Error{Kind: KindInvalid, Code: "self_invitation", Message: "Authors cannot invite themselves"}
+	ErrSelfMerge         = &Error{Kind: KindInvalid, Code: "self_merge", Message: "Place cannot be merged into itself"}
+	ErrSelfFollow        = &Error{Kind: KindInvalid, Code: "self_follow", Message: "Users cannot follow themselves"}
+	ErrInvalidCountry    = &Error{Kind: KindInvalid, Code: "invalid_country", Message: "Country code must be an ISO 3166-1 alpha-2 code"}
+	ErrInvalidProfile    = &Error{Kind: KindInvalid, Code: "invalid_profile", Message: "Display name, bio or home city is too long"}
+	ErrInvalidAvatar     = &Error{Kind: KindInvalid, Code: "invalid_avatar", Message: "Avatar must be an http or https URL"}
+	ErrInvalidImage      = &Error{Kind: KindInvalid, Code: "invalid_image", Message: "Image cannot be decoded or has too many pixels"}
+	ErrInvalidRating     = &Error{Kind: KindInvalid, Code: "invalid_rating", Message: "Rating must be between 1 and 5"}
+	ErrInvalidReview     = &Error{Kind: KindInvalid, Code: "invalid_review", Message: "Review must not be longer than 2000 characters"}
+	ErrInvalidSort       = &Error{Kind: KindInvalid, Code: "invalid_sort", Message: "Sort must be RELEVANCE or RATING"}
+	ErrInvalidVisitNote  = &Error{Kind: KindInvalid, Code: "invalid_visit_note", Message: "Visit note must not be longer than 2000 characters"}
+	ErrInvalidVisitDate  = &Error{Kind: KindInvalid, Code: "invalid_visit_date", Message: "Visit date must not be in the future"}
+	ErrInvalidDateRange  = &Error{Kind: KindInvalid, Code: "invalid_date_range", Message: "From must be before to"}
+	ErrInvalidProgress   = &Error{Kind: KindInvalid, Code: "invalid_progress", Message: "Status must be in_progress or completed"}
+
+	ErrUnauthenticated    = &Error{Kind: KindUnauthorized, Code: "unauthenticated", Message: "Authentication is required"}
+	ErrInvalidCredentials = &Error{Kind: KindUnauthorized, Code: "invalid_credentials", Message: "Invalid username or password"}
+	ErrInvalidToken       = &Error{Kind: KindUnauthorized, Code: "invalid_token", Message: "Token is invalid or expired"}
+
+	ErrAuthProvider = &Error{Kind: KindUpstream, Code: "auth_provider_error", Message: "Authentication provider failed"}
+	ErrBlobStorage  = &Error{Kind: KindUpstream, Code: "blob_storage_error", Message: "File storage failed"}
+
+	ErrShuttingDown            = &Error{Kind: KindUnavailable, Code: "shutting_down", Message: "Server is shutting down"}
+	ErrDatabaseUnavailable     = &Error{Kind: KindUnavailable, Code: "database_unavailable", Message: "Database is unavailable"}
+	ErrAuthProviderUnavailable = &Error{Kind: KindUnavailable, Code: "auth_provider_unavailable", Message: "Authentication provider is unavailable"}
+
+	ErrTimeout = &Error{Kind: KindTimeout, Code: "timeout", Message: "Request took too long"}
+
+	ErrVersionMismatch = &Error{Kind: KindPrecondition, Code: "version_mismatch", Message: "Resource was changed by another request"}
+
+	ErrMediaTooLarge = &Error{Kind: KindTooLarge, Code: "media_too_large", Message: "File must not be larger than 10 MB"}
+
+	ErrUnsupportedMedia = &Error{Kind: KindUnsupported, Code: "unsupported_media", Message: "File must be a JPEG or PNG image"}
+)
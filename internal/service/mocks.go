@@ -5,7 +5,10 @@
 package service
 
 import (
+	"context"
+
 	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/types"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,8 +41,8 @@ func (_m *MockPlaceService) EXPECT() *MockPlaceService_Expecter {
 }
 
 // Create provides a mock function for the type MockPlaceService
-func (_mock *MockPlaceService) Create(userID string, pc model.PlaceCreate) (model.Place, error) {
-	ret := _mock.Called(userID, pc)
+func (_mock *MockPlaceService) Create(ctx context.Context, userID string, pc model.PlaceCreate) (model.Place, error) {
+	ret := _mock.Called(ctx, userID, pc)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -47,16 +50,16 @@ func (_mock *MockPlaceService) Create(userID string, pc model.PlaceCreate) (mode
 
 	var r0 model.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, model.PlaceCreate) (model.Place, error)); ok {
-		return returnFunc(userID, pc)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.PlaceCreate) (model.Place, error)); ok {
+		return returnFunc(ctx, userID, pc)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, model.PlaceCreate) model.Place); ok {
-		r0 = returnFunc(userID, pc)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.PlaceCreate) model.Place); ok {
+		r0 = returnFunc(ctx, userID, pc)
 	} else {
 		r0 = ret.Get(0).(model.Place)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, model.PlaceCreate) error); ok {
-		r1 = returnFunc(userID, pc)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.PlaceCreate) error); ok {
+		r1 = returnFunc(ctx, userID, pc)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -69,15 +72,16 @@ type MockPlaceService_Create_Call struct {
 }
 
 // Create is a helper method to define mock.On call
+//   - ctx
 //   - userID
 //   - pc
-func (_e *MockPlaceService_Expecter) Create(userID interface{}, pc interface{}) *MockPlaceService_Create_Call {
-	return &MockPlaceService_Create_Call{Call: _e.mock.On("Create", userID, pc)}
+func (_e *MockPlaceService_Expecter) Create(ctx interface{}, userID interface{}, pc interface{}) *MockPlaceService_Create_Call {
+	return &MockPlaceService_Create_Call{Call: _e.mock.On("Create", ctx, userID, pc)}
 }
 
-func (_c *MockPlaceService_Create_Call) Run(run func(userID string, pc model.PlaceCreate)) *MockPlaceService_Create_Call {
+func (_c *MockPlaceService_Create_Call) Run(run func(ctx context.Context, userID string, pc model.PlaceCreate)) *MockPlaceService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(model.PlaceCreate))
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PlaceCreate))
 	})
 	return _c
 }
@@ -87,14 +91,61 @@ func (_c *MockPlaceService_Create_Call) Return(place model.Place, err error) *Mo
 	return _c
 }
 
-func (_c *MockPlaceService_Create_Call) RunAndReturn(run func(userID string, pc model.PlaceCreate) (model.Place, error)) *MockPlaceService_Create_Call {
+func (_c *MockPlaceService_Create_Call) RunAndReturn(run func(ctx context.Context, userID string, pc model.PlaceCreate) (model.Place, error)) *MockPlaceService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByID provides a mock function for the type MockPlaceService
+func (_mock *MockPlaceService) DeleteByID(ctx context.Context, placeID string, userID string) error {
+	ret := _mock.Called(ctx, placeID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, placeID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPlaceService_DeleteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByID'
+type MockPlaceService_DeleteByID_Call struct {
+	*mock.Call
+}
+
+// DeleteByID is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - userID
+func (_e *MockPlaceService_Expecter) DeleteByID(ctx interface{}, placeID interface{}, userID interface{}) *MockPlaceService_DeleteByID_Call {
+	return &MockPlaceService_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, placeID, userID)}
+}
+
+func (_c *MockPlaceService_DeleteByID_Call) Run(run func(ctx context.Context, placeID string, userID string)) *MockPlaceService_DeleteByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlaceService_DeleteByID_Call) Return(err error) *MockPlaceService_DeleteByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPlaceService_DeleteByID_Call) RunAndReturn(run func(ctx context.Context, placeID string, userID string) error) *MockPlaceService_DeleteByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByID provides a mock function for the type MockPlaceService
-func (_mock *MockPlaceService) GetByID(placeID string, userID string) (model.Place, error) {
-	ret := _mock.Called(placeID, userID)
+func (_mock *MockPlaceService) GetByID(ctx context.Context, placeID string, userID string) (model.Place, error) {
+	ret := _mock.Called(ctx, placeID, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -102,16 +153,16 @@ func (_mock *MockPlaceService) GetByID(placeID string, userID string) (model.Pla
 
 	var r0 model.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (model.Place, error)); ok {
-		return returnFunc(placeID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Place, error)); ok {
+		return returnFunc(ctx, placeID, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) model.Place); ok {
-		r0 = returnFunc(placeID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Place); ok {
+		r0 = returnFunc(ctx, placeID, userID)
 	} else {
 		r0 = ret.Get(0).(model.Place)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(placeID, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, placeID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,15 +175,16 @@ type MockPlaceService_GetByID_Call struct {
 }
 
 // GetByID is a helper method to define mock.On call
+//   - ctx
 //   - placeID
 //   - userID
-func (_e *MockPlaceService_Expecter) GetByID(placeID interface{}, userID interface{}) *MockPlaceService_GetByID_Call {
-	return &MockPlaceService_GetByID_Call{Call: _e.mock.On("GetByID", placeID, userID)}
+func (_e *MockPlaceService_Expecter) GetByID(ctx interface{}, placeID interface{}, userID interface{}) *MockPlaceService_GetByID_Call {
+	return &MockPlaceService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, placeID, userID)}
 }
 
-func (_c *MockPlaceService_GetByID_Call) Run(run func(placeID string, userID string)) *MockPlaceService_GetByID_Call {
+func (_c *MockPlaceService_GetByID_Call) Run(run func(ctx context.Context, placeID string, userID string)) *MockPlaceService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -142,37 +194,43 @@ func (_c *MockPlaceService_GetByID_Call) Return(place model.Place, err error) *M
 	return _c
 }
 
-func (_c *MockPlaceService_GetByID_Call) RunAndReturn(run func(placeID string, userID string) (model.Place, error)) *MockPlaceService_GetByID_Call {
+func (_c *MockPlaceService_GetByID_Call) RunAndReturn(run func(ctx context.Context, placeID string, userID string) (model.Place, error)) *MockPlaceService_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByNameOrAddress provides a mock function for the type MockPlaceService
-func (_mock *MockPlaceService) GetByNameOrAddress(query string, userID string) ([]model.Place, error) {
-	ret := _mock.Called(query, userID)
+func (_mock *MockPlaceService) GetByNameOrAddress(ctx context.Context, query string, sort types.PlaceSort, userID string, page model.Page) ([]model.Place, model.PageInfo, error) {
+	ret := _mock.Called(ctx, query, sort, userID, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByNameOrAddress")
 	}
 
 	var r0 []model.Place
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) ([]model.Place, error)); ok {
-		return returnFunc(query, userID)
+	var r1 model.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, types.PlaceSort, string, model.Page) ([]model.Place, model.PageInfo, error)); ok {
+		return returnFunc(ctx, query, sort, userID, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) []model.Place); ok {
-		r0 = returnFunc(query, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, types.PlaceSort, string, model.Page) []model.Place); ok {
+		r0 = returnFunc(ctx, query, sort, userID, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Place)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(query, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, types.PlaceSort, string, model.Page) model.PageInfo); ok {
+		r1 = returnFunc(ctx, query, sort, userID, page)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(model.PageInfo)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, types.PlaceSort, string, model.Page) error); ok {
+		r2 = returnFunc(ctx, query, sort, userID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockPlaceService_GetByNameOrAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNameOrAddress'
@@ -181,511 +239,581 @@ type MockPlaceService_GetByNameOrAddress_Call struct {
 }
 
 // GetByNameOrAddress is a helper method to define mock.On call
+//   - ctx
 //   - query
+//   - sort
 //   - userID
-func (_e *MockPlaceService_Expecter) GetByNameOrAddress(query interface{}, userID interface{}) *MockPlaceService_GetByNameOrAddress_Call {
-	return &MockPlaceService_GetByNameOrAddress_Call{Call: _e.mock.On("GetByNameOrAddress", query, userID)}
+//   - page
+func (_e *MockPlaceService_Expecter) GetByNameOrAddress(ctx interface{}, query interface{}, sort interface{}, userID interface{}, page interface{}) *MockPlaceService_GetByNameOrAddress_Call {
+	return &MockPlaceService_GetByNameOrAddress_Call{Call: _e.mock.On("GetByNameOrAddress", ctx, query, sort, userID, page)}
 }
 
-func (_c *MockPlaceService_GetByNameOrAddress_Call) Run(run func(query string, userID string)) *MockPlaceService_GetByNameOrAddress_Call {
+func (_c *MockPlaceService_GetByNameOrAddress_Call) Run(run func(ctx context.Context, query string, sort types.PlaceSort, userID string, page model.Page)) *MockPlaceService_GetByNameOrAddress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(types.PlaceSort), args[3].(string), args[4].(model.Page))
 	})
 	return _c
 }
 
-func (_c *MockPlaceService_GetByNameOrAddress_Call) Return(places []model.Place, err error) *MockPlaceService_GetByNameOrAddress_Call {
-	_c.Call.Return(places, err)
+func (_c *MockPlaceService_GetByNameOrAddress_Call) Return(places []model.Place, pageInfo model.PageInfo, err error) *MockPlaceService_GetByNameOrAddress_Call {
+	_c.Call.Return(places, pageInfo, err)
 	return _c
 }
 
-func (_c *MockPlaceService_GetByNameOrAddress_Call) RunAndReturn(run func(query string, userID string) ([]model.Place, error)) *MockPlaceService_GetByNameOrAddress_Call {
+func (_c *MockPlaceService_GetByNameOrAddress_Call) RunAndReturn(run func(ctx context.Context, query string, sort types.PlaceSort, userID string, page model.Page) ([]model.Place, model.PageInfo, error)) *MockPlaceService_GetByNameOrAddress_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateByID provides a mock function for the type MockPlaceService
-func (_mock *MockPlaceService) UpdateByID(placeID string, userID string, pu model.PlaceUpdate) (model.Place, error) {
-	ret := _mock.Called(placeID, userID, pu)
+// GetDuplicates provides a mock function for the type MockPlaceService
+func (_mock *MockPlaceService) GetDuplicates(ctx context.Context, pc model.PlaceCreate, userID string) ([]model.Place, error) {
+	ret := _mock.Called(ctx, pc, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateByID")
+		panic("no return value specified for GetDuplicates")
 	}
 
-	var r0 model.Place
+	var r0 []model.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string, model.PlaceUpdate) (model.Place, error)); ok {
-		return returnFunc(placeID, userID, pu)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PlaceCreate, string) ([]model.Place, error)); ok {
+		return returnFunc(ctx, pc, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string, model.PlaceUpdate) model.Place); ok {
-		r0 = returnFunc(placeID, userID, pu)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PlaceCreate, string) []model.Place); ok {
+		r0 = returnFunc(ctx, pc, userID)
 	} else {
-		r0 = ret.Get(0).(model.Place)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Place)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string, model.PlaceUpdate) error); ok {
-		r1 = returnFunc(placeID, userID, pu)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.PlaceCreate, string) error); ok {
+		r1 = returnFunc(ctx, pc, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPlaceService_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
-type MockPlaceService_UpdateByID_Call struct {
+// MockPlaceService_GetDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuplicates'
+type MockPlaceService_GetDuplicates_Call struct {
 	*mock.Call
 }
 
-// UpdateByID is a helper method to define mock.On call
-//   - placeID
+// GetDuplicates is a helper method to define mock.On call
+//   - ctx
+//   - pc
 //   - userID
-//   - pu
-func (_e *MockPlaceService_Expecter) UpdateByID(placeID interface{}, userID interface{}, pu interface{}) *MockPlaceService_UpdateByID_Call {
-	return &MockPlaceService_UpdateByID_Call{Call: _e.mock.On("UpdateByID", placeID, userID, pu)}
+func (_e *MockPlaceService_Expecter) GetDuplicates(ctx interface{}, pc interface{}, userID interface{}) *MockPlaceService_GetDuplicates_Call {
+	return &MockPlaceService_GetDuplicates_Call{Call: _e.mock.On("GetDuplicates", ctx, pc, userID)}
 }
 
-func (_c *MockPlaceService_UpdateByID_Call) Run(run func(placeID string, userID string, pu model.PlaceUpdate)) *MockPlaceService_UpdateByID_Call {
+func (_c *MockPlaceService_GetDuplicates_Call) Run(run func(ctx context.Context, pc model.PlaceCreate, userID string)) *MockPlaceService_GetDuplicates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(model.PlaceUpdate))
+		run(args[0].(context.Context), args[1].(model.PlaceCreate), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockPlaceService_UpdateByID_Call) Return(place model.Place, err error) *MockPlaceService_UpdateByID_Call {
-	_c.Call.Return(place, err)
+func (_c *MockPlaceService_GetDuplicates_Call) Return(places []model.Place, err error) *MockPlaceService_GetDuplicates_Call {
+	_c.Call.Return(places, err)
 	return _c
 }
 
-func (_c *MockPlaceService_UpdateByID_Call) RunAndReturn(run func(placeID string, userID string, pu model.PlaceUpdate) (model.Place, error)) *MockPlaceService_UpdateByID_Call {
+func (_c *MockPlaceService_GetDuplicates_Call) RunAndReturn(run func(ctx context.Context, pc model.PlaceCreate, userID string) ([]model.Place, error)) *MockPlaceService_GetDuplicates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPackService creates a new instance of MockPackService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPackService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPackService {
-	mock := &MockPackService{}
-	mock.Mock.Test(t)
+// GetNearby provides a mock function for the type MockPlaceService
+func (_mock *MockPlaceService) GetNearby(ctx context.Context, lat float64, lng float64, radius float64, userID string) ([]model.Place, error) {
+	ret := _mock.Called(ctx, lat, lng, radius, userID)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for GetNearby")
+	}
 
-	return mock
+	var r0 []model.Place
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, float64, float64, float64, string) ([]model.Place, error)); ok {
+		return returnFunc(ctx, lat, lng, radius, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, float64, float64, float64, string) []model.Place); ok {
+		r0 = returnFunc(ctx, lat, lng, radius, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Place)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, float64, float64, float64, string) error); ok {
+		r1 = returnFunc(ctx, lat, lng, radius, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPackService is an autogenerated mock type for the PackService type
-type MockPackService struct {
-	mock.Mock
+// MockPlaceService_GetNearby_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNearby'
+type MockPlaceService_GetNearby_Call struct {
+	*mock.Call
 }
 
-type MockPackService_Expecter struct {
-	mock *mock.Mock
+// GetNearby is a helper method to define mock.On call
+//   - ctx
+//   - lat
+//   - lng
+//   - radius
+//   - userID
+func (_e *MockPlaceService_Expecter) GetNearby(ctx interface{}, lat interface{}, lng interface{}, radius interface{}, userID interface{}) *MockPlaceService_GetNearby_Call {
+	return &MockPlaceService_GetNearby_Call{Call: _e.mock.On("GetNearby", ctx, lat, lng, radius, userID)}
 }
 
-func (_m *MockPackService) EXPECT() *MockPackService_Expecter {
-	return &MockPackService_Expecter{mock: &_m.Mock}
+func (_c *MockPlaceService_GetNearby_Call) Run(run func(ctx context.Context, lat float64, lng float64, radius float64, userID string)) *MockPlaceService_GetNearby_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(string))
+	})
+	return _c
 }
 
-// Create provides a mock function for the type MockPackService
-func (_mock *MockPackService) Create(userID string, pc model.PackCreate) (model.Pack, error) {
-	ret := _mock.Called(userID, pc)
+func (_c *MockPlaceService_GetNearby_Call) Return(places []model.Place, err error) *MockPlaceService_GetNearby_Call {
+	_c.Call.Return(places, err)
+	return _c
+}
+
+func (_c *MockPlaceService_GetNearby_Call) RunAndReturn(run func(ctx context.Context, lat float64, lng float64, radius float64, userID string) ([]model.Place, error)) *MockPlaceService_GetNearby_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeByID provides a mock function for the type MockPlaceService
+func (_mock *MockPlaceService) MergeByID(ctx context.Context, placeID string, userID string, pm model.PlaceMerge) (model.Place, error) {
+	ret := _mock.Called(ctx, placeID, userID, pm)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for MergeByID")
 	}
 
-	var r0 model.Pack
+	var r0 model.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, model.PackCreate) (model.Pack, error)); ok {
-		return returnFunc(userID, pc)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PlaceMerge) (model.Place, error)); ok {
+		return returnFunc(ctx, placeID, userID, pm)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, model.PackCreate) model.Pack); ok {
-		r0 = returnFunc(userID, pc)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PlaceMerge) model.Place); ok {
+		r0 = returnFunc(ctx, placeID, userID, pm)
 	} else {
-		r0 = ret.Get(0).(model.Pack)
+		r0 = ret.Get(0).(model.Place)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, model.PackCreate) error); ok {
-		r1 = returnFunc(userID, pc)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.PlaceMerge) error); ok {
+		r1 = returnFunc(ctx, placeID, userID, pm)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPackService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockPackService_Create_Call struct {
+// MockPlaceService_MergeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeByID'
+type MockPlaceService_MergeByID_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// MergeByID is a helper method to define mock.On call
+//   - ctx
+//   - placeID
 //   - userID
-//   - pc
-func (_e *MockPackService_Expecter) Create(userID interface{}, pc interface{}) *MockPackService_Create_Call {
-	return &MockPackService_Create_Call{Call: _e.mock.On("Create", userID, pc)}
+//   - pm
+func (_e *MockPlaceService_Expecter) MergeByID(ctx interface{}, placeID interface{}, userID interface{}, pm interface{}) *MockPlaceService_MergeByID_Call {
+	return &MockPlaceService_MergeByID_Call{Call: _e.mock.On("MergeByID", ctx, placeID, userID, pm)}
 }
 
-func (_c *MockPackService_Create_Call) Run(run func(userID string, pc model.PackCreate)) *MockPackService_Create_Call {
+func (_c *MockPlaceService_MergeByID_Call) Run(run func(ctx context.Context, placeID string, userID string, pm model.PlaceMerge)) *MockPlaceService_MergeByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(model.PackCreate))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PlaceMerge))
 	})
 	return _c
 }
 
-func (_c *MockPackService_Create_Call) Return(pack model.Pack, err error) *MockPackService_Create_Call {
-	_c.Call.Return(pack, err)
+func (_c *MockPlaceService_MergeByID_Call) Return(place model.Place, err error) *MockPlaceService_MergeByID_Call {
+	_c.Call.Return(place, err)
 	return _c
 }
 
-func (_c *MockPackService_Create_Call) RunAndReturn(run func(userID string, pc model.PackCreate) (model.Pack, error)) *MockPackService_Create_Call {
+func (_c *MockPlaceService_MergeByID_Call) RunAndReturn(run func(ctx context.Context, placeID string, userID string, pm model.PlaceMerge) (model.Place, error)) *MockPlaceService_MergeByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockPackService
-func (_mock *MockPackService) GetByID(packID string, userID string) (model.Pack, error) {
-	ret := _mock.Called(packID, userID)
+// RestoreByID provides a mock function for the type MockPlaceService
+func (_mock *MockPlaceService) RestoreByID(ctx context.Context, placeID string, userID string) (model.Place, error) {
+	ret := _mock.Called(ctx, placeID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for RestoreByID")
 	}
 
-	var r0 model.Pack
+	var r0 model.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (model.Pack, error)); ok {
-		return returnFunc(packID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Place, error)); ok {
+		return returnFunc(ctx, placeID, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) model.Pack); ok {
-		r0 = returnFunc(packID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Place); ok {
+		r0 = returnFunc(ctx, placeID, userID)
 	} else {
-		r0 = ret.Get(0).(model.Pack)
+		r0 = ret.Get(0).(model.Place)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(packID, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, placeID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPackService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockPackService_GetByID_Call struct {
+// MockPlaceService_RestoreByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreByID'
+type MockPlaceService_RestoreByID_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
-//   - packID
+// RestoreByID is a helper method to define mock.On call
+//   - ctx
+//   - placeID
 //   - userID
-func (_e *MockPackService_Expecter) GetByID(packID interface{}, userID interface{}) *MockPackService_GetByID_Call {
-	return &MockPackService_GetByID_Call{Call: _e.mock.On("GetByID", packID, userID)}
+func (_e *MockPlaceService_Expecter) RestoreByID(ctx interface{}, placeID interface{}, userID interface{}) *MockPlaceService_RestoreByID_Call {
+	return &MockPlaceService_RestoreByID_Call{Call: _e.mock.On("RestoreByID", ctx, placeID, userID)}
 }
 
-func (_c *MockPackService_GetByID_Call) Run(run func(packID string, userID string)) *MockPackService_GetByID_Call {
+func (_c *MockPlaceService_RestoreByID_Call) Run(run func(ctx context.Context, placeID string, userID string)) *MockPlaceService_RestoreByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockPackService_GetByID_Call) Return(pack model.Pack, err error) *MockPackService_GetByID_Call {
-	_c.Call.Return(pack, err)
+func (_c *MockPlaceService_RestoreByID_Call) Return(place model.Place, err error) *MockPlaceService_RestoreByID_Call {
+	_c.Call.Return(place, err)
 	return _c
 }
 
-func (_c *MockPackService_GetByID_Call) RunAndReturn(run func(packID string, userID string) (model.Pack, error)) *MockPackService_GetByID_Call {
+func (_c *MockPlaceService_RestoreByID_Call) RunAndReturn(run func(ctx context.Context, placeID string, userID string) (model.Place, error)) *MockPlaceService_RestoreByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByNameOrAuthor provides a mock function for the type MockPackService
-func (_mock *MockPackService) GetByNameOrAuthor(query string, userID string) ([]model.Pack, error) {
-	ret := _mock.Called(query, userID)
+// UpdateByID provides a mock function for the type MockPlaceService
+func (_mock *MockPlaceService) UpdateByID(ctx context.Context, placeID string, userID string, pu model.PlaceUpdate) (model.Place, error) {
+	ret := _mock.Called(ctx, placeID, userID, pu)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByNameOrAuthor")
+		panic("no return value specified for UpdateByID")
 	}
 
-	var r0 []model.Pack
+	var r0 model.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) ([]model.Pack, error)); ok {
-		return returnFunc(query, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PlaceUpdate) (model.Place, error)); ok {
+		return returnFunc(ctx, placeID, userID, pu)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) []model.Pack); ok {
-		r0 = returnFunc(query, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PlaceUpdate) model.Place); ok {
+		r0 = returnFunc(ctx, placeID, userID, pu)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Pack)
-		}
+		r0 = ret.Get(0).(model.Place)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(query, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.PlaceUpdate) error); ok {
+		r1 = returnFunc(ctx, placeID, userID, pu)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPackService_GetByNameOrAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNameOrAuthor'
-type MockPackService_GetByNameOrAuthor_Call struct {
+// MockPlaceService_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
+type MockPlaceService_UpdateByID_Call struct {
 	*mock.Call
 }
 
-// GetByNameOrAuthor is a helper method to define mock.On call
-//   - query
+// UpdateByID is a helper method to define mock.On call
+//   - ctx
+//   - placeID
 //   - userID
-func (_e *MockPackService_Expecter) GetByNameOrAuthor(query interface{}, userID interface{}) *MockPackService_GetByNameOrAuthor_Call {
-	return &MockPackService_GetByNameOrAuthor_Call{Call: _e.mock.On("GetByNameOrAuthor", query, userID)}
+//   - pu
+func (_e *MockPlaceService_Expecter) UpdateByID(ctx interface{}, placeID interface{}, userID interface{}, pu interface{}) *MockPlaceService_UpdateByID_Call {
+	return &MockPlaceService_UpdateByID_Call{Call: _e.mock.On("UpdateByID", ctx, placeID, userID, pu)}
 }
 
-func (_c *MockPackService_GetByNameOrAuthor_Call) Run(run func(query string, userID string)) *MockPackService_GetByNameOrAuthor_Call {
+func (_c *MockPlaceService_UpdateByID_Call) Run(run func(ctx context.Context, placeID string, userID string, pu model.PlaceUpdate)) *MockPlaceService_UpdateByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PlaceUpdate))
 	})
 	return _c
 }
 
-func (_c *MockPackService_GetByNameOrAuthor_Call) Return(packs []model.Pack, err error) *MockPackService_GetByNameOrAuthor_Call {
-	_c.Call.Return(packs, err)
+func (_c *MockPlaceService_UpdateByID_Call) Return(place model.Place, err error) *MockPlaceService_UpdateByID_Call {
+	_c.Call.Return(place, err)
 	return _c
 }
 
-func (_c *MockPackService_GetByNameOrAuthor_Call) RunAndReturn(run func(query string, userID string) ([]model.Pack, error)) *MockPackService_GetByNameOrAuthor_Call {
+func (_c *MockPlaceService_UpdateByID_Call) RunAndReturn(run func(ctx context.Context, placeID string, userID string, pu model.PlaceUpdate) (model.Place, error)) *MockPlaceService_UpdateByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCreatedByUserID provides a mock function for the type MockPackService
-func (_mock *MockPackService) GetCreatedByUserID(userID string) ([]model.Pack, error) {
-	ret := _mock.Called(userID)
+// NewMockReviewService creates a new instance of MockReviewService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReviewService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReviewService {
+	mock := &MockReviewService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReviewService is an autogenerated mock type for the ReviewService type
+type MockReviewService struct {
+	mock.Mock
+}
+
+type MockReviewService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReviewService) EXPECT() *MockReviewService_Expecter {
+	return &MockReviewService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockReviewService
+func (_mock *MockReviewService) Create(ctx context.Context, placeID string, userID string, rc model.ReviewCreate) (model.Review, error) {
+	ret := _mock.Called(ctx, placeID, userID, rc)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCreatedByUserID")
+		panic("no return value specified for Create")
 	}
 
-	var r0 []model.Pack
+	var r0 model.Review
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) ([]model.Pack, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.ReviewCreate) (model.Review, error)); ok {
+		return returnFunc(ctx, placeID, userID, rc)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) []model.Pack); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.ReviewCreate) model.Review); ok {
+		r0 = returnFunc(ctx, placeID, userID, rc)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Pack)
-		}
+		r0 = ret.Get(0).(model.Review)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.ReviewCreate) error); ok {
+		r1 = returnFunc(ctx, placeID, userID, rc)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPackService_GetCreatedByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreatedByUserID'
-type MockPackService_GetCreatedByUserID_Call struct {
+// MockReviewService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReviewService_Create_Call struct {
 	*mock.Call
 }
 
-// GetCreatedByUserID is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - placeID
 //   - userID
-func (_e *MockPackService_Expecter) GetCreatedByUserID(userID interface{}) *MockPackService_GetCreatedByUserID_Call {
-	return &MockPackService_GetCreatedByUserID_Call{Call: _e.mock.On("GetCreatedByUserID", userID)}
+//   - rc
+func (_e *MockReviewService_Expecter) Create(ctx interface{}, placeID interface{}, userID interface{}, rc interface{}) *MockReviewService_Create_Call {
+	return &MockReviewService_Create_Call{Call: _e.mock.On("Create", ctx, placeID, userID, rc)}
 }
 
-func (_c *MockPackService_GetCreatedByUserID_Call) Run(run func(userID string)) *MockPackService_GetCreatedByUserID_Call {
+func (_c *MockReviewService_Create_Call) Run(run func(ctx context.Context, placeID string, userID string, rc model.ReviewCreate)) *MockReviewService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.ReviewCreate))
 	})
 	return _c
 }
 
-func (_c *MockPackService_GetCreatedByUserID_Call) Return(packs []model.Pack, err error) *MockPackService_GetCreatedByUserID_Call {
-	_c.Call.Return(packs, err)
+func (_c *MockReviewService_Create_Call) Return(review model.Review, err error) *MockReviewService_Create_Call {
+	_c.Call.Return(review, err)
 	return _c
 }
 
-func (_c *MockPackService_GetCreatedByUserID_Call) RunAndReturn(run func(userID string) ([]model.Pack, error)) *MockPackService_GetCreatedByUserID_Call {
+func (_c *MockReviewService_Create_Call) RunAndReturn(run func(ctx context.Context, placeID string, userID string, rc model.ReviewCreate) (model.Review, error)) *MockReviewService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowedByUserID provides a mock function for the type MockPackService
-func (_mock *MockPackService) GetFollowedByUserID(userID string) ([]model.Pack, error) {
-	ret := _mock.Called(userID)
+// DeleteByID provides a mock function for the type MockReviewService
+func (_mock *MockReviewService) DeleteByID(ctx context.Context, placeID string, reviewID string, userID string) error {
+	ret := _mock.Called(ctx, placeID, reviewID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowedByUserID")
+		panic("no return value specified for DeleteByID")
 	}
 
-	var r0 []model.Pack
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) ([]model.Pack, error)); ok {
-		return returnFunc(userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) []model.Pack); ok {
-		r0 = returnFunc(userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Pack)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, placeID, reviewID, userID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockPackService_GetFollowedByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowedByUserID'
-type MockPackService_GetFollowedByUserID_Call struct {
+// MockReviewService_DeleteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByID'
+type MockReviewService_DeleteByID_Call struct {
 	*mock.Call
 }
 
-// GetFollowedByUserID is a helper method to define mock.On call
+// DeleteByID is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - reviewID
 //   - userID
-func (_e *MockPackService_Expecter) GetFollowedByUserID(userID interface{}) *MockPackService_GetFollowedByUserID_Call {
-	return &MockPackService_GetFollowedByUserID_Call{Call: _e.mock.On("GetFollowedByUserID", userID)}
+func (_e *MockReviewService_Expecter) DeleteByID(ctx interface{}, placeID interface{}, reviewID interface{}, userID interface{}) *MockReviewService_DeleteByID_Call {
+	return &MockReviewService_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, placeID, reviewID, userID)}
 }
 
-func (_c *MockPackService_GetFollowedByUserID_Call) Run(run func(userID string)) *MockPackService_GetFollowedByUserID_Call {
+func (_c *MockReviewService_DeleteByID_Call) Run(run func(ctx context.Context, placeID string, reviewID string, userID string)) *MockReviewService_DeleteByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockPackService_GetFollowedByUserID_Call) Return(packs []model.Pack, err error) *MockPackService_GetFollowedByUserID_Call {
-	_c.Call.Return(packs, err)
+func (_c *MockReviewService_DeleteByID_Call) Return(err error) *MockReviewService_DeleteByID_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPackService_GetFollowedByUserID_Call) RunAndReturn(run func(userID string) ([]model.Pack, error)) *MockPackService_GetFollowedByUserID_Call {
+func (_c *MockReviewService_DeleteByID_Call) RunAndReturn(run func(ctx context.Context, placeID string, reviewID string, userID string) error) *MockReviewService_DeleteByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPlacesByID provides a mock function for the type MockPackService
-func (_mock *MockPackService) GetPlacesByID(packID string, userID string) ([]model.Place, error) {
-	ret := _mock.Called(packID, userID)
+// GetByPlaceID provides a mock function for the type MockReviewService
+func (_mock *MockReviewService) GetByPlaceID(ctx context.Context, placeID string, page model.Page) ([]model.Review, model.PageInfo, error) {
+	ret := _mock.Called(ctx, placeID, page)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPlacesByID")
+		panic("no return value specified for GetByPlaceID")
 	}
 
-	var r0 []model.Place
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) ([]model.Place, error)); ok {
-		return returnFunc(packID, userID)
+	var r0 []model.Review
+	var r1 model.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Page) ([]model.Review, model.PageInfo, error)); ok {
+		return returnFunc(ctx, placeID, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) []model.Place); ok {
-		r0 = returnFunc(packID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Page) []model.Review); ok {
+		r0 = returnFunc(ctx, placeID, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Place)
+			r0 = ret.Get(0).([]model.Review)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(packID, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.Page) model.PageInfo); ok {
+		r1 = returnFunc(ctx, placeID, page)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(model.PageInfo)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, model.Page) error); ok {
+		r2 = returnFunc(ctx, placeID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockPackService_GetPlacesByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlacesByID'
-type MockPackService_GetPlacesByID_Call struct {
+// MockReviewService_GetByPlaceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPlaceID'
+type MockReviewService_GetByPlaceID_Call struct {
 	*mock.Call
 }
 
-// GetPlacesByID is a helper method to define mock.On call
-//   - packID
-//   - userID
-func (_e *MockPackService_Expecter) GetPlacesByID(packID interface{}, userID interface{}) *MockPackService_GetPlacesByID_Call {
-	return &MockPackService_GetPlacesByID_Call{Call: _e.mock.On("GetPlacesByID", packID, userID)}
+// GetByPlaceID is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - page
+func (_e *MockReviewService_Expecter) GetByPlaceID(ctx interface{}, placeID interface{}, page interface{}) *MockReviewService_GetByPlaceID_Call {
+	return &MockReviewService_GetByPlaceID_Call{Call: _e.mock.On("GetByPlaceID", ctx, placeID, page)}
 }
 
-func (_c *MockPackService_GetPlacesByID_Call) Run(run func(packID string, userID string)) *MockPackService_GetPlacesByID_Call {
+func (_c *MockReviewService_GetByPlaceID_Call) Run(run func(ctx context.Context, placeID string, page model.Page)) *MockReviewService_GetByPlaceID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(model.Page))
 	})
 	return _c
 }
 
-func (_c *MockPackService_GetPlacesByID_Call) Return(places []model.Place, err error) *MockPackService_GetPlacesByID_Call {
-	_c.Call.Return(places, err)
+func (_c *MockReviewService_GetByPlaceID_Call) Return(reviews []model.Review, pageInfo model.PageInfo, err error) *MockReviewService_GetByPlaceID_Call {
+	_c.Call.Return(reviews, pageInfo, err)
 	return _c
 }
 
-func (_c *MockPackService_GetPlacesByID_Call) RunAndReturn(run func(packID string, userID string) ([]model.Place, error)) *MockPackService_GetPlacesByID_Call {
+func (_c *MockReviewService_GetByPlaceID_Call) RunAndReturn(run func(ctx context.Context, placeID string, page model.Page) ([]model.Review, model.PageInfo, error)) *MockReviewService_GetByPlaceID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateByID provides a mock function for the type MockPackService
-func (_mock *MockPackService) UpdateByID(packID string, userID string, pu model.PackUpdate) (model.Pack, error) {
-	ret := _mock.Called(packID, userID, pu)
+// UpdateByID provides a mock function for the type MockReviewService
+func (_mock *MockReviewService) UpdateByID(ctx context.Context, placeID string, reviewID string, userID string, ru model.ReviewUpdate) (model.Review, error) {
+	ret := _mock.Called(ctx, placeID, reviewID, userID, ru)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateByID")
 	}
 
-	var r0 model.Pack
+	var r0 model.Review
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string, model.PackUpdate) (model.Pack, error)); ok {
-		return returnFunc(packID, userID, pu)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, model.ReviewUpdate) (model.Review, error)); ok {
+		return returnFunc(ctx, placeID, reviewID, userID, ru)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string, model.PackUpdate) model.Pack); ok {
-		r0 = returnFunc(packID, userID, pu)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, model.ReviewUpdate) model.Review); ok {
+		r0 = returnFunc(ctx, placeID, reviewID, userID, ru)
 	} else {
-		r0 = ret.Get(0).(model.Pack)
+		r0 = ret.Get(0).(model.Review)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string, model.PackUpdate) error); ok {
-		r1 = returnFunc(packID, userID, pu)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, model.ReviewUpdate) error); ok {
+		r1 = returnFunc(ctx, placeID, reviewID, userID, ru)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPackService_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
-type MockPackService_UpdateByID_Call struct {
+// MockReviewService_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
+type MockReviewService_UpdateByID_Call struct {
 	*mock.Call
 }
 
 // UpdateByID is a helper method to define mock.On call
-//   - packID
+//   - ctx
+//   - placeID
+//   - reviewID
 //   - userID
-//   - pu
-func (_e *MockPackService_Expecter) UpdateByID(packID interface{}, userID interface{}, pu interface{}) *MockPackService_UpdateByID_Call {
-	return &MockPackService_UpdateByID_Call{Call: _e.mock.On("UpdateByID", packID, userID, pu)}
+//   - ru
+func (_e *MockReviewService_Expecter) UpdateByID(ctx interface{}, placeID interface{}, reviewID interface{}, userID interface{}, ru interface{}) *MockReviewService_UpdateByID_Call {
+	return &MockReviewService_UpdateByID_Call{Call: _e.mock.On("UpdateByID", ctx, placeID, reviewID, userID, ru)}
 }
 
-func (_c *MockPackService_UpdateByID_Call) Run(run func(packID string, userID string, pu model.PackUpdate)) *MockPackService_UpdateByID_Call {
+func (_c *MockReviewService_UpdateByID_Call) Run(run func(ctx context.Context, placeID string, reviewID string, userID string, ru model.ReviewUpdate)) *MockReviewService_UpdateByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(model.PackUpdate))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(model.ReviewUpdate))
 	})
 	return _c
 }
 
-func (_c *MockPackService_UpdateByID_Call) Return(pack model.Pack, err error) *MockPackService_UpdateByID_Call {
-	_c.Call.Return(pack, err)
+func (_c *MockReviewService_UpdateByID_Call) Return(review model.Review, err error) *MockReviewService_UpdateByID_Call {
+	_c.Call.Return(review, err)
 	return _c
 }
 
-func (_c *MockPackService_UpdateByID_Call) RunAndReturn(run func(packID string, userID string, pu model.PackUpdate) (model.Pack, error)) *MockPackService_UpdateByID_Call {
+func (_c *MockReviewService_UpdateByID_Call) RunAndReturn(run func(ctx context.Context, placeID string, reviewID string, userID string, ru model.ReviewUpdate) (model.Review, error)) *MockReviewService_UpdateByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserService creates a new instance of MockUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockVisitService creates a new instance of MockVisitService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockUserService(t interface {
+func NewMockVisitService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockUserService {
-	mock := &MockUserService{}
+}) *MockVisitService {
+	mock := &MockVisitService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -693,69 +821,2425 @@ func NewMockUserService(t interface {
 	return mock
 }
 
-// MockUserService is an autogenerated mock type for the UserService type
-type MockUserService struct {
+// MockVisitService is an autogenerated mock type for the VisitService type
+type MockVisitService struct {
 	mock.Mock
 }
 
-type MockUserService_Expecter struct {
+type MockVisitService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
-	return &MockUserService_Expecter{mock: &_m.Mock}
+func (_m *MockVisitService) EXPECT() *MockVisitService_Expecter {
+	return &MockVisitService_Expecter{mock: &_m.Mock}
 }
 
-// GetByID provides a mock function for the type MockUserService
-func (_mock *MockUserService) GetByID(id string) (model.User, error) {
-	ret := _mock.Called(id)
+// Create provides a mock function for the type MockVisitService
+func (_mock *MockVisitService) Create(ctx context.Context, placeID string, userID string, vc model.VisitCreate) (model.Visit, error) {
+	ret := _mock.Called(ctx, placeID, userID, vc)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for Create")
 	}
 
-	var r0 model.User
+	var r0 model.Visit
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (model.User, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.VisitCreate) (model.Visit, error)); ok {
+		return returnFunc(ctx, placeID, userID, vc)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) model.User); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.VisitCreate) model.Visit); ok {
+		r0 = returnFunc(ctx, placeID, userID, vc)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		r0 = ret.Get(0).(model.Visit)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.VisitCreate) error); ok {
+		r1 = returnFunc(ctx, placeID, userID, vc)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockUserService_GetByID_Call struct {
+// MockVisitService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockVisitService_Create_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
-//   - id
-func (_e *MockUserService_Expecter) GetByID(id interface{}) *MockUserService_GetByID_Call {
-	return &MockUserService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - userID
+//   - vc
+func (_e *MockVisitService_Expecter) Create(ctx interface{}, placeID interface{}, userID interface{}, vc interface{}) *MockVisitService_Create_Call {
+	return &MockVisitService_Create_Call{Call: _e.mock.On("Create", ctx, placeID, userID, vc)}
 }
 
-func (_c *MockUserService_GetByID_Call) Run(run func(id string)) *MockUserService_GetByID_Call {
+func (_c *MockVisitService_Create_Call) Run(run func(ctx context.Context, placeID string, userID string, vc model.VisitCreate)) *MockVisitService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.VisitCreate))
 	})
 	return _c
 }
 
-func (_c *MockUserService_GetByID_Call) Return(user model.User, err error) *MockUserService_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockVisitService_Create_Call) Return(visit model.Visit, err error) *MockVisitService_Create_Call {
+	_c.Call.Return(visit, err)
+	return _c
+}
+
+func (_c *MockVisitService_Create_Call) RunAndReturn(run func(ctx context.Context, placeID string, userID string, vc model.VisitCreate) (model.Visit, error)) *MockVisitService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByID provides a mock function for the type MockVisitService
+func (_mock *MockVisitService) DeleteByID(ctx context.Context, visitID string, userID string) error {
+	ret := _mock.Called(ctx, visitID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, visitID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVisitService_DeleteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByID'
+type MockVisitService_DeleteByID_Call struct {
+	*mock.Call
+}
+
+// DeleteByID is a helper method to define mock.On call
+//   - ctx
+//   - visitID
+//   - userID
+func (_e *MockVisitService_Expecter) DeleteByID(ctx interface{}, visitID interface{}, userID interface{}) *MockVisitService_DeleteByID_Call {
+	return &MockVisitService_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, visitID, userID)}
+}
+
+func (_c *MockVisitService_DeleteByID_Call) Run(run func(ctx context.Context, visitID string, userID string)) *MockVisitService_DeleteByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockVisitService_DeleteByID_Call) Return(err error) *MockVisitService_DeleteByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVisitService_DeleteByID_Call) RunAndReturn(run func(ctx context.Context, visitID string, userID string) error) *MockVisitService_DeleteByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function for the type MockVisitService
+func (_mock *MockVisitService) GetByUserID(ctx context.Context, userID string, vf model.VisitFilter, page model.Page) ([]model.Visit, model.PageInfo, error) {
+	ret := _mock.Called(ctx, userID, vf, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Visit
+	var r1 model.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.VisitFilter, model.Page) ([]model.Visit, model.PageInfo, error)); ok {
+		return returnFunc(ctx, userID, vf, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.VisitFilter, model.Page) []model.Visit); ok {
+		r0 = returnFunc(ctx, userID, vf, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Visit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.VisitFilter, model.Page) model.PageInfo); ok {
+		r1 = returnFunc(ctx, userID, vf, page)
+	} else {
+		r1 = ret.Get(1).(model.PageInfo)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, model.VisitFilter, model.Page) error); ok {
+		r2 = returnFunc(ctx, userID, vf, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockVisitService_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockVisitService_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - vf
+//   - page
+func (_e *MockVisitService_Expecter) GetByUserID(ctx interface{}, userID interface{}, vf interface{}, page interface{}) *MockVisitService_GetByUserID_Call {
+	return &MockVisitService_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userID, vf, page)}
+}
+
+func (_c *MockVisitService_GetByUserID_Call) Run(run func(ctx context.Context, userID string, vf model.VisitFilter, page model.Page)) *MockVisitService_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.VisitFilter), args[3].(model.Page))
+	})
+	return _c
+}
+
+func (_c *MockVisitService_GetByUserID_Call) Return(visits []model.Visit, pageInfo model.PageInfo, err error) *MockVisitService_GetByUserID_Call {
+	_c.Call.Return(visits, pageInfo, err)
+	return _c
+}
+
+func (_c *MockVisitService_GetByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string, vf model.VisitFilter, page model.Page) ([]model.Visit, model.PageInfo, error)) *MockVisitService_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPackService creates a new instance of MockPackService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPackService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPackService {
+	mock := &MockPackService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPackService is an autogenerated mock type for the PackService type
+type MockPackService struct {
+	mock.Mock
+}
+
+type MockPackService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPackService) EXPECT() *MockPackService_Expecter {
+	return &MockPackService_Expecter{mock: &_m.Mock}
+}
+
+// AcceptInvitation provides a mock function for the type MockPackService
+func (_mock *MockPackService) AcceptInvitation(ctx context.Context, packID string, userID string) (model.Pack, error) {
+	ret := _mock.Called(ctx, packID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptInvitation")
+	}
+
+	var r0 model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Pack, error)); ok {
+		return returnFunc(ctx, packID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Pack); ok {
+		r0 = returnFunc(ctx, packID, userID)
+	} else {
+		r0 = ret.Get(0).(model.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, packID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_AcceptInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptInvitation'
+type MockPackService_AcceptInvitation_Call struct {
+	*mock.Call
+}
+
+// AcceptInvitation is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+func (_e *MockPackService_Expecter) AcceptInvitation(ctx interface{}, packID interface{}, userID interface{}) *MockPackService_AcceptInvitation_Call {
+	return &MockPackService_AcceptInvitation_Call{Call: _e.mock.On("AcceptInvitation", ctx, packID, userID)}
+}
+
+func (_c *MockPackService_AcceptInvitation_Call) Run(run func(ctx context.Context, packID string, userID string)) *MockPackService_AcceptInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_AcceptInvitation_Call) Return(pack model.Pack, err error) *MockPackService_AcceptInvitation_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackService_AcceptInvitation_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string) (model.Pack, error)) *MockPackService_AcceptInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockPackService
+func (_mock *MockPackService) Create(ctx context.Context, userID string, pc model.PackCreate) (model.Pack, error) {
+	ret := _mock.Called(ctx, userID, pc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.PackCreate) (model.Pack, error)); ok {
+		return returnFunc(ctx, userID, pc)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.PackCreate) model.Pack); ok {
+		r0 = returnFunc(ctx, userID, pc)
+	} else {
+		r0 = ret.Get(0).(model.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.PackCreate) error); ok {
+		r1 = returnFunc(ctx, userID, pc)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPackService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - pc
+func (_e *MockPackService_Expecter) Create(ctx interface{}, userID interface{}, pc interface{}) *MockPackService_Create_Call {
+	return &MockPackService_Create_Call{Call: _e.mock.On("Create", ctx, userID, pc)}
+}
+
+func (_c *MockPackService_Create_Call) Run(run func(ctx context.Context, userID string, pc model.PackCreate)) *MockPackService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PackCreate))
+	})
+	return _c
+}
+
+func (_c *MockPackService_Create_Call) Return(pack model.Pack, err error) *MockPackService_Create_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackService_Create_Call) RunAndReturn(run func(ctx context.Context, userID string, pc model.PackCreate) (model.Pack, error)) *MockPackService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateShareLink provides a mock function for the type MockPackService
+func (_mock *MockPackService) CreateShareLink(ctx context.Context, packID string, userID string, slc model.ShareLinkCreate) (model.ShareLink, error) {
+	ret := _mock.Called(ctx, packID, userID, slc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShareLink")
+	}
+
+	var r0 model.ShareLink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.ShareLinkCreate) (model.ShareLink, error)); ok {
+		return returnFunc(ctx, packID, userID, slc)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.ShareLinkCreate) model.ShareLink); ok {
+		r0 = returnFunc(ctx, packID, userID, slc)
+	} else {
+		r0 = ret.Get(0).(model.ShareLink)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.ShareLinkCreate) error); ok {
+		r1 = returnFunc(ctx, packID, userID, slc)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_CreateShareLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShareLink'
+type MockPackService_CreateShareLink_Call struct {
+	*mock.Call
+}
+
+// CreateShareLink is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+//   - slc
+func (_e *MockPackService_Expecter) CreateShareLink(ctx interface{}, packID interface{}, userID interface{}, slc interface{}) *MockPackService_CreateShareLink_Call {
+	return &MockPackService_CreateShareLink_Call{Call: _e.mock.On("CreateShareLink", ctx, packID, userID, slc)}
+}
+
+func (_c *MockPackService_CreateShareLink_Call) Run(run func(ctx context.Context, packID string, userID string, slc model.ShareLinkCreate)) *MockPackService_CreateShareLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.ShareLinkCreate))
+	})
+	return _c
+}
+
+func (_c *MockPackService_CreateShareLink_Call) Return(shareLink model.ShareLink, err error) *MockPackService_CreateShareLink_Call {
+	_c.Call.Return(shareLink, err)
+	return _c
+}
+
+func (_c *MockPackService_CreateShareLink_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string, slc model.ShareLinkCreate) (model.ShareLink, error)) *MockPackService_CreateShareLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByID provides a mock function for the type MockPackService
+func (_mock *MockPackService) DeleteByID(ctx context.Context, packID string, userID string) error {
+	ret := _mock.Called(ctx, packID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, packID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackService_DeleteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByID'
+type MockPackService_DeleteByID_Call struct {
+	*mock.Call
+}
+
+// DeleteByID is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+func (_e *MockPackService_Expecter) DeleteByID(ctx interface{}, packID interface{}, userID interface{}) *MockPackService_DeleteByID_Call {
+	return &MockPackService_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, packID, userID)}
+}
+
+func (_c *MockPackService_DeleteByID_Call) Run(run func(ctx context.Context, packID string, userID string)) *MockPackService_DeleteByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_DeleteByID_Call) Return(err error) *MockPackService_DeleteByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackService_DeleteByID_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string) error) *MockPackService_DeleteByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FollowByShareToken provides a mock function for the type MockPackService
+func (_mock *MockPackService) FollowByShareToken(ctx context.Context, token string, userID string) (model.Pack, error) {
+	ret := _mock.Called(ctx, token, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowByShareToken")
+	}
+
+	var r0 model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Pack, error)); ok {
+		return returnFunc(ctx, token, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Pack); ok {
+		r0 = returnFunc(ctx, token, userID)
+	} else {
+		r0 = ret.Get(0).(model.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, token, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_FollowByShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowByShareToken'
+type MockPackService_FollowByShareToken_Call struct {
+	*mock.Call
+}
+
+// FollowByShareToken is a helper method to define mock.On call
+//   - ctx
+//   - token
+//   - userID
+func (_e *MockPackService_Expecter) FollowByShareToken(ctx interface{}, token interface{}, userID interface{}) *MockPackService_FollowByShareToken_Call {
+	return &MockPackService_FollowByShareToken_Call{Call: _e.mock.On("FollowByShareToken", ctx, token, userID)}
+}
+
+func (_c *MockPackService_FollowByShareToken_Call) Run(run func(ctx context.Context, token string, userID string)) *MockPackService_FollowByShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_FollowByShareToken_Call) Return(pack model.Pack, err error) *MockPackService_FollowByShareToken_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackService_FollowByShareToken_Call) RunAndReturn(run func(ctx context.Context, token string, userID string) (model.Pack, error)) *MockPackService_FollowByShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockPackService
+func (_mock *MockPackService) GetByID(ctx context.Context, packID string, userID string) (model.Pack, error) {
+	ret := _mock.Called(ctx, packID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Pack, error)); ok {
+		return returnFunc(ctx, packID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Pack); ok {
+		r0 = returnFunc(ctx, packID, userID)
+	} else {
+		r0 = ret.Get(0).(model.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, packID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockPackService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+func (_e *MockPackService_Expecter) GetByID(ctx interface{}, packID interface{}, userID interface{}) *MockPackService_GetByID_Call {
+	return &MockPackService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, packID, userID)}
+}
+
+func (_c *MockPackService_GetByID_Call) Run(run func(ctx context.Context, packID string, userID string)) *MockPackService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_GetByID_Call) Return(pack model.Pack, err error) *MockPackService_GetByID_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackService_GetByID_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string) (model.Pack, error)) *MockPackService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByNameOrAuthor provides a mock function for the type MockPackService
+func (_mock *MockPackService) GetByNameOrAuthor(ctx context.Context, query string, userID string, page model.Page) ([]model.Pack, model.PageInfo, error) {
+	ret := _mock.Called(ctx, query, userID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByNameOrAuthor")
+	}
+
+	var r0 []model.Pack
+	var r1 model.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Page) ([]model.Pack, model.PageInfo, error)); ok {
+		return returnFunc(ctx, query, userID, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Page) []model.Pack); ok {
+		r0 = returnFunc(ctx, query, userID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Pack)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.Page) model.PageInfo); ok {
+		r1 = returnFunc(ctx, query, userID, page)
+	} else {
+		r1 = ret.Get(1).(model.PageInfo)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, model.Page) error); ok {
+		r2 = returnFunc(ctx, query, userID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPackService_GetByNameOrAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNameOrAuthor'
+type MockPackService_GetByNameOrAuthor_Call struct {
+	*mock.Call
+}
+
+// GetByNameOrAuthor is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - userID
+//   - page
+func (_e *MockPackService_Expecter) GetByNameOrAuthor(ctx interface{}, query interface{}, userID interface{}, page interface{}) *MockPackService_GetByNameOrAuthor_Call {
+	return &MockPackService_GetByNameOrAuthor_Call{Call: _e.mock.On("GetByNameOrAuthor", ctx, query, userID, page)}
+}
+
+func (_c *MockPackService_GetByNameOrAuthor_Call) Run(run func(ctx context.Context, query string, userID string, page model.Page)) *MockPackService_GetByNameOrAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Page))
+	})
+	return _c
+}
+
+func (_c *MockPackService_GetByNameOrAuthor_Call) Return(packs []model.Pack, pageInfo model.PageInfo, err error) *MockPackService_GetByNameOrAuthor_Call {
+	_c.Call.Return(packs, pageInfo, err)
+	return _c
+}
+
+func (_c *MockPackService_GetByNameOrAuthor_Call) RunAndReturn(run func(ctx context.Context, query string, userID string, page model.Page) ([]model.Pack, model.PageInfo, error)) *MockPackService_GetByNameOrAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByShareToken provides a mock function for the type MockPackService
+func (_mock *MockPackService) GetByShareToken(ctx context.Context, token string, userID string) (model.Pack, error) {
+	ret := _mock.Called(ctx, token, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByShareToken")
+	}
+
+	var r0 model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Pack, error)); ok {
+		return returnFunc(ctx, token, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Pack); ok {
+		r0 = returnFunc(ctx, token, userID)
+	} else {
+		r0 = ret.Get(0).(model.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, token, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_GetByShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByShareToken'
+type MockPackService_GetByShareToken_Call struct {
+	*mock.Call
+}
+
+// GetByShareToken is a helper method to define mock.On call
+//   - ctx
+//   - token
+//   - userID
+func (_e *MockPackService_Expecter) GetByShareToken(ctx interface{}, token interface{}, userID interface{}) *MockPackService_GetByShareToken_Call {
+	return &MockPackService_GetByShareToken_Call{Call: _e.mock.On("GetByShareToken", ctx, token, userID)}
+}
+
+func (_c *MockPackService_GetByShareToken_Call) Run(run func(ctx context.Context, token string, userID string)) *MockPackService_GetByShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_GetByShareToken_Call) Return(pack model.Pack, err error) *MockPackService_GetByShareToken_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackService_GetByShareToken_Call) RunAndReturn(run func(ctx context.Context, token string, userID string) (model.Pack, error)) *MockPackService_GetByShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreatedByUserID provides a mock function for the type MockPackService
+func (_mock *MockPackService) GetCreatedByUserID(ctx context.Context, userID string) ([]model.Pack, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreatedByUserID")
+	}
+
+	var r0 []model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]model.Pack, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []model.Pack); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Pack)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_GetCreatedByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreatedByUserID'
+type MockPackService_GetCreatedByUserID_Call struct {
+	*mock.Call
+}
+
+// GetCreatedByUserID is a helper method to define mock.On call
+//   - ctx
+//   - userID
+func (_e *MockPackService_Expecter) GetCreatedByUserID(ctx interface{}, userID interface{}) *MockPackService_GetCreatedByUserID_Call {
+	return &MockPackService_GetCreatedByUserID_Call{Call: _e.mock.On("GetCreatedByUserID", ctx, userID)}
+}
+
+func (_c *MockPackService_GetCreatedByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockPackService_GetCreatedByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_GetCreatedByUserID_Call) Return(packs []model.Pack, err error) *MockPackService_GetCreatedByUserID_Call {
+	_c.Call.Return(packs, err)
+	return _c
+}
+
+func (_c *MockPackService_GetCreatedByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]model.Pack, error)) *MockPackService_GetCreatedByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowedByUserID provides a mock function for the type MockPackService
+func (_mock *MockPackService) GetFollowedByUserID(ctx context.Context, userID string, progress types.PackProgress) ([]model.Pack, error) {
+	ret := _mock.Called(ctx, userID, progress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowedByUserID")
+	}
+
+	var r0 []model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, types.PackProgress) ([]model.Pack, error)); ok {
+		return returnFunc(ctx, userID, progress)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, types.PackProgress) []model.Pack); ok {
+		r0 = returnFunc(ctx, userID, progress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Pack)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, types.PackProgress) error); ok {
+		r1 = returnFunc(ctx, userID, progress)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_GetFollowedByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowedByUserID'
+type MockPackService_GetFollowedByUserID_Call struct {
+	*mock.Call
+}
+
+// GetFollowedByUserID is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - progress
+func (_e *MockPackService_Expecter) GetFollowedByUserID(ctx interface{}, userID interface{}, progress interface{}) *MockPackService_GetFollowedByUserID_Call {
+	return &MockPackService_GetFollowedByUserID_Call{Call: _e.mock.On("GetFollowedByUserID", ctx, userID, progress)}
+}
+
+func (_c *MockPackService_GetFollowedByUserID_Call) Run(run func(ctx context.Context, userID string, progress types.PackProgress)) *MockPackService_GetFollowedByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(types.PackProgress))
+	})
+	return _c
+}
+
+func (_c *MockPackService_GetFollowedByUserID_Call) Return(packs []model.Pack, err error) *MockPackService_GetFollowedByUserID_Call {
+	_c.Call.Return(packs, err)
+	return _c
+}
+
+func (_c *MockPackService_GetFollowedByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string, progress types.PackProgress) ([]model.Pack, error)) *MockPackService_GetFollowedByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShareLinks provides a mock function for the type MockPackService
+func (_mock *MockPackService) GetShareLinks(ctx context.Context, packID string, userID string) ([]model.ShareLink, error) {
+	ret := _mock.Called(ctx, packID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShareLinks")
+	}
+
+	var r0 []model.ShareLink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]model.ShareLink, error)); ok {
+		return returnFunc(ctx, packID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []model.ShareLink); ok {
+		r0 = returnFunc(ctx, packID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ShareLink)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, packID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_GetShareLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShareLinks'
+type MockPackService_GetShareLinks_Call struct {
+	*mock.Call
+}
+
+// GetShareLinks is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+func (_e *MockPackService_Expecter) GetShareLinks(ctx interface{}, packID interface{}, userID interface{}) *MockPackService_GetShareLinks_Call {
+	return &MockPackService_GetShareLinks_Call{Call: _e.mock.On("GetShareLinks", ctx, packID, userID)}
+}
+
+func (_c *MockPackService_GetShareLinks_Call) Run(run func(ctx context.Context, packID string, userID string)) *MockPackService_GetShareLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_GetShareLinks_Call) Return(shareLinks []model.ShareLink, err error) *MockPackService_GetShareLinks_Call {
+	_c.Call.Return(shareLinks, err)
+	return _c
+}
+
+func (_c *MockPackService_GetShareLinks_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string) ([]model.ShareLink, error)) *MockPackService_GetShareLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InviteCollaborator provides a mock function for the type MockPackService
+func (_mock *MockPackService) InviteCollaborator(ctx context.Context, packID string, userID string, ci model.CollaboratorInvite) (model.Collaborator, error) {
+	ret := _mock.Called(ctx, packID, userID, ci)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteCollaborator")
+	}
+
+	var r0 model.Collaborator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.CollaboratorInvite) (model.Collaborator, error)); ok {
+		return returnFunc(ctx, packID, userID, ci)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.CollaboratorInvite) model.Collaborator); ok {
+		r0 = returnFunc(ctx, packID, userID, ci)
+	} else {
+		r0 = ret.Get(0).(model.Collaborator)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.CollaboratorInvite) error); ok {
+		r1 = returnFunc(ctx, packID, userID, ci)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_InviteCollaborator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteCollaborator'
+type MockPackService_InviteCollaborator_Call struct {
+	*mock.Call
+}
+
+// InviteCollaborator is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+//   - ci
+func (_e *MockPackService_Expecter) InviteCollaborator(ctx interface{}, packID interface{}, userID interface{}, ci interface{}) *MockPackService_InviteCollaborator_Call {
+	return &MockPackService_InviteCollaborator_Call{Call: _e.mock.On("InviteCollaborator", ctx, packID, userID, ci)}
+}
+
+func (_c *MockPackService_InviteCollaborator_Call) Run(run func(ctx context.Context, packID string, userID string, ci model.CollaboratorInvite)) *MockPackService_InviteCollaborator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.CollaboratorInvite))
+	})
+	return _c
+}
+
+func (_c *MockPackService_InviteCollaborator_Call) Return(collaborator model.Collaborator, err error) *MockPackService_InviteCollaborator_Call {
+	_c.Call.Return(collaborator, err)
+	return _c
+}
+
+func (_c *MockPackService_InviteCollaborator_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string, ci model.CollaboratorInvite) (model.Collaborator, error)) *MockPackService_InviteCollaborator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCollaborator provides a mock function for the type MockPackService
+func (_mock *MockPackService) RemoveCollaborator(ctx context.Context, packID string, collaboratorID string, userID string) error {
+	ret := _mock.Called(ctx, packID, collaboratorID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCollaborator")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, packID, collaboratorID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackService_RemoveCollaborator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCollaborator'
+type MockPackService_RemoveCollaborator_Call struct {
+	*mock.Call
+}
+
+// RemoveCollaborator is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - collaboratorID
+//   - userID
+func (_e *MockPackService_Expecter) RemoveCollaborator(ctx interface{}, packID interface{}, collaboratorID interface{}, userID interface{}) *MockPackService_RemoveCollaborator_Call {
+	return &MockPackService_RemoveCollaborator_Call{Call: _e.mock.On("RemoveCollaborator", ctx, packID, collaboratorID, userID)}
+}
+
+func (_c *MockPackService_RemoveCollaborator_Call) Run(run func(ctx context.Context, packID string, collaboratorID string, userID string)) *MockPackService_RemoveCollaborator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_RemoveCollaborator_Call) Return(err error) *MockPackService_RemoveCollaborator_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackService_RemoveCollaborator_Call) RunAndReturn(run func(ctx context.Context, packID string, collaboratorID string, userID string) error) *MockPackService_RemoveCollaborator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreByID provides a mock function for the type MockPackService
+func (_mock *MockPackService) RestoreByID(ctx context.Context, packID string, userID string) (model.Pack, error) {
+	ret := _mock.Called(ctx, packID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreByID")
+	}
+
+	var r0 model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Pack, error)); ok {
+		return returnFunc(ctx, packID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Pack); ok {
+		r0 = returnFunc(ctx, packID, userID)
+	} else {
+		r0 = ret.Get(0).(model.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, packID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_RestoreByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreByID'
+type MockPackService_RestoreByID_Call struct {
+	*mock.Call
+}
+
+// RestoreByID is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+func (_e *MockPackService_Expecter) RestoreByID(ctx interface{}, packID interface{}, userID interface{}) *MockPackService_RestoreByID_Call {
+	return &MockPackService_RestoreByID_Call{Call: _e.mock.On("RestoreByID", ctx, packID, userID)}
+}
+
+func (_c *MockPackService_RestoreByID_Call) Run(run func(ctx context.Context, packID string, userID string)) *MockPackService_RestoreByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_RestoreByID_Call) Return(pack model.Pack, err error) *MockPackService_RestoreByID_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackService_RestoreByID_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string) (model.Pack, error)) *MockPackService_RestoreByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeShareLink provides a mock function for the type MockPackService
+func (_mock *MockPackService) RevokeShareLink(ctx context.Context, packID string, linkID string, userID string) error {
+	ret := _mock.Called(ctx, packID, linkID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeShareLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, packID, linkID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackService_RevokeShareLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeShareLink'
+type MockPackService_RevokeShareLink_Call struct {
+	*mock.Call
+}
+
+// RevokeShareLink is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - linkID
+//   - userID
+func (_e *MockPackService_Expecter) RevokeShareLink(ctx interface{}, packID interface{}, linkID interface{}, userID interface{}) *MockPackService_RevokeShareLink_Call {
+	return &MockPackService_RevokeShareLink_Call{Call: _e.mock.On("RevokeShareLink", ctx, packID, linkID, userID)}
+}
+
+func (_c *MockPackService_RevokeShareLink_Call) Run(run func(ctx context.Context, packID string, linkID string, userID string)) *MockPackService_RevokeShareLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackService_RevokeShareLink_Call) Return(err error) *MockPackService_RevokeShareLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackService_RevokeShareLink_Call) RunAndReturn(run func(ctx context.Context, packID string, linkID string, userID string) error) *MockPackService_RevokeShareLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateByID provides a mock function for the type MockPackService
+func (_mock *MockPackService) UpdateByID(ctx context.Context, packID string, userID string, pu model.PackUpdate) (model.Pack, error) {
+	ret := _mock.Called(ctx, packID, userID, pu)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateByID")
+	}
+
+	var r0 model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PackUpdate) (model.Pack, error)); ok {
+		return returnFunc(ctx, packID, userID, pu)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PackUpdate) model.Pack); ok {
+		r0 = returnFunc(ctx, packID, userID, pu)
+	} else {
+		r0 = ret.Get(0).(model.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.PackUpdate) error); ok {
+		r1 = returnFunc(ctx, packID, userID, pu)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
+type MockPackService_UpdateByID_Call struct {
+	*mock.Call
+}
+
+// UpdateByID is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+//   - pu
+func (_e *MockPackService_Expecter) UpdateByID(ctx interface{}, packID interface{}, userID interface{}, pu interface{}) *MockPackService_UpdateByID_Call {
+	return &MockPackService_UpdateByID_Call{Call: _e.mock.On("UpdateByID", ctx, packID, userID, pu)}
+}
+
+func (_c *MockPackService_UpdateByID_Call) Run(run func(ctx context.Context, packID string, userID string, pu model.PackUpdate)) *MockPackService_UpdateByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PackUpdate))
+	})
+	return _c
+}
+
+func (_c *MockPackService_UpdateByID_Call) Return(pack model.Pack, err error) *MockPackService_UpdateByID_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackService_UpdateByID_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string, pu model.PackUpdate) (model.Pack, error)) *MockPackService_UpdateByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePlaceByID provides a mock function for the type MockPackService
+func (_mock *MockPackService) UpdatePlaceByID(ctx context.Context, packID string, placeID string, userID string, ppu model.PackPlaceUpdate) (model.Pack, error) {
+	ret := _mock.Called(ctx, packID, placeID, userID, ppu)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePlaceByID")
+	}
+
+	var r0 model.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, model.PackPlaceUpdate) (model.Pack, error)); ok {
+		return returnFunc(ctx, packID, placeID, userID, ppu)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, model.PackPlaceUpdate) model.Pack); ok {
+		r0 = returnFunc(ctx, packID, placeID, userID, ppu)
+	} else {
+		r0 = ret.Get(0).(model.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, model.PackPlaceUpdate) error); ok {
+		r1 = returnFunc(ctx, packID, placeID, userID, ppu)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackService_UpdatePlaceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePlaceByID'
+type MockPackService_UpdatePlaceByID_Call struct {
+	*mock.Call
+}
+
+// UpdatePlaceByID is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - placeID
+//   - userID
+//   - ppu
+func (_e *MockPackService_Expecter) UpdatePlaceByID(ctx interface{}, packID interface{}, placeID interface{}, userID interface{}, ppu interface{}) *MockPackService_UpdatePlaceByID_Call {
+	return &MockPackService_UpdatePlaceByID_Call{Call: _e.mock.On("UpdatePlaceByID", ctx, packID, placeID, userID, ppu)}
+}
+
+func (_c *MockPackService_UpdatePlaceByID_Call) Run(run func(ctx context.Context, packID string, placeID string, userID string, ppu model.PackPlaceUpdate)) *MockPackService_UpdatePlaceByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(model.PackPlaceUpdate))
+	})
+	return _c
+}
+
+func (_c *MockPackService_UpdatePlaceByID_Call) Return(pack model.Pack, err error) *MockPackService_UpdatePlaceByID_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackService_UpdatePlaceByID_Call) RunAndReturn(run func(ctx context.Context, packID string, placeID string, userID string, ppu model.PackPlaceUpdate) (model.Pack, error)) *MockPackService_UpdatePlaceByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserService creates a new instance of MockUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserService {
+	mock := &MockUserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserService is an autogenerated mock type for the UserService type
+type MockUserService struct {
+	mock.Mock
+}
+
+type MockUserService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
+	return &MockUserService_Expecter{mock: &_m.Mock}
+}
+
+// CheckNotBanned provides a mock function for the type MockUserService
+func (_mock *MockUserService) CheckNotBanned(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckNotBanned")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_CheckNotBanned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckNotBanned'
+type MockUserService_CheckNotBanned_Call struct {
+	*mock.Call
+}
+
+// CheckNotBanned is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserService_Expecter) CheckNotBanned(ctx interface{}, id interface{}) *MockUserService_CheckNotBanned_Call {
+	return &MockUserService_CheckNotBanned_Call{Call: _e.mock.On("CheckNotBanned", ctx, id)}
+}
+
+func (_c *MockUserService_CheckNotBanned_Call) Run(run func(ctx context.Context, id string)) *MockUserService_CheckNotBanned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_CheckNotBanned_Call) Return(err error) *MockUserService_CheckNotBanned_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_CheckNotBanned_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockUserService_CheckNotBanned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockUserService
+func (_mock *MockUserService) Follow(ctx context.Context, id string, userID string) error {
+	ret := _mock.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockUserService_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - userID
+func (_e *MockUserService_Expecter) Follow(ctx interface{}, id interface{}, userID interface{}) *MockUserService_Follow_Call {
+	return &MockUserService_Follow_Call{Call: _e.mock.On("Follow", ctx, id, userID)}
+}
+
+func (_c *MockUserService_Follow_Call) Run(run func(ctx context.Context, id string, userID string)) *MockUserService_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_Follow_Call) Return(err error) *MockUserService_Follow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Follow_Call) RunAndReturn(run func(ctx context.Context, id string, userID string) error) *MockUserService_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetByID(ctx context.Context, id string) (model.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserService_Expecter) GetByID(ctx interface{}, id interface{}) *MockUserService_GetByID_Call {
+	return &MockUserService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockUserService_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockUserService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetByID_Call) Return(user model.User, err error) *MockUserService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.User, error)) *MockUserService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeed provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetFeed(ctx context.Context, userID string, page model.Page) ([]model.Activity, model.PageInfo, error) {
+	ret := _mock.Called(ctx, userID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeed")
+	}
+
+	var r0 []model.Activity
+	var r1 model.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Page) ([]model.Activity, model.PageInfo, error)); ok {
+		return returnFunc(ctx, userID, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Page) []model.Activity); ok {
+		r0 = returnFunc(ctx, userID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Activity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.Page) model.PageInfo); ok {
+		r1 = returnFunc(ctx, userID, page)
+	} else {
+		r1 = ret.Get(1).(model.PageInfo)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, model.Page) error); ok {
+		r2 = returnFunc(ctx, userID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserService_GetFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeed'
+type MockUserService_GetFeed_Call struct {
+	*mock.Call
+}
+
+// GetFeed is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - page
+func (_e *MockUserService_Expecter) GetFeed(ctx interface{}, userID interface{}, page interface{}) *MockUserService_GetFeed_Call {
+	return &MockUserService_GetFeed_Call{Call: _e.mock.On("GetFeed", ctx, userID, page)}
+}
+
+func (_c *MockUserService_GetFeed_Call) Run(run func(ctx context.Context, userID string, page model.Page)) *MockUserService_GetFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.Page))
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetFeed_Call) Return(activitys []model.Activity, pageInfo model.PageInfo, err error) *MockUserService_GetFeed_Call {
+	_c.Call.Return(activitys, pageInfo, err)
+	return _c
+}
+
+func (_c *MockUserService_GetFeed_Call) RunAndReturn(run func(ctx context.Context, userID string, page model.Page) ([]model.Activity, model.PageInfo, error)) *MockUserService_GetFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockUserService
+func (_mock *MockUserService) Unfollow(ctx context.Context, id string, userID string) error {
+	ret := _mock.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockUserService_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - userID
+func (_e *MockUserService_Expecter) Unfollow(ctx interface{}, id interface{}, userID interface{}) *MockUserService_Unfollow_Call {
+	return &MockUserService_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, id, userID)}
+}
+
+func (_c *MockUserService_Unfollow_Call) Run(run func(ctx context.Context, id string, userID string)) *MockUserService_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_Unfollow_Call) Return(err error) *MockUserService_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Unfollow_Call) RunAndReturn(run func(ctx context.Context, id string, userID string) error) *MockUserService_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateByID provides a mock function for the type MockUserService
+func (_mock *MockUserService) UpdateByID(ctx context.Context, id string, uu model.UserUpdate) (model.User, error) {
+	ret := _mock.Called(ctx, id, uu)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.UserUpdate) (model.User, error)); ok {
+		return returnFunc(ctx, id, uu)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.UserUpdate) model.User); ok {
+		r0 = returnFunc(ctx, id, uu)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.UserUpdate) error); ok {
+		r1 = returnFunc(ctx, id, uu)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
+type MockUserService_UpdateByID_Call struct {
+	*mock.Call
+}
+
+// UpdateByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - uu
+func (_e *MockUserService_Expecter) UpdateByID(ctx interface{}, id interface{}, uu interface{}) *MockUserService_UpdateByID_Call {
+	return &MockUserService_UpdateByID_Call{Call: _e.mock.On("UpdateByID", ctx, id, uu)}
+}
+
+func (_c *MockUserService_UpdateByID_Call) Run(run func(ctx context.Context, id string, uu model.UserUpdate)) *MockUserService_UpdateByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.UserUpdate))
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdateByID_Call) Return(user model.User, err error) *MockUserService_UpdateByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_UpdateByID_Call) RunAndReturn(run func(ctx context.Context, id string, uu model.UserUpdate) (model.User, error)) *MockUserService_UpdateByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMediaService creates a new instance of MockMediaService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMediaService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMediaService {
+	mock := &MockMediaService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMediaService is an autogenerated mock type for the MediaService type
+type MockMediaService struct {
+	mock.Mock
+}
+
+type MockMediaService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMediaService) EXPECT() *MockMediaService_Expecter {
+	return &MockMediaService_Expecter{mock: &_m.Mock}
+}
+
+// AddToPlace provides a mock function for the type MockMediaService
+func (_mock *MockMediaService) AddToPlace(ctx context.Context, placeID string, userID string, mu model.MediaUpload) (model.Media, error) {
+	ret := _mock.Called(ctx, placeID, userID, mu)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToPlace")
+	}
+
+	var r0 model.Media
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.MediaUpload) (model.Media, error)); ok {
+		return returnFunc(ctx, placeID, userID, mu)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.MediaUpload) model.Media); ok {
+		r0 = returnFunc(ctx, placeID, userID, mu)
+	} else {
+		r0 = ret.Get(0).(model.Media)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.MediaUpload) error); ok {
+		r1 = returnFunc(ctx, placeID, userID, mu)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMediaService_AddToPlace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToPlace'
+type MockMediaService_AddToPlace_Call struct {
+	*mock.Call
+}
+
+// AddToPlace is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - userID
+//   - mu
+func (_e *MockMediaService_Expecter) AddToPlace(ctx interface{}, placeID interface{}, userID interface{}, mu interface{}) *MockMediaService_AddToPlace_Call {
+	return &MockMediaService_AddToPlace_Call{Call: _e.mock.On("AddToPlace", ctx, placeID, userID, mu)}
+}
+
+func (_c *MockMediaService_AddToPlace_Call) Run(run func(ctx context.Context, placeID string, userID string, mu model.MediaUpload)) *MockMediaService_AddToPlace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.MediaUpload))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_AddToPlace_Call) Return(media model.Media, err error) *MockMediaService_AddToPlace_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_AddToPlace_Call) RunAndReturn(run func(ctx context.Context, placeID string, userID string, mu model.MediaUpload) (model.Media, error)) *MockMediaService_AddToPlace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFromPlace provides a mock function for the type MockMediaService
+func (_mock *MockMediaService) DeleteFromPlace(ctx context.Context, placeID string, mediaID string, userID string) error {
+	ret := _mock.Called(ctx, placeID, mediaID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFromPlace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, placeID, mediaID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMediaService_DeleteFromPlace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFromPlace'
+type MockMediaService_DeleteFromPlace_Call struct {
+	*mock.Call
+}
+
+// DeleteFromPlace is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - mediaID
+//   - userID
+func (_e *MockMediaService_Expecter) DeleteFromPlace(ctx interface{}, placeID interface{}, mediaID interface{}, userID interface{}) *MockMediaService_DeleteFromPlace_Call {
+	return &MockMediaService_DeleteFromPlace_Call{Call: _e.mock.On("DeleteFromPlace", ctx, placeID, mediaID, userID)}
+}
+
+func (_c *MockMediaService_DeleteFromPlace_Call) Run(run func(ctx context.Context, placeID string, mediaID string, userID string)) *MockMediaService_DeleteFromPlace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_DeleteFromPlace_Call) Return(err error) *MockMediaService_DeleteFromPlace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMediaService_DeleteFromPlace_Call) RunAndReturn(run func(ctx context.Context, placeID string, mediaID string, userID string) error) *MockMediaService_DeleteFromPlace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePackCover provides a mock function for the type MockMediaService
+func (_mock *MockMediaService) DeletePackCover(ctx context.Context, packID string, userID string) error {
+	ret := _mock.Called(ctx, packID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePackCover")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, packID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMediaService_DeletePackCover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePackCover'
+type MockMediaService_DeletePackCover_Call struct {
+	*mock.Call
+}
+
+// DeletePackCover is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+func (_e *MockMediaService_Expecter) DeletePackCover(ctx interface{}, packID interface{}, userID interface{}) *MockMediaService_DeletePackCover_Call {
+	return &MockMediaService_DeletePackCover_Call{Call: _e.mock.On("DeletePackCover", ctx, packID, userID)}
+}
+
+func (_c *MockMediaService_DeletePackCover_Call) Run(run func(ctx context.Context, packID string, userID string)) *MockMediaService_DeletePackCover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_DeletePackCover_Call) Return(err error) *MockMediaService_DeletePackCover_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMediaService_DeletePackCover_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string) error) *MockMediaService_DeletePackCover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPackCover provides a mock function for the type MockMediaService
+func (_mock *MockMediaService) SetPackCover(ctx context.Context, packID string, userID string, mu model.MediaUpload) (model.Media, error) {
+	ret := _mock.Called(ctx, packID, userID, mu)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPackCover")
+	}
+
+	var r0 model.Media
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.MediaUpload) (model.Media, error)); ok {
+		return returnFunc(ctx, packID, userID, mu)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.MediaUpload) model.Media); ok {
+		r0 = returnFunc(ctx, packID, userID, mu)
+	} else {
+		r0 = ret.Get(0).(model.Media)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.MediaUpload) error); ok {
+		r1 = returnFunc(ctx, packID, userID, mu)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMediaService_SetPackCover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPackCover'
+type MockMediaService_SetPackCover_Call struct {
+	*mock.Call
+}
+
+// SetPackCover is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - userID
+//   - mu
+func (_e *MockMediaService_Expecter) SetPackCover(ctx interface{}, packID interface{}, userID interface{}, mu interface{}) *MockMediaService_SetPackCover_Call {
+	return &MockMediaService_SetPackCover_Call{Call: _e.mock.On("SetPackCover", ctx, packID, userID, mu)}
+}
+
+func (_c *MockMediaService_SetPackCover_Call) Run(run func(ctx context.Context, packID string, userID string, mu model.MediaUpload)) *MockMediaService_SetPackCover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.MediaUpload))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_SetPackCover_Call) Return(media model.Media, err error) *MockMediaService_SetPackCover_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_SetPackCover_Call) RunAndReturn(run func(ctx context.Context, packID string, userID string, mu model.MediaUpload) (model.Media, error)) *MockMediaService_SetPackCover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModerationService creates a new instance of MockModerationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModerationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModerationService {
+	mock := &MockModerationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModerationService is an autogenerated mock type for the ModerationService type
+type MockModerationService struct {
+	mock.Mock
+}
+
+type MockModerationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModerationService) EXPECT() *MockModerationService_Expecter {
+	return &MockModerationService_Expecter{mock: &_m.Mock}
+}
+
+// BanUser provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) BanUser(ctx context.Context, userID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, userID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BanUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, userID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_BanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BanUser'
+type MockModerationService_BanUser_Call struct {
+	*mock.Call
+}
+
+// BanUser is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) BanUser(ctx interface{}, userID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_BanUser_Call {
+	return &MockModerationService_BanUser_Call{Call: _e.mock.On("BanUser", ctx, userID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_BanUser_Call) Run(run func(ctx context.Context, userID string, moderatorID string, m model.Moderation)) *MockModerationService_BanUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_BanUser_Call) Return(err error) *MockModerationService_BanUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_BanUser_Call) RunAndReturn(run func(ctx context.Context, userID string, moderatorID string, m model.Moderation) error) *MockModerationService_BanUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePack provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) DeletePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, packID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePack")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, packID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_DeletePack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePack'
+type MockModerationService_DeletePack_Call struct {
+	*mock.Call
+}
+
+// DeletePack is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) DeletePack(ctx interface{}, packID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_DeletePack_Call {
+	return &MockModerationService_DeletePack_Call{Call: _e.mock.On("DeletePack", ctx, packID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_DeletePack_Call) Run(run func(ctx context.Context, packID string, moderatorID string, m model.Moderation)) *MockModerationService_DeletePack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_DeletePack_Call) Return(err error) *MockModerationService_DeletePack_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_DeletePack_Call) RunAndReturn(run func(ctx context.Context, packID string, moderatorID string, m model.Moderation) error) *MockModerationService_DeletePack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePlace provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) DeletePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, placeID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePlace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, placeID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_DeletePlace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePlace'
+type MockModerationService_DeletePlace_Call struct {
+	*mock.Call
+}
+
+// DeletePlace is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) DeletePlace(ctx interface{}, placeID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_DeletePlace_Call {
+	return &MockModerationService_DeletePlace_Call{Call: _e.mock.On("DeletePlace", ctx, placeID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_DeletePlace_Call) Run(run func(ctx context.Context, placeID string, moderatorID string, m model.Moderation)) *MockModerationService_DeletePlace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_DeletePlace_Call) Return(err error) *MockModerationService_DeletePlace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_DeletePlace_Call) RunAndReturn(run func(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error) *MockModerationService_DeletePlace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReview provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) DeleteReview(ctx context.Context, reviewID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, reviewID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReview")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, reviewID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_DeleteReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReview'
+type MockModerationService_DeleteReview_Call struct {
+	*mock.Call
+}
+
+// DeleteReview is a helper method to define mock.On call
+//   - ctx
+//   - reviewID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) DeleteReview(ctx interface{}, reviewID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_DeleteReview_Call {
+	return &MockModerationService_DeleteReview_Call{Call: _e.mock.On("DeleteReview", ctx, reviewID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_DeleteReview_Call) Run(run func(ctx context.Context, reviewID string, moderatorID string, m model.Moderation)) *MockModerationService_DeleteReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_DeleteReview_Call) Return(err error) *MockModerationService_DeleteReview_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_DeleteReview_Call) RunAndReturn(run func(ctx context.Context, reviewID string, moderatorID string, m model.Moderation) error) *MockModerationService_DeleteReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLog provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) GetLog(ctx context.Context, page model.Page) ([]model.ModerationAction, model.PageInfo, error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLog")
+	}
+
+	var r0 []model.ModerationAction
+	var r1 model.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Page) ([]model.ModerationAction, model.PageInfo, error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Page) []model.ModerationAction); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ModerationAction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Page) model.PageInfo); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Get(1).(model.PageInfo)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, model.Page) error); ok {
+		r2 = returnFunc(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockModerationService_GetLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLog'
+type MockModerationService_GetLog_Call struct {
+	*mock.Call
+}
+
+// GetLog is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockModerationService_Expecter) GetLog(ctx interface{}, page interface{}) *MockModerationService_GetLog_Call {
+	return &MockModerationService_GetLog_Call{Call: _e.mock.On("GetLog", ctx, page)}
+}
+
+func (_c *MockModerationService_GetLog_Call) Run(run func(ctx context.Context, page model.Page)) *MockModerationService_GetLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Page))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_GetLog_Call) Return(moderationActions []model.ModerationAction, pageInfo model.PageInfo, err error) *MockModerationService_GetLog_Call {
+	_c.Call.Return(moderationActions, pageInfo, err)
+	return _c
+}
+
+func (_c *MockModerationService_GetLog_Call) RunAndReturn(run func(ctx context.Context, page model.Page) ([]model.ModerationAction, model.PageInfo, error)) *MockModerationService_GetLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HidePack provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) HidePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, packID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HidePack")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, packID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_HidePack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HidePack'
+type MockModerationService_HidePack_Call struct {
+	*mock.Call
+}
+
+// HidePack is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) HidePack(ctx interface{}, packID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_HidePack_Call {
+	return &MockModerationService_HidePack_Call{Call: _e.mock.On("HidePack", ctx, packID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_HidePack_Call) Run(run func(ctx context.Context, packID string, moderatorID string, m model.Moderation)) *MockModerationService_HidePack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_HidePack_Call) Return(err error) *MockModerationService_HidePack_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_HidePack_Call) RunAndReturn(run func(ctx context.Context, packID string, moderatorID string, m model.Moderation) error) *MockModerationService_HidePack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HidePlace provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) HidePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, placeID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HidePlace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, placeID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_HidePlace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HidePlace'
+type MockModerationService_HidePlace_Call struct {
+	*mock.Call
+}
+
+// HidePlace is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) HidePlace(ctx interface{}, placeID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_HidePlace_Call {
+	return &MockModerationService_HidePlace_Call{Call: _e.mock.On("HidePlace", ctx, placeID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_HidePlace_Call) Run(run func(ctx context.Context, placeID string, moderatorID string, m model.Moderation)) *MockModerationService_HidePlace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_HidePlace_Call) Return(err error) *MockModerationService_HidePlace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_HidePlace_Call) RunAndReturn(run func(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error) *MockModerationService_HidePlace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergePlace provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) MergePlace(ctx context.Context, placeID string, moderatorID string, pm model.PlaceMerge) error {
+	ret := _mock.Called(ctx, placeID, moderatorID, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergePlace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PlaceMerge) error); ok {
+		r0 = returnFunc(ctx, placeID, moderatorID, pm)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_MergePlace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergePlace'
+type MockModerationService_MergePlace_Call struct {
+	*mock.Call
+}
+
+// MergePlace is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - moderatorID
+//   - pm
+func (_e *MockModerationService_Expecter) MergePlace(ctx interface{}, placeID interface{}, moderatorID interface{}, pm interface{}) *MockModerationService_MergePlace_Call {
+	return &MockModerationService_MergePlace_Call{Call: _e.mock.On("MergePlace", ctx, placeID, moderatorID, pm)}
+}
+
+func (_c *MockModerationService_MergePlace_Call) Run(run func(ctx context.Context, placeID string, moderatorID string, pm model.PlaceMerge)) *MockModerationService_MergePlace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PlaceMerge))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_MergePlace_Call) Return(err error) *MockModerationService_MergePlace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_MergePlace_Call) RunAndReturn(run func(ctx context.Context, placeID string, moderatorID string, pm model.PlaceMerge) error) *MockModerationService_MergePlace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferPack provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) TransferPack(ctx context.Context, packID string, moderatorID string, pt model.PackTransfer) error {
+	ret := _mock.Called(ctx, packID, moderatorID, pt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferPack")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.PackTransfer) error); ok {
+		r0 = returnFunc(ctx, packID, moderatorID, pt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_TransferPack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferPack'
+type MockModerationService_TransferPack_Call struct {
+	*mock.Call
+}
+
+// TransferPack is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - moderatorID
+//   - pt
+func (_e *MockModerationService_Expecter) TransferPack(ctx interface{}, packID interface{}, moderatorID interface{}, pt interface{}) *MockModerationService_TransferPack_Call {
+	return &MockModerationService_TransferPack_Call{Call: _e.mock.On("TransferPack", ctx, packID, moderatorID, pt)}
+}
+
+func (_c *MockModerationService_TransferPack_Call) Run(run func(ctx context.Context, packID string, moderatorID string, pt model.PackTransfer)) *MockModerationService_TransferPack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PackTransfer))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_TransferPack_Call) Return(err error) *MockModerationService_TransferPack_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_TransferPack_Call) RunAndReturn(run func(ctx context.Context, packID string, moderatorID string, pt model.PackTransfer) error) *MockModerationService_TransferPack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnbanUser provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) UnbanUser(ctx context.Context, userID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, userID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnbanUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, userID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_UnbanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnbanUser'
+type MockModerationService_UnbanUser_Call struct {
+	*mock.Call
+}
+
+// UnbanUser is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) UnbanUser(ctx interface{}, userID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_UnbanUser_Call {
+	return &MockModerationService_UnbanUser_Call{Call: _e.mock.On("UnbanUser", ctx, userID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_UnbanUser_Call) Run(run func(ctx context.Context, userID string, moderatorID string, m model.Moderation)) *MockModerationService_UnbanUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_UnbanUser_Call) Return(err error) *MockModerationService_UnbanUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_UnbanUser_Call) RunAndReturn(run func(ctx context.Context, userID string, moderatorID string, m model.Moderation) error) *MockModerationService_UnbanUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnhidePack provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) UnhidePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, packID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnhidePack")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, packID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_UnhidePack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnhidePack'
+type MockModerationService_UnhidePack_Call struct {
+	*mock.Call
+}
+
+// UnhidePack is a helper method to define mock.On call
+//   - ctx
+//   - packID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) UnhidePack(ctx interface{}, packID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_UnhidePack_Call {
+	return &MockModerationService_UnhidePack_Call{Call: _e.mock.On("UnhidePack", ctx, packID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_UnhidePack_Call) Run(run func(ctx context.Context, packID string, moderatorID string, m model.Moderation)) *MockModerationService_UnhidePack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_UnhidePack_Call) Return(err error) *MockModerationService_UnhidePack_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_UnhidePack_Call) RunAndReturn(run func(ctx context.Context, packID string, moderatorID string, m model.Moderation) error) *MockModerationService_UnhidePack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnhidePlace provides a mock function for the type MockModerationService
+func (_mock *MockModerationService) UnhidePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error {
+	ret := _mock.Called(ctx, placeID, moderatorID, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnhidePlace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Moderation) error); ok {
+		r0 = returnFunc(ctx, placeID, moderatorID, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationService_UnhidePlace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnhidePlace'
+type MockModerationService_UnhidePlace_Call struct {
+	*mock.Call
+}
+
+// UnhidePlace is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - moderatorID
+//   - m
+func (_e *MockModerationService_Expecter) UnhidePlace(ctx interface{}, placeID interface{}, moderatorID interface{}, m interface{}) *MockModerationService_UnhidePlace_Call {
+	return &MockModerationService_UnhidePlace_Call{Call: _e.mock.On("UnhidePlace", ctx, placeID, moderatorID, m)}
+}
+
+func (_c *MockModerationService_UnhidePlace_Call) Run(run func(ctx context.Context, placeID string, moderatorID string, m model.Moderation)) *MockModerationService_UnhidePlace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.Moderation))
+	})
+	return _c
+}
+
+func (_c *MockModerationService_UnhidePlace_Call) Return(err error) *MockModerationService_UnhidePlace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationService_UnhidePlace_Call) RunAndReturn(run func(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error) *MockModerationService_UnhidePlace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHealthService creates a new instance of MockHealthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHealthService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHealthService {
+	mock := &MockHealthService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHealthService is an autogenerated mock type for the HealthService type
+type MockHealthService struct {
+	mock.Mock
+}
+
+type MockHealthService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHealthService) EXPECT() *MockHealthService_Expecter {
+	return &MockHealthService_Expecter{mock: &_m.Mock}
+}
+
+// Ready provides a mock function for the type MockHealthService
+func (_mock *MockHealthService) Ready(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ready")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockHealthService_Ready_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ready'
+type MockHealthService_Ready_Call struct {
+	*mock.Call
+}
+
+// Ready is a helper method to define mock.On call
+//   - ctx
+func (_e *MockHealthService_Expecter) Ready(ctx interface{}) *MockHealthService_Ready_Call {
+	return &MockHealthService_Ready_Call{Call: _e.mock.On("Ready", ctx)}
+}
+
+func (_c *MockHealthService_Ready_Call) Run(run func(ctx context.Context)) *MockHealthService_Ready_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockHealthService_Ready_Call) Return(err error) *MockHealthService_Ready_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserService_GetByID_Call) RunAndReturn(run func(id string) (model.User, error)) *MockUserService_GetByID_Call {
+func (_c *MockHealthService_Ready_Call) RunAndReturn(run func(ctx context.Context) error) *MockHealthService_Ready_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -788,8 +3272,8 @@ func (_m *MockAuthService) EXPECT() *MockAuthService_Expecter {
 }
 
 // Login provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) Login(login model.Login) (model.AccessToken, error) {
-	ret := _mock.Called(login)
+func (_mock *MockAuthService) Login(ctx context.Context, login model.Login) (model.AccessToken, error) {
+	ret := _mock.Called(ctx, login)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
@@ -797,16 +3281,16 @@ func (_mock *MockAuthService) Login(login model.Login) (model.AccessToken, error
 
 	var r0 model.AccessToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Login) (model.AccessToken, error)); ok {
-		return returnFunc(login)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Login) (model.AccessToken, error)); ok {
+		return returnFunc(ctx, login)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Login) model.AccessToken); ok {
-		r0 = returnFunc(login)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Login) model.AccessToken); ok {
+		r0 = returnFunc(ctx, login)
 	} else {
 		r0 = ret.Get(0).(model.AccessToken)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Login) error); ok {
-		r1 = returnFunc(login)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Login) error); ok {
+		r1 = returnFunc(ctx, login)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -819,14 +3303,15 @@ type MockAuthService_Login_Call struct {
 }
 
 // Login is a helper method to define mock.On call
+//   - ctx
 //   - login
-func (_e *MockAuthService_Expecter) Login(login interface{}) *MockAuthService_Login_Call {
-	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", login)}
+func (_e *MockAuthService_Expecter) Login(ctx interface{}, login interface{}) *MockAuthService_Login_Call {
+	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, login)}
 }
 
-func (_c *MockAuthService_Login_Call) Run(run func(login model.Login)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, login model.Login)) *MockAuthService_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(model.Login))
+		run(args[0].(context.Context), args[1].(model.Login))
 	})
 	return _c
 }
@@ -836,14 +3321,14 @@ func (_c *MockAuthService_Login_Call) Return(accessToken model.AccessToken, err
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) RunAndReturn(run func(login model.Login) (model.AccessToken, error)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_Login_Call) RunAndReturn(run func(ctx context.Context, login model.Login) (model.AccessToken, error)) *MockAuthService_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Refresh provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) Refresh(refresh model.Refresh) (model.AccessToken, error) {
-	ret := _mock.Called(refresh)
+func (_mock *MockAuthService) Refresh(ctx context.Context, refresh model.Refresh) (model.AccessToken, error) {
+	ret := _mock.Called(ctx, refresh)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Refresh")
@@ -851,16 +3336,16 @@ func (_mock *MockAuthService) Refresh(refresh model.Refresh) (model.AccessToken,
 
 	var r0 model.AccessToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Refresh) (model.AccessToken, error)); ok {
-		return returnFunc(refresh)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Refresh) (model.AccessToken, error)); ok {
+		return returnFunc(ctx, refresh)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Refresh) model.AccessToken); ok {
-		r0 = returnFunc(refresh)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Refresh) model.AccessToken); ok {
+		r0 = returnFunc(ctx, refresh)
 	} else {
 		r0 = ret.Get(0).(model.AccessToken)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Refresh) error); ok {
-		r1 = returnFunc(refresh)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Refresh) error); ok {
+		r1 = returnFunc(ctx, refresh)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -873,14 +3358,15 @@ type MockAuthService_Refresh_Call struct {
 }
 
 // Refresh is a helper method to define mock.On call
+//   - ctx
 //   - refresh
-func (_e *MockAuthService_Expecter) Refresh(refresh interface{}) *MockAuthService_Refresh_Call {
-	return &MockAuthService_Refresh_Call{Call: _e.mock.On("Refresh", refresh)}
+func (_e *MockAuthService_Expecter) Refresh(ctx interface{}, refresh interface{}) *MockAuthService_Refresh_Call {
+	return &MockAuthService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refresh)}
 }
 
-func (_c *MockAuthService_Refresh_Call) Run(run func(refresh model.Refresh)) *MockAuthService_Refresh_Call {
+func (_c *MockAuthService_Refresh_Call) Run(run func(ctx context.Context, refresh model.Refresh)) *MockAuthService_Refresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(model.Refresh))
+		run(args[0].(context.Context), args[1].(model.Refresh))
 	})
 	return _c
 }
@@ -890,14 +3376,14 @@ func (_c *MockAuthService_Refresh_Call) Return(accessToken model.AccessToken, er
 	return _c
 }
 
-func (_c *MockAuthService_Refresh_Call) RunAndReturn(run func(refresh model.Refresh) (model.AccessToken, error)) *MockAuthService_Refresh_Call {
+func (_c *MockAuthService_Refresh_Call) RunAndReturn(run func(ctx context.Context, refresh model.Refresh) (model.AccessToken, error)) *MockAuthService_Refresh_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Register provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) Register(register model.Register) (model.AccessToken, error) {
-	ret := _mock.Called(register)
+func (_mock *MockAuthService) Register(ctx context.Context, register model.Register) (model.AccessToken, error) {
+	ret := _mock.Called(ctx, register)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Register")
@@ -905,16 +3391,16 @@ func (_mock *MockAuthService) Register(register model.Register) (model.AccessTok
 
 	var r0 model.AccessToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Register) (model.AccessToken, error)); ok {
-		return returnFunc(register)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Register) (model.AccessToken, error)); ok {
+		return returnFunc(ctx, register)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Register) model.AccessToken); ok {
-		r0 = returnFunc(register)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Register) model.AccessToken); ok {
+		r0 = returnFunc(ctx, register)
 	} else {
 		r0 = ret.Get(0).(model.AccessToken)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Register) error); ok {
-		r1 = returnFunc(register)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Register) error); ok {
+		r1 = returnFunc(ctx, register)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -927,14 +3413,15 @@ type MockAuthService_Register_Call struct {
 }
 
 // Register is a helper method to define mock.On call
+//   - ctx
 //   - register
-func (_e *MockAuthService_Expecter) Register(register interface{}) *MockAuthService_Register_Call {
-	return &MockAuthService_Register_Call{Call: _e.mock.On("Register", register)}
+func (_e *MockAuthService_Expecter) Register(ctx interface{}, register interface{}) *MockAuthService_Register_Call {
+	return &MockAuthService_Register_Call{Call: _e.mock.On("Register", ctx, register)}
 }
 
-func (_c *MockAuthService_Register_Call) Run(run func(register model.Register)) *MockAuthService_Register_Call {
+func (_c *MockAuthService_Register_Call) Run(run func(ctx context.Context, register model.Register)) *MockAuthService_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(model.Register))
+		run(args[0].(context.Context), args[1].(model.Register))
 	})
 	return _c
 }
@@ -944,7 +3431,7 @@ func (_c *MockAuthService_Register_Call) Return(accessToken model.AccessToken, e
 	return _c
 }
 
-func (_c *MockAuthService_Register_Call) RunAndReturn(run func(register model.Register) (model.AccessToken, error)) *MockAuthService_Register_Call {
+func (_c *MockAuthService_Register_Call) RunAndReturn(run func(ctx context.Context, register model.Register) (model.AccessToken, error)) *MockAuthService_Register_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,20 @@
+package model
+
+// Media is an uploaded image with a thumbnail.
+type Media struct {
+	ID           string `copier:"PublicID"`
+	URL          string
+	ThumbnailURL string
+	ContentType  string
+	Width        int
+	Height       int
+}
+
+// MaxMediaSize is the largest file accepted as media, in bytes.
+const MaxMediaSize = 10 << 20
+
+// MediaUpload is the content of an uploaded file. Its type is detected from
+// the content, whatever the client declared.
+type MediaUpload struct {
+	Data []byte
+}
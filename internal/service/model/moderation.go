@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"locpack-backend/pkg/types"
+)
+
+type ModerationAction struct {
+	ID         string
+	CreatedAt  time.Time
+	Moderator  User
+	Action     types.ModerationAction
+	TargetType string
+	TargetID   string
+	Reason     string
+}
+
+type Moderation struct {
+	Reason string
+}
+
+type PackTransfer struct {
+	UserID string
+	Reason string
+}
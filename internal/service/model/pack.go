@@ -1,21 +1,84 @@
 package model
 
-import "locpack-backend/pkg/types"
+import (
+	"time"
+
+	"locpack-backend/pkg/types"
+)
 
 type Pack struct {
-	ID     string
-	Name   string
-	Author User
-	Status types.PackStatus
-	Places []Place
+	ID         string
+	Name       string
+	Visibility types.PackVisibility
+	Version    int64
+	Author     User
+	Status     types.PackStatus
+	Places     []Place
+	Cover      *Media
+
+	Collaborators []Collaborator
+
+	// Progress is nil when the places of the pack are not loaded.
+	Progress *PackProgress
+
+	// NameHighlight is the HTML-escaped name with words matched by a search
+	// wrapped in <mark> elements.
+	NameHighlight string
+}
+
+// PackProgress counts the places of a pack visited by the current user.
+// CompletedAt is set when they visited its last place while following it,
+// as long as all of its places are still visited.
+type PackProgress struct {
+	Visited     int
+	Total       int
+	Percent     int
+	CompletedAt *time.Time
 }
 
 type PackCreate struct {
-	Name string
+	Name       string
+	Visibility types.PackVisibility
 }
 
+// PackUpdate changes a pack. When Versions is not nil, the update is applied
+// only if the pack still has one of those versions.
 type PackUpdate struct {
-	Name      string
-	Status    types.PackStatus
-	PlacesIDs []string
+	Name       string
+	Status     types.PackStatus
+	Visibility types.PackVisibility
+	PlacesIDs  []string
+	Versions   []int64
+}
+
+// Collaborator is a user invited to a pack by its author. Until the
+// invitation is accepted the user has no access to the pack.
+type Collaborator struct {
+	User     User
+	Role     types.CollaboratorRole
+	Accepted bool
+}
+
+type CollaboratorInvite struct {
+	UserID string
+	Role   types.CollaboratorRole
+}
+
+// ShareLink grants access to a pack to anyone who has its token, until it
+// expires or is revoked.
+type ShareLink struct {
+	ID        string
+	Token     string
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+}
+
+type ShareLinkCreate struct {
+	ExpiresAt *time.Time
+}
+
+type PackPlaceUpdate struct {
+	Position        *int
+	Note            *string
+	PlannedDuration *int
 }
@@ -0,0 +1,11 @@
+package model
+
+type Page struct {
+	Limit  int
+	Cursor string
+}
+
+type PageInfo struct {
+	NextCursor string
+	Total      int64
+}
@@ -1,20 +1,76 @@
 package model
 
+import "time"
+
 type Place struct {
 	ID      string `copier:"PublicID"`
 	Name    string
 	Address string
+
+	// Latitude and Longitude are both nil when the location is unknown.
+	Latitude  *float64
+	Longitude *float64
+	Distance  float64
+
 	Visited bool
+	Version int64
+
+	// LastVisitedAt is when the current user last visited the place, or nil
+	// when they have never visited it.
+	LastVisitedAt *time.Time
+
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country of the
+	// place, or empty when it is unknown.
+	CountryCode string
+
+	// RatingAverage is the average rating of the reviews of the place, or 0
+	// when it has none.
+	RatingAverage float64
+	RatingCount   int64
+
+	// Media is set only when a single place is requested.
+	Media []Media `copier:"-"`
+
+	// Set only by searches, see Pack.NameHighlight.
+	NameHighlight    string
+	AddressHighlight string
+
+	Position        int
+	Note            string
+	PlannedDuration int
 }
 
 type PlaceCreate struct {
-	Name    string
-	Address string
-	Visited bool
+	Name      string
+	Address   string
+	Latitude  *float64
+	Longitude *float64
+	Visited   bool
+
+	CountryCode string
+
+	// Visit records a check-in with its details and implies Visited.
+	Visit *VisitCreate
+
+	// IgnoreDuplicates creates the place even if similar places exist.
+	IgnoreDuplicates bool
 }
 
+// PlaceUpdate changes a place. When Versions is not nil, the update is
+// applied only if the place still has one of those versions.
 type PlaceUpdate struct {
-	Name    string
-	Address string
-	Visited bool
+	Name      string
+	Address   string
+	Latitude  *float64
+	Longitude *float64
+	Versions  []int64
+
+	CountryCode string
+}
+
+// PlaceMerge merges a place into the place with IntoID. Reason is recorded
+// when a moderator merges places.
+type PlaceMerge struct {
+	IntoID string
+	Reason string
 }
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type Review struct {
+	ID        string `copier:"PublicID"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Author    User
+	Rating    int
+	Text      string
+}
+
+type ReviewCreate struct {
+	Rating int
+	Text   string
+}
+
+// ReviewUpdate replaces the rating and the text of a review.
+type ReviewUpdate struct {
+	Rating int
+	Text   string
+}
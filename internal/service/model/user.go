@@ -1,6 +1,51 @@
 package model
 
+import (
+	"time"
+
+	"locpack-backend/pkg/types"
+)
+
 type User struct {
 	ID       string `copier:"PublicID"`
 	Username string
+
+	DisplayName string
+	Bio         string
+	HomeCity    string
+	AvatarURL   string
+
+	// Stats is set only when a single user is requested.
+	Stats *UserStats
+}
+
+// UserUpdate replaces the profile of a user.
+type UserUpdate struct {
+	DisplayName string
+	Bio         string
+	HomeCity    string
+	AvatarURL   string
+}
+
+// UserStats counts only content visible to everyone. Countries are the
+// distinct country codes the users entered for the visited places, and the
+// visited places without one are counted in UnknownCountryPlaces instead.
+type UserStats struct {
+	CreatedPacks         int64
+	Followers            int64
+	Following            int64
+	VisitedPlaces        int64
+	Countries            int64
+	UnknownCountryPlaces int64
+}
+
+// Activity is an event of a followed user. Pack and Place are set depending
+// on the type.
+type Activity struct {
+	ID        string
+	CreatedAt time.Time
+	Type      types.ActivityType
+	User      User
+	Pack      *Pack
+	Place     *Place
 }
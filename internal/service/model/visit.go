@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// Visit is a check-in of the current user at a place.
+type Visit struct {
+	ID        string `copier:"PublicID"`
+	VisitedAt time.Time
+	Note      string
+	Rating    *int
+	Place     Place
+}
+
+// VisitCreate records a check-in. A nil VisitedAt means now.
+type VisitCreate struct {
+	VisitedAt *time.Time
+	Note      string
+	Rating    *int
+}
+
+// VisitFilter selects the visits at or after From and before To. A nil
+// bound is open.
+type VisitFilter struct {
+	From *time.Time
+	To   *time.Time
+}
@@ -1,31 +1,94 @@
 package service
 
 import (
+	"context"
+
 	"locpack-backend/internal/service/model"
+	"locpack-backend/pkg/types"
 )
 
 type PlaceService interface {
-	GetByID(placeID string, userID string) (model.Place, error)
-	GetByNameOrAddress(query string, userID string) ([]model.Place, error)
-	Create(userID string, pc model.PlaceCreate) (model.Place, error)
-	UpdateByID(placeID string, userID string, pu model.PlaceUpdate) (model.Place, error)
+	GetByID(ctx context.Context, placeID string, userID string) (model.Place, error)
+	GetByNameOrAddress(ctx context.Context, query string, sort types.PlaceSort, userID string, page model.Page) ([]model.Place, model.PageInfo, error)
+	GetNearby(ctx context.Context, lat float64, lng float64, radius float64, userID string) ([]model.Place, error)
+	GetDuplicates(ctx context.Context, pc model.PlaceCreate, userID string) ([]model.Place, error)
+	Create(ctx context.Context, userID string, pc model.PlaceCreate) (model.Place, error)
+	UpdateByID(ctx context.Context, placeID string, userID string, pu model.PlaceUpdate) (model.Place, error)
+	DeleteByID(ctx context.Context, placeID string, userID string) error
+	RestoreByID(ctx context.Context, placeID string, userID string) (model.Place, error)
+	MergeByID(ctx context.Context, placeID string, userID string, pm model.PlaceMerge) (model.Place, error)
+}
+
+type ReviewService interface {
+	GetByPlaceID(ctx context.Context, placeID string, page model.Page) ([]model.Review, model.PageInfo, error)
+	Create(ctx context.Context, placeID string, userID string, rc model.ReviewCreate) (model.Review, error)
+	UpdateByID(ctx context.Context, placeID string, reviewID string, userID string, ru model.ReviewUpdate) (model.Review, error)
+	DeleteByID(ctx context.Context, placeID string, reviewID string, userID string) error
+}
+
+type VisitService interface {
+	GetByUserID(ctx context.Context, userID string, vf model.VisitFilter, page model.Page) ([]model.Visit, model.PageInfo, error)
+	Create(ctx context.Context, placeID string, userID string, vc model.VisitCreate) (model.Visit, error)
+	DeleteByID(ctx context.Context, visitID string, userID string) error
 }
 
 type PackService interface {
-	GetByID(packID string, userID string) (model.Pack, error)
-	GetByNameOrAuthor(query string, userID string) ([]model.Pack, error)
-	GetFollowedByUserID(userID string) ([]model.Pack, error)
-	GetCreatedByUserID(userID string) ([]model.Pack, error)
-	Create(userID string, pc model.PackCreate) (model.Pack, error)
-	UpdateByID(packID string, userID string, pu model.PackUpdate) (model.Pack, error)
+	GetByID(ctx context.Context, packID string, userID string) (model.Pack, error)
+	GetByNameOrAuthor(ctx context.Context, query string, userID string, page model.Page) ([]model.Pack, model.PageInfo, error)
+	GetFollowedByUserID(ctx context.Context, userID string, progress types.PackProgress) ([]model.Pack, error)
+	GetCreatedByUserID(ctx context.Context, userID string) ([]model.Pack, error)
+	Create(ctx context.Context, userID string, pc model.PackCreate) (model.Pack, error)
+	UpdateByID(ctx context.Context, packID string, userID string, pu model.PackUpdate) (model.Pack, error)
+	UpdatePlaceByID(ctx context.Context, packID string, placeID string, userID string, ppu model.PackPlaceUpdate) (model.Pack, error)
+	DeleteByID(ctx context.Context, packID string, userID string) error
+	RestoreByID(ctx context.Context, packID string, userID string) (model.Pack, error)
+	InviteCollaborator(ctx context.Context, packID string, userID string, ci model.CollaboratorInvite) (model.Collaborator, error)
+	AcceptInvitation(ctx context.Context, packID string, userID string) (model.Pack, error)
+	RemoveCollaborator(ctx context.Context, packID string, collaboratorID string, userID string) error
+	CreateShareLink(ctx context.Context, packID string, userID string, slc model.ShareLinkCreate) (model.ShareLink, error)
+	GetShareLinks(ctx context.Context, packID string, userID string) ([]model.ShareLink, error)
+	RevokeShareLink(ctx context.Context, packID string, linkID string, userID string) error
+	GetByShareToken(ctx context.Context, token string, userID string) (model.Pack, error)
+	FollowByShareToken(ctx context.Context, token string, userID string) (model.Pack, error)
 }
 
 type UserService interface {
-	GetByID(id string) (model.User, error)
+	GetByID(ctx context.Context, id string) (model.User, error)
+	UpdateByID(ctx context.Context, id string, uu model.UserUpdate) (model.User, error)
+	CheckNotBanned(ctx context.Context, id string) error
+	Follow(ctx context.Context, id string, userID string) error
+	Unfollow(ctx context.Context, id string, userID string) error
+	GetFeed(ctx context.Context, userID string, page model.Page) ([]model.Activity, model.PageInfo, error)
+}
+
+type MediaService interface {
+	AddToPlace(ctx context.Context, placeID string, userID string, mu model.MediaUpload) (model.Media, error)
+	DeleteFromPlace(ctx context.Context, placeID string, mediaID string, userID string) error
+	SetPackCover(ctx context.Context, packID string, userID string, mu model.MediaUpload) (model.Media, error)
+	DeletePackCover(ctx context.Context, packID string, userID string) error
+}
+
+type ModerationService interface {
+	GetLog(ctx context.Context, page model.Page) ([]model.ModerationAction, model.PageInfo, error)
+	HidePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error
+	UnhidePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error
+	DeletePlace(ctx context.Context, placeID string, moderatorID string, m model.Moderation) error
+	HidePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error
+	UnhidePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error
+	DeletePack(ctx context.Context, packID string, moderatorID string, m model.Moderation) error
+	TransferPack(ctx context.Context, packID string, moderatorID string, pt model.PackTransfer) error
+	MergePlace(ctx context.Context, placeID string, moderatorID string, pm model.PlaceMerge) error
+	DeleteReview(ctx context.Context, reviewID string, moderatorID string, m model.Moderation) error
+	BanUser(ctx context.Context, userID string, moderatorID string, m model.Moderation) error
+	UnbanUser(ctx context.Context, userID string, moderatorID string, m model.Moderation) error
+}
+
+type HealthService interface {
+	Ready(ctx context.Context) error
 }
 
 type AuthService interface {
-	Register(register model.Register) (model.AccessToken, error)
-	Login(login model.Login) (model.AccessToken, error)
-	Refresh(refresh model.Refresh) (model.AccessToken, error)
+	Register(ctx context.Context, register model.Register) (model.AccessToken, error)
+	Login(ctx context.Context, login model.Login) (model.AccessToken, error)
+	Refresh(ctx context.Context, refresh model.Refresh) (model.AccessToken, error)
 }
@@ -4,49 +4,243 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type Place struct {
-	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
-	CreatedAt time.Time `gorm:"not null"`
-	UpdatedAt time.Time `gorm:"not null"`
+	ID        uuid.UUID      `gorm:"primaryKey;type:uuid"`
+	CreatedAt time.Time      `gorm:"not null"`
+	UpdatedAt time.Time      `gorm:"not null"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 
 	PublicID string `gorm:"unique;not null"`
 	Name     string `gorm:"not null"`
 	Address  string `gorm:"not null"`
+	HiddenAt *time.Time
+	Version  int64 `gorm:"not null;default:1"`
+
+	Latitude    *float64
+	Longitude   *float64
+	Distance    float64 `gorm:"->;-:migration"`
+	CountryCode string  `gorm:"not null;default:''"`
+
+	RatingAverage float64 `gorm:"->;-:migration"`
+	RatingCount   int64   `gorm:"->;-:migration"`
+
+	NameHeadline    string  `gorm:"->;-:migration"`
+	AddressHeadline string  `gorm:"->;-:migration"`
+	Rank            float64 `gorm:"->;-:migration"`
 
 	AuthorID uuid.UUID `gorm:"type:uuid;not null"`
 	Author   User      `gorm:"foreignKey:AuthorID"`
 
-	Visitors []User `gorm:"many2many:user_visited_places"`
-	Packs    []Pack `gorm:"many2many:place_packs"`
+	Visitors []PlaceVisitor `gorm:"foreignKey:PlaceID"`
+	Packs    []Pack         `gorm:"many2many:place_packs"`
+	Media    []Media        `gorm:"foreignKey:PlaceID"`
 }
 
 type Pack struct {
+	ID        uuid.UUID      `gorm:"primaryKey;type:uuid"`
+	CreatedAt time.Time      `gorm:"not null"`
+	UpdatedAt time.Time      `gorm:"not null"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	PublicID   string `gorm:"unique;not null"`
+	Name       string `gorm:"not null"`
+	Visibility string `gorm:"not null;default:PUBLIC"`
+	HiddenAt   *time.Time
+	Version    int64 `gorm:"not null;default:1"`
+
+	NameHeadline string  `gorm:"->;-:migration"`
+	Rank         float64 `gorm:"->;-:migration"`
+
+	// CompletedAt is when the user the pack was loaded for visited its last
+	// place while following it.
+	CompletedAt *time.Time `gorm:"->;-:migration"`
+
+	AuthorID uuid.UUID `gorm:"type:uuid;not null"`
+	Author   User      `gorm:"foreignKey:AuthorID"`
+
+	Places        []Place            `gorm:"many2many:pack_places"`
+	PlaceEntries  []PackPlace        `gorm:"foreignKey:PackID"`
+	FollowedUsers []User             `gorm:"many2many:pack_followed_users"`
+	Collaborators []PackCollaborator `gorm:"foreignKey:PackID"`
+	Cover         *Media             `gorm:"foreignKey:PackID"`
+}
+
+type PackPlace struct {
+	PackID  uuid.UUID `gorm:"primaryKey;type:uuid"`
+	PlaceID uuid.UUID `gorm:"primaryKey;type:uuid"`
+
+	Position        int    `gorm:"not null;default:0"`
+	Note            string `gorm:"not null;default:''"`
+	PlannedDuration int    `gorm:"not null;default:0"`
+
+	Place Place `gorm:"foreignKey:PlaceID"`
+}
+
+type PackCollaborator struct {
+	PackID    uuid.UUID `gorm:"primaryKey;type:uuid"`
+	UserID    uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt time.Time `gorm:"not null"`
+
+	Role       string `gorm:"not null"`
+	AcceptedAt *time.Time
+
+	User User `gorm:"foreignKey:UserID"`
+}
+
+type ShareLink struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt time.Time `gorm:"not null"`
+
+	PublicID  string    `gorm:"unique;not null"`
+	PackID    uuid.UUID `gorm:"type:uuid;not null"`
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+}
+
+type User struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+
+	PublicID  string `gorm:"unique;not null"`
+	Username  string `gorm:"unique;not null"`
+	BannedAt  *time.Time
+	BanReason string `gorm:"not null;default:''"`
+
+	DisplayName string `gorm:"not null;default:''"`
+	Bio         string `gorm:"not null;default:''"`
+	HomeCity    string `gorm:"not null;default:''"`
+	AvatarURL   string `gorm:"not null;default:''"`
+
+	FollowerCount     int64 `gorm:"->;-:migration"`
+	FollowingCount    int64 `gorm:"->;-:migration"`
+	CreatedPackCount  int64 `gorm:"->;-:migration"`
+	VisitedPlaceCount int64 `gorm:"->;-:migration"`
+	CountryCount      int64 `gorm:"->;-:migration"`
+
+	UnknownCountryPlaceCount int64 `gorm:"->;-:migration"`
+
+	FollowedPacks []Pack  `gorm:"many2many:pack_followed_users"`
+	CreatedPacks  []Pack  `gorm:"foreignKey:AuthorID"`
+	Visits        []Visit `gorm:"foreignKey:UserID"`
+	CreatedPlaces []Place `gorm:"foreignKey:AuthorID"`
+}
+
+type UserFollow struct {
+	FollowerID uuid.UUID `gorm:"primaryKey;type:uuid"`
+	FolloweeID uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt  time.Time `gorm:"not null"`
+}
+
+// Activity is an event shown in the feed of the followers of its user.
+// PackID and PlaceID are set depending on the type.
+type Activity struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt time.Time `gorm:"not null"`
+
+	PublicID string    `gorm:"unique;not null"`
+	UserID   uuid.UUID `gorm:"type:uuid;not null"`
+	User     User      `gorm:"foreignKey:UserID"`
+
+	Type    string     `gorm:"not null"`
+	PackID  *uuid.UUID `gorm:"type:uuid"`
+	Pack    *Pack      `gorm:"foreignKey:PackID"`
+	PlaceID *uuid.UUID `gorm:"type:uuid"`
+	Place   *Place     `gorm:"foreignKey:PlaceID"`
+}
+
+// Media is an uploaded image with its thumbnail, either in the gallery of a
+// place or as the cover of a pack. Keys locate the files in the blob storage
+// and URLs are where they are served.
+type Media struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt time.Time `gorm:"not null"`
+
+	PublicID string `gorm:"unique;not null"`
+
+	AuthorID uuid.UUID  `gorm:"type:uuid;not null"`
+	Author   User       `gorm:"foreignKey:AuthorID"`
+	PlaceID  *uuid.UUID `gorm:"type:uuid"`
+	PackID   *uuid.UUID `gorm:"type:uuid"`
+
+	ContentType string `gorm:"not null"`
+	Width       int    `gorm:"not null"`
+	Height      int    `gorm:"not null"`
+	Size        int64  `gorm:"not null"`
+
+	Key          string `gorm:"not null"`
+	ThumbnailKey string `gorm:"not null"`
+	URL          string `gorm:"not null"`
+	ThumbnailURL string `gorm:"not null"`
+}
+
+func (Media) TableName() string {
+	return "media"
+}
+
+// Review is the rating and opinion of a user about a place. A user has at
+// most one review per place.
+type Review struct {
 	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
 	CreatedAt time.Time `gorm:"not null"`
 	UpdatedAt time.Time `gorm:"not null"`
 
 	PublicID string `gorm:"unique;not null"`
-	Name     string `gorm:"not null"`
 
+	PlaceID  uuid.UUID `gorm:"type:uuid;not null"`
+	Place    Place     `gorm:"foreignKey:PlaceID"`
 	AuthorID uuid.UUID `gorm:"type:uuid;not null"`
 	Author   User      `gorm:"foreignKey:AuthorID"`
 
-	Places        []Place `gorm:"many2many:pack_places"`
-	FollowedUsers []User  `gorm:"many2many:pack_followed_users"`
+	Rating int    `gorm:"not null"`
+	Text   string `gorm:"not null;default:''"`
 }
 
-type User struct {
+// Visit is a check-in of a user at a place. A user can visit a place many
+// times.
+type Visit struct {
 	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
 	CreatedAt time.Time `gorm:"not null"`
-	UpdatedAt time.Time `gorm:"not null"`
 
 	PublicID string `gorm:"unique;not null"`
-	Username string `gorm:"unique;not null"`
 
-	FollowedPacks []Pack  `gorm:"many2many:user_followed_packs"`
-	CreatedPacks  []Pack  `gorm:"foreignKey:AuthorID"`
-	VisitedPlaces []Place `gorm:"many2many:user_visited_places"`
-	CreatedPlaces []Place `gorm:"foreignKey:AuthorID"`
+	UserID  uuid.UUID `gorm:"type:uuid;not null"`
+	User    User      `gorm:"foreignKey:UserID"`
+	PlaceID uuid.UUID `gorm:"type:uuid;not null"`
+	Place   Place     `gorm:"foreignKey:PlaceID"`
+
+	VisitedAt time.Time `gorm:"not null"`
+	Note      string    `gorm:"not null;default:''"`
+	Rating    *int
+}
+
+// PlaceVisitor is a user who has visited a place at least once. It is read
+// from the user_visited_places view over visits and cannot be written.
+type PlaceVisitor struct {
+	UserID        uuid.UUID `gorm:"primaryKey;type:uuid"`
+	PlaceID       uuid.UUID `gorm:"primaryKey;type:uuid"`
+	LastVisitedAt time.Time
+
+	User User `gorm:"foreignKey:UserID"`
+}
+
+func (PlaceVisitor) TableName() string {
+	return "user_visited_places"
+}
+
+type ModerationAction struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt time.Time `gorm:"not null"`
+
+	PublicID    string    `gorm:"unique;not null"`
+	ModeratorID uuid.UUID `gorm:"type:uuid;not null"`
+	Moderator   User      `gorm:"foreignKey:ModeratorID"`
+
+	Action     string `gorm:"not null"`
+	TargetType string `gorm:"not null"`
+	TargetID   string `gorm:"not null"`
+	Reason     string `gorm:"not null;default:''"`
 }
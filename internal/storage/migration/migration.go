@@ -0,0 +1,290 @@
+package migration
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"locpack-backend/pkg/adapter"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// lockID is the key of the PostgreSQL advisory lock held while migrating,
+// so that only one instance changes the schema at a time.
+const lockID int64 = 0x6c6f637061636b
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+var ErrNoApplied = errors.New("no applied migrations")
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type appliedMigration struct {
+	Version   int64
+	Name      string
+	AppliedAt time.Time
+}
+
+func (appliedMigration) TableName() string {
+	return "schema_migrations"
+}
+
+type Migrator struct {
+	db         adapter.Database
+	migrations []Migration
+}
+
+func New(db adapter.Database) (*Migrator, error) {
+	migrations, err := load(files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db, migrations}, nil
+}
+
+// Up applies all pending migrations in order and returns the applied ones.
+func (m *Migrator) Up() ([]Migration, error) {
+	var applied []Migration
+
+	err := m.locked(func(conn adapter.Database) error {
+		done, err := appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+
+			err = conn.Transaction(func(tx adapter.Database) error {
+				err := tx.Exec(migration.Up).Error
+				if err != nil {
+					return err
+				}
+				return tx.Create(&appliedMigration{
+					Version:   migration.Version,
+					Name:      migration.Name,
+					AppliedAt: time.Now(),
+				}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migration %s: %w", migration, err)
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down rolls back the latest applied migration.
+func (m *Migrator) Down() (Migration, error) {
+	var rolledBack Migration
+
+	err := m.locked(func(conn adapter.Database) error {
+		var latest appliedMigration
+		result := conn.Order("version DESC").Limit(1).Find(&latest)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNoApplied
+		}
+
+		migration, ok := m.find(latest.Version)
+		if !ok {
+			return fmt.Errorf("migration %d is applied but unknown to this binary", latest.Version)
+		}
+
+		err := conn.Transaction(func(tx adapter.Database) error {
+			err := tx.Exec(migration.Down).Error
+			if err != nil {
+				return err
+			}
+			return tx.Delete(&appliedMigration{}, "version = ?", migration.Version).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", migration, err)
+		}
+
+		rolledBack = migration
+		return nil
+	})
+
+	return rolledBack, err
+}
+
+// Status lists known migrations with the time they were applied, if any.
+func (m *Migrator) Status() ([]Status, error) {
+	err := createTable(m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	done, err := appliedVersions(m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []Status
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if applied, ok := done[migration.Version]; ok {
+			status.AppliedAt = &applied.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// Pending lists known migrations that are not applied yet.
+func (m *Migrator) Pending() ([]Migration, error) {
+	statuses, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	return pending(statuses), nil
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+// locked runs fc on a single connection holding the advisory lock. Session
+// level locks are released together with the connection if the process dies.
+func (m *Migrator) locked(fc func(conn adapter.Database) error) error {
+	return m.db.Connection(func(conn adapter.Database) error {
+		err := conn.Exec("SELECT pg_advisory_lock(?)", lockID).Error
+		if err != nil {
+			return err
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", lockID)
+
+		err = createTable(conn)
+		if err != nil {
+			return err
+		}
+
+		return fc(conn)
+	})
+}
+
+func createTable(db adapter.Database) error {
+	return db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    bigint PRIMARY KEY,
+		name       text NOT NULL,
+		applied_at timestamptz NOT NULL
+	)`).Error
+}
+
+func pending(statuses []Status) []Migration {
+	var migrations []Migration
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			migrations = append(migrations, status.Migration)
+		}
+	}
+	return migrations
+}
+
+func appliedVersions(db adapter.Database) (map[int64]appliedMigration, error) {
+	var applied []appliedMigration
+	err := db.Find(&applied).Error
+	if err != nil {
+		return nil, err
+	}
+
+	done := map[int64]appliedMigration{}
+	for _, migration := range applied {
+		done[migration.Version] = migration
+	}
+	return done, nil
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	paths, err := fs.Glob(fsys, "sql/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, p := range paths {
+		match := fileName.FindStringSubmatch(path.Base(p))
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file name %q", p)
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has different names %q and %q", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	var migrations []Migration
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %s must have both up and down files", migration)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
@@ -0,0 +1,97 @@
+package migration
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		files    fstest.MapFS
+		versions []int64
+		wantErr  bool
+	}{
+		{
+			name: "sorted by version",
+			files: fstest.MapFS{
+				"sql/0002_second.up.sql":   {Data: []byte("SELECT 2")},
+				"sql/0002_second.down.sql": {Data: []byte("SELECT -2")},
+				"sql/0001_first.up.sql":    {Data: []byte("SELECT 1")},
+				"sql/0001_first.down.sql":  {Data: []byte("SELECT -1")},
+			},
+			versions: []int64{1, 2},
+		},
+		{
+			name: "missing down file",
+			files: fstest.MapFS{
+				"sql/0001_first.up.sql": {Data: []byte("SELECT 1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "different names",
+			files: fstest.MapFS{
+				"sql/0001_first.up.sql":   {Data: []byte("SELECT 1")},
+				"sql/0001_other.down.sql": {Data: []byte("SELECT -1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unexpected file name",
+			files: fstest.MapFS{
+				"sql/first.sql": {Data: []byte("SELECT 1")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := load(tt.files)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			var versions []int64
+			for _, m := range migrations {
+				versions = append(versions, m.Version)
+			}
+			assert.Equal(t, tt.versions, versions)
+		})
+	}
+}
+
+func TestLoad_Embedded(t *testing.T) {
+	t.Parallel()
+
+	migrations, err := load(files)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, migrations)
+	for i, m := range migrations {
+		assert.Equal(t, int64(i+1), m.Version, "migration versions must be contiguous")
+	}
+}
+
+func TestPending(t *testing.T) {
+	t.Parallel()
+
+	appliedAt := time.Now()
+	statuses := []Status{
+		{Migration: Migration{Version: 1, Name: "first"}, AppliedAt: &appliedAt},
+		{Migration: Migration{Version: 2, Name: "second"}},
+		{Migration: Migration{Version: 3, Name: "third"}},
+	}
+
+	assert.Equal(t, []Migration{{Version: 2, Name: "second"}, {Version: 3, Name: "third"}}, pending(statuses))
+	assert.Empty(t, pending(statuses[:1]))
+}
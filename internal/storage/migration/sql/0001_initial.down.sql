@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS user_visited_places;
+DROP TABLE IF EXISTS user_followed_packs;
+DROP TABLE IF EXISTS pack_followed_users;
+DROP TABLE IF EXISTS place_packs;
+DROP TABLE IF EXISTS pack_places;
+DROP TABLE IF EXISTS packs;
+DROP TABLE IF EXISTS places;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,71 @@
+-- Baseline schema. Statements are idempotent so that databases created by
+-- the former GORM AutoMigrate are adopted without changes.
+
+CREATE TABLE IF NOT EXISTS users (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    public_id  text NOT NULL CONSTRAINT uni_users_public_id UNIQUE,
+    username   text NOT NULL CONSTRAINT uni_users_username UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS places (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    public_id  text NOT NULL CONSTRAINT uni_places_public_id UNIQUE,
+    name       text NOT NULL,
+    address    text NOT NULL,
+    author_id  uuid NOT NULL CONSTRAINT fk_places_author REFERENCES users (id)
+);
+
+CREATE TABLE IF NOT EXISTS packs (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    public_id  text NOT NULL CONSTRAINT uni_packs_public_id UNIQUE,
+    name       text NOT NULL,
+    author_id  uuid NOT NULL CONSTRAINT fk_packs_author REFERENCES users (id)
+);
+
+ALTER TABLE places ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+ALTER TABLE places ADD COLUMN IF NOT EXISTS latitude decimal NOT NULL DEFAULT 0;
+ALTER TABLE places ADD COLUMN IF NOT EXISTS longitude decimal NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_places_deleted_at ON places (deleted_at);
+
+ALTER TABLE packs ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_packs_deleted_at ON packs (deleted_at);
+
+CREATE TABLE IF NOT EXISTS pack_places (
+    pack_id  uuid NOT NULL CONSTRAINT fk_pack_places_pack REFERENCES packs (id),
+    place_id uuid NOT NULL CONSTRAINT fk_pack_places_place REFERENCES places (id),
+    PRIMARY KEY (pack_id, place_id)
+);
+
+ALTER TABLE pack_places ADD COLUMN IF NOT EXISTS position bigint NOT NULL DEFAULT 0;
+ALTER TABLE pack_places ADD COLUMN IF NOT EXISTS note text NOT NULL DEFAULT '';
+ALTER TABLE pack_places ADD COLUMN IF NOT EXISTS planned_duration bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS place_packs (
+    place_id uuid NOT NULL CONSTRAINT fk_place_packs_place REFERENCES places (id),
+    pack_id  uuid NOT NULL CONSTRAINT fk_place_packs_pack REFERENCES packs (id),
+    PRIMARY KEY (place_id, pack_id)
+);
+
+CREATE TABLE IF NOT EXISTS pack_followed_users (
+    pack_id uuid NOT NULL CONSTRAINT fk_pack_followed_users_pack REFERENCES packs (id),
+    user_id uuid NOT NULL CONSTRAINT fk_pack_followed_users_user REFERENCES users (id),
+    PRIMARY KEY (pack_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS user_followed_packs (
+    user_id uuid NOT NULL CONSTRAINT fk_user_followed_packs_user REFERENCES users (id),
+    pack_id uuid NOT NULL CONSTRAINT fk_user_followed_packs_pack REFERENCES packs (id),
+    PRIMARY KEY (user_id, pack_id)
+);
+
+CREATE TABLE IF NOT EXISTS user_visited_places (
+    user_id  uuid NOT NULL CONSTRAINT fk_user_visited_places_user REFERENCES users (id),
+    place_id uuid NOT NULL CONSTRAINT fk_user_visited_places_place REFERENCES places (id),
+    PRIMARY KEY (user_id, place_id)
+);
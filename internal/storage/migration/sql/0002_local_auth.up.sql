@@ -0,0 +1,22 @@
+CREATE TABLE auth_credentials (
+    id            uuid PRIMARY KEY,
+    created_at    timestamptz NOT NULL,
+    updated_at    timestamptz NOT NULL,
+    username      text NOT NULL CONSTRAINT uni_auth_credentials_username UNIQUE,
+    email         text NOT NULL,
+    password_hash text NOT NULL,
+    roles         text NOT NULL DEFAULT 'user'
+);
+
+CREATE TABLE auth_refresh_tokens (
+    id            uuid PRIMARY KEY,
+    created_at    timestamptz NOT NULL,
+    credential_id uuid NOT NULL CONSTRAINT fk_auth_refresh_tokens_credential REFERENCES auth_credentials (id) ON DELETE CASCADE,
+    family_id     uuid NOT NULL,
+    token_hash    text NOT NULL CONSTRAINT uni_auth_refresh_tokens_token_hash UNIQUE,
+    expires_at    timestamptz NOT NULL,
+    revoked_at    timestamptz
+);
+
+CREATE INDEX idx_auth_refresh_tokens_family_id ON auth_refresh_tokens (family_id);
+CREATE INDEX idx_auth_refresh_tokens_credential_id ON auth_refresh_tokens (credential_id);
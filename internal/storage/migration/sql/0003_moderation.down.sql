@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS moderation_actions;
+
+ALTER TABLE users DROP COLUMN IF EXISTS ban_reason;
+ALTER TABLE users DROP COLUMN IF EXISTS banned_at;
+
+ALTER TABLE packs DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE places DROP COLUMN IF EXISTS hidden_at;
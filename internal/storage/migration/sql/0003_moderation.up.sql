@@ -0,0 +1,18 @@
+ALTER TABLE places ADD COLUMN hidden_at timestamptz;
+ALTER TABLE packs ADD COLUMN hidden_at timestamptz;
+
+ALTER TABLE users ADD COLUMN banned_at timestamptz;
+ALTER TABLE users ADD COLUMN ban_reason text NOT NULL DEFAULT '';
+
+CREATE TABLE moderation_actions (
+    id           uuid PRIMARY KEY,
+    created_at   timestamptz NOT NULL,
+    public_id    text NOT NULL CONSTRAINT uni_moderation_actions_public_id UNIQUE,
+    moderator_id uuid NOT NULL CONSTRAINT fk_moderation_actions_moderator REFERENCES users (id),
+    action       text NOT NULL,
+    target_type  text NOT NULL,
+    target_id    text NOT NULL,
+    reason       text NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_moderation_actions_created_at ON moderation_actions (created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE packs DROP COLUMN IF EXISTS version;
+ALTER TABLE places DROP COLUMN IF EXISTS version;
@@ -0,0 +1,2 @@
+ALTER TABLE places ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE packs ADD COLUMN version bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,10 @@
+CREATE TABLE pack_collaborators (
+    pack_id     uuid NOT NULL CONSTRAINT fk_pack_collaborators_pack REFERENCES packs (id) ON DELETE CASCADE,
+    user_id     uuid NOT NULL CONSTRAINT fk_pack_collaborators_user REFERENCES users (id) ON DELETE CASCADE,
+    created_at  timestamptz NOT NULL,
+    role        text NOT NULL,
+    accepted_at timestamptz,
+    PRIMARY KEY (pack_id, user_id)
+);
+
+CREATE INDEX idx_pack_collaborators_user_id ON pack_collaborators (user_id);
@@ -0,0 +1 @@
+ALTER TABLE packs DROP COLUMN IF EXISTS visibility;
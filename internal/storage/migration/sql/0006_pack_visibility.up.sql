@@ -0,0 +1 @@
+ALTER TABLE packs ADD COLUMN visibility text NOT NULL DEFAULT 'PUBLIC';
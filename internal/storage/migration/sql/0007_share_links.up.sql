@@ -0,0 +1,10 @@
+CREATE TABLE share_links (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz NOT NULL,
+    public_id  text NOT NULL CONSTRAINT uni_share_links_public_id UNIQUE,
+    pack_id    uuid NOT NULL CONSTRAINT fk_share_links_pack REFERENCES packs (id) ON DELETE CASCADE,
+    expires_at timestamptz,
+    revoked_at timestamptz
+);
+
+CREATE INDEX idx_share_links_pack_id ON share_links (pack_id);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_users_username_trgm;
+DROP INDEX IF EXISTS idx_packs_name_trgm;
+DROP INDEX IF EXISTS idx_packs_search;
+ALTER TABLE packs DROP COLUMN IF EXISTS search;
+
+DROP INDEX IF EXISTS idx_places_address_trgm;
+DROP INDEX IF EXISTS idx_places_name_trgm;
+DROP INDEX IF EXISTS idx_places_search;
+ALTER TABLE places DROP COLUMN IF EXISTS search;
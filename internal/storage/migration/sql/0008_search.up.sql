@@ -0,0 +1,13 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE places ADD COLUMN search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', name), 'A') || setweight(to_tsvector('simple', address), 'B')
+) STORED;
+CREATE INDEX idx_places_search ON places USING gin (search);
+CREATE INDEX idx_places_name_trgm ON places USING gin (name gin_trgm_ops);
+CREATE INDEX idx_places_address_trgm ON places USING gin (address gin_trgm_ops);
+
+ALTER TABLE packs ADD COLUMN search tsvector GENERATED ALWAYS AS (to_tsvector('simple', name)) STORED;
+CREATE INDEX idx_packs_search ON packs USING gin (search);
+CREATE INDEX idx_packs_name_trgm ON packs USING gin (name gin_trgm_ops);
+CREATE INDEX idx_users_username_trgm ON users USING gin (username gin_trgm_ops);
@@ -0,0 +1,20 @@
+CREATE TABLE user_follows (
+    follower_id uuid NOT NULL CONSTRAINT fk_user_follows_follower REFERENCES users (id) ON DELETE CASCADE,
+    followee_id uuid NOT NULL CONSTRAINT fk_user_follows_followee REFERENCES users (id) ON DELETE CASCADE,
+    created_at  timestamptz NOT NULL,
+    PRIMARY KEY (follower_id, followee_id)
+);
+
+CREATE INDEX idx_user_follows_followee_id ON user_follows (followee_id);
+
+CREATE TABLE activities (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz NOT NULL,
+    public_id  text NOT NULL CONSTRAINT uni_activities_public_id UNIQUE,
+    user_id    uuid NOT NULL CONSTRAINT fk_activities_user REFERENCES users (id) ON DELETE CASCADE,
+    type       text NOT NULL,
+    pack_id    uuid CONSTRAINT fk_activities_pack REFERENCES packs (id) ON DELETE CASCADE,
+    place_id   uuid CONSTRAINT fk_activities_place REFERENCES places (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_activities_user_id_created_at ON activities (user_id, created_at);
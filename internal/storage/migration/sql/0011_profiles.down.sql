@@ -0,0 +1,6 @@
+ALTER TABLE places DROP COLUMN IF EXISTS country_code;
+
+ALTER TABLE users DROP COLUMN IF EXISTS avatar_url;
+ALTER TABLE users DROP COLUMN IF EXISTS home_city;
+ALTER TABLE users DROP COLUMN IF EXISTS bio;
+ALTER TABLE users DROP COLUMN IF EXISTS display_name;
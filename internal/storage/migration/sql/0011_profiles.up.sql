@@ -0,0 +1,6 @@
+ALTER TABLE users ADD COLUMN display_name text NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN bio text NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN home_city text NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN avatar_url text NOT NULL DEFAULT '';
+
+ALTER TABLE places ADD COLUMN country_code text NOT NULL DEFAULT '';
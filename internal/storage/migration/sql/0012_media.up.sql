@@ -0,0 +1,20 @@
+CREATE TABLE media (
+    id            uuid PRIMARY KEY,
+    created_at    timestamptz NOT NULL,
+    public_id     text NOT NULL CONSTRAINT uni_media_public_id UNIQUE,
+    author_id     uuid NOT NULL CONSTRAINT fk_media_author REFERENCES users (id) ON DELETE CASCADE,
+    place_id      uuid CONSTRAINT fk_media_place REFERENCES places (id) ON DELETE CASCADE,
+    pack_id       uuid CONSTRAINT fk_media_pack REFERENCES packs (id) ON DELETE CASCADE,
+    content_type  text NOT NULL,
+    width         integer NOT NULL,
+    height        integer NOT NULL,
+    size          bigint NOT NULL,
+    key           text NOT NULL,
+    thumbnail_key text NOT NULL,
+    url           text NOT NULL,
+    thumbnail_url text NOT NULL,
+    CONSTRAINT chk_media_owner CHECK ((place_id IS NULL) <> (pack_id IS NULL))
+);
+
+CREATE INDEX idx_media_place_id ON media (place_id);
+CREATE UNIQUE INDEX idx_media_pack_id ON media (pack_id);
@@ -0,0 +1,12 @@
+CREATE TABLE reviews (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    public_id  text NOT NULL CONSTRAINT uni_reviews_public_id UNIQUE,
+    place_id   uuid NOT NULL CONSTRAINT fk_reviews_place REFERENCES places (id) ON DELETE CASCADE,
+    author_id  uuid NOT NULL CONSTRAINT fk_reviews_author REFERENCES users (id) ON DELETE CASCADE,
+    rating     smallint NOT NULL CONSTRAINT chk_reviews_rating CHECK (rating BETWEEN 1 AND 5),
+    text       text NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_reviews_place_author ON reviews (place_id, author_id);
@@ -0,0 +1,13 @@
+DROP VIEW IF EXISTS user_visited_places;
+
+CREATE TABLE user_visited_places (
+    user_id  uuid NOT NULL CONSTRAINT fk_user_visited_places_user REFERENCES users (id),
+    place_id uuid NOT NULL CONSTRAINT fk_user_visited_places_place REFERENCES places (id),
+    PRIMARY KEY (user_id, place_id)
+);
+
+INSERT INTO user_visited_places (user_id, place_id)
+SELECT DISTINCT user_id, place_id
+FROM visits;
+
+DROP TABLE IF EXISTS visits;
@@ -0,0 +1,47 @@
+CREATE TABLE visits (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz NOT NULL,
+    public_id  text NOT NULL CONSTRAINT uni_visits_public_id UNIQUE,
+    user_id    uuid NOT NULL CONSTRAINT fk_visits_user REFERENCES users (id) ON DELETE CASCADE,
+    place_id   uuid NOT NULL CONSTRAINT fk_visits_place REFERENCES places (id) ON DELETE CASCADE,
+    visited_at timestamptz NOT NULL,
+    note       text NOT NULL DEFAULT '',
+    rating     smallint CONSTRAINT chk_visits_rating CHECK (rating BETWEEN 1 AND 5)
+);
+
+CREATE INDEX idx_visits_user_visited_at ON visits (user_id, visited_at);
+CREATE INDEX idx_visits_place_id ON visits (place_id);
+
+-- Visits recorded before the log existed have no date, so they are dated
+-- when they are migrated. Their public IDs are drawn like the ones of the
+-- application, so the visits whose IDs collide are inserted again with new
+-- ones until all are migrated.
+DO $$
+BEGIN
+    LOOP
+        INSERT INTO visits (id, created_at, public_id, user_id, place_id, visited_at)
+        SELECT gen_random_uuid(), now(), left(gen_random_uuid()::text, 8), visited.user_id, visited.place_id, now()
+        FROM user_visited_places visited
+        WHERE NOT EXISTS (
+            SELECT 1 FROM visits WHERE visits.user_id = visited.user_id AND visits.place_id = visited.place_id
+        )
+        ON CONFLICT (public_id) DO NOTHING;
+
+        EXIT WHEN NOT EXISTS (
+            SELECT 1
+            FROM user_visited_places visited
+            WHERE NOT EXISTS (
+                SELECT 1 FROM visits WHERE visits.user_id = visited.user_id AND visits.place_id = visited.place_id
+            )
+        );
+    END LOOP;
+END
+$$;
+
+DROP TABLE user_visited_places;
+
+-- The places visited by users are derived from their visits.
+CREATE VIEW user_visited_places AS
+SELECT user_id, place_id, max(visited_at) AS last_visited_at
+FROM visits
+GROUP BY user_id, place_id;
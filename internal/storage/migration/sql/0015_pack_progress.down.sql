@@ -0,0 +1,11 @@
+ALTER TABLE pack_followed_users DROP COLUMN IF EXISTS completed_at;
+
+CREATE TABLE user_followed_packs (
+    user_id uuid NOT NULL CONSTRAINT fk_user_followed_packs_user REFERENCES users (id),
+    pack_id uuid NOT NULL CONSTRAINT fk_user_followed_packs_pack REFERENCES packs (id),
+    PRIMARY KEY (user_id, pack_id)
+);
+
+INSERT INTO user_followed_packs (user_id, pack_id)
+SELECT user_id, pack_id
+FROM pack_followed_users;
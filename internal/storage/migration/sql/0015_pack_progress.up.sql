@@ -0,0 +1,10 @@
+-- Packs are followed through pack_followed_users, so the packs followed by
+-- users are read from it as well.
+INSERT INTO pack_followed_users (pack_id, user_id)
+SELECT pack_id, user_id
+FROM user_followed_packs
+ON CONFLICT DO NOTHING;
+
+DROP TABLE user_followed_packs;
+
+ALTER TABLE pack_followed_users ADD COLUMN completed_at timestamptz;
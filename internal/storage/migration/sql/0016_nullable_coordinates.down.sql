@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_places_latitude_longitude;
+
+ALTER TABLE places DROP CONSTRAINT IF EXISTS chk_places_coordinates;
+
+UPDATE places SET latitude = 0, longitude = 0 WHERE latitude IS NULL OR longitude IS NULL;
+
+ALTER TABLE places ALTER COLUMN latitude SET DEFAULT 0;
+ALTER TABLE places ALTER COLUMN latitude SET NOT NULL;
+ALTER TABLE places ALTER COLUMN longitude SET DEFAULT 0;
+ALTER TABLE places ALTER COLUMN longitude SET NOT NULL;
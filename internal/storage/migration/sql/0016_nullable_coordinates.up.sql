@@ -0,0 +1,17 @@
+-- Places without coordinates used to be at (0, 0), which is a real point in
+-- the Gulf of Guinea, so unknown coordinates are now NULL.
+ALTER TABLE places ALTER COLUMN latitude DROP NOT NULL;
+ALTER TABLE places ALTER COLUMN latitude DROP DEFAULT;
+ALTER TABLE places ALTER COLUMN longitude DROP NOT NULL;
+ALTER TABLE places ALTER COLUMN longitude DROP DEFAULT;
+
+UPDATE places SET latitude = NULL, longitude = NULL WHERE latitude = 0 AND longitude = 0;
+
+ALTER TABLE places ADD CONSTRAINT chk_places_coordinates CHECK (
+    (latitude IS NULL) = (longitude IS NULL)
+    AND latitude BETWEEN -90 AND 90
+    AND longitude BETWEEN -180 AND 180
+);
+
+-- Nearby searches first narrow places down to a bounding box.
+CREATE INDEX idx_places_latitude_longitude ON places (latitude, longitude);
@@ -5,7 +5,13 @@
 package storage
 
 import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
 	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,16 +44,16 @@ func (_m *MockPlaceRepository) EXPECT() *MockPlaceRepository_Expecter {
 }
 
 // Create provides a mock function for the type MockPlaceRepository
-func (_mock *MockPlaceRepository) Create(p entity.Place) error {
-	ret := _mock.Called(p)
+func (_mock *MockPlaceRepository) Create(ctx context.Context, p entity.Place) error {
+	ret := _mock.Called(ctx, p)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(entity.Place) error); ok {
-		r0 = returnFunc(p)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Place) error); ok {
+		r0 = returnFunc(ctx, p)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -60,14 +66,15 @@ type MockPlaceRepository_Create_Call struct {
 }
 
 // Create is a helper method to define mock.On call
+//   - ctx
 //   - p
-func (_e *MockPlaceRepository_Expecter) Create(p interface{}) *MockPlaceRepository_Create_Call {
-	return &MockPlaceRepository_Create_Call{Call: _e.mock.On("Create", p)}
+func (_e *MockPlaceRepository_Expecter) Create(ctx interface{}, p interface{}) *MockPlaceRepository_Create_Call {
+	return &MockPlaceRepository_Create_Call{Call: _e.mock.On("Create", ctx, p)}
 }
 
-func (_c *MockPlaceRepository_Create_Call) Run(run func(p entity.Place)) *MockPlaceRepository_Create_Call {
+func (_c *MockPlaceRepository_Create_Call) Run(run func(ctx context.Context, p entity.Place)) *MockPlaceRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(entity.Place))
+		run(args[0].(context.Context), args[1].(entity.Place))
 	})
 	return _c
 }
@@ -77,14 +84,60 @@ func (_c *MockPlaceRepository_Create_Call) Return(err error) *MockPlaceRepositor
 	return _c
 }
 
-func (_c *MockPlaceRepository_Create_Call) RunAndReturn(run func(p entity.Place) error) *MockPlaceRepository_Create_Call {
+func (_c *MockPlaceRepository_Create_Call) RunAndReturn(run func(ctx context.Context, p entity.Place) error) *MockPlaceRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) Delete(ctx context.Context, p entity.Place) error {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Place) error); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPlaceRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockPlaceRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - p
+func (_e *MockPlaceRepository_Expecter) Delete(ctx interface{}, p interface{}) *MockPlaceRepository_Delete_Call {
+	return &MockPlaceRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, p)}
+}
+
+func (_c *MockPlaceRepository_Delete_Call) Run(run func(ctx context.Context, p entity.Place)) *MockPlaceRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Place))
+	})
+	return _c
+}
+
+func (_c *MockPlaceRepository_Delete_Call) Return(err error) *MockPlaceRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPlaceRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, p entity.Place) error) *MockPlaceRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByNameOrAddress provides a mock function for the type MockPlaceRepository
-func (_mock *MockPlaceRepository) GetByNameOrAddress(query string) ([]entity.Place, error) {
-	ret := _mock.Called(query)
+func (_mock *MockPlaceRepository) GetByNameOrAddress(ctx context.Context, query string) ([]entity.Place, error) {
+	ret := _mock.Called(ctx, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByNameOrAddress")
@@ -92,18 +145,18 @@ func (_mock *MockPlaceRepository) GetByNameOrAddress(query string) ([]entity.Pla
 
 	var r0 []entity.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) ([]entity.Place, error)); ok {
-		return returnFunc(query)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entity.Place, error)); ok {
+		return returnFunc(ctx, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) []entity.Place); ok {
-		r0 = returnFunc(query)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entity.Place); ok {
+		r0 = returnFunc(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Place)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(query)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,14 +169,15 @@ type MockPlaceRepository_GetByNameOrAddress_Call struct {
 }
 
 // GetByNameOrAddress is a helper method to define mock.On call
+//   - ctx
 //   - query
-func (_e *MockPlaceRepository_Expecter) GetByNameOrAddress(query interface{}) *MockPlaceRepository_GetByNameOrAddress_Call {
-	return &MockPlaceRepository_GetByNameOrAddress_Call{Call: _e.mock.On("GetByNameOrAddress", query)}
+func (_e *MockPlaceRepository_Expecter) GetByNameOrAddress(ctx interface{}, query interface{}) *MockPlaceRepository_GetByNameOrAddress_Call {
+	return &MockPlaceRepository_GetByNameOrAddress_Call{Call: _e.mock.On("GetByNameOrAddress", ctx, query)}
 }
 
-func (_c *MockPlaceRepository_GetByNameOrAddress_Call) Run(run func(query string)) *MockPlaceRepository_GetByNameOrAddress_Call {
+func (_c *MockPlaceRepository_GetByNameOrAddress_Call) Run(run func(ctx context.Context, query string)) *MockPlaceRepository_GetByNameOrAddress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -133,37 +187,45 @@ func (_c *MockPlaceRepository_GetByNameOrAddress_Call) Return(places []entity.Pl
 	return _c
 }
 
-func (_c *MockPlaceRepository_GetByNameOrAddress_Call) RunAndReturn(run func(query string) ([]entity.Place, error)) *MockPlaceRepository_GetByNameOrAddress_Call {
+func (_c *MockPlaceRepository_GetByNameOrAddress_Call) RunAndReturn(run func(ctx context.Context, query string) ([]entity.Place, error)) *MockPlaceRepository_GetByNameOrAddress_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByNameOrAddressFull provides a mock function for the type MockPlaceRepository
-func (_mock *MockPlaceRepository) GetByNameOrAddressFull(query string) ([]entity.Place, error) {
-	ret := _mock.Called(query)
+func (_mock *MockPlaceRepository) GetByNameOrAddressFull(ctx context.Context, query string, sort types.PlaceSort, limit int, after *cursor.Cursor) ([]entity.Place, int64, error) {
+	ret := _mock.Called(ctx, query, sort, limit, after)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByNameOrAddressFull")
 	}
 
 	var r0 []entity.Place
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) ([]entity.Place, error)); ok {
-		return returnFunc(query)
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, types.PlaceSort, int, *cursor.Cursor) ([]entity.Place, int64, error)); ok {
+		return returnFunc(ctx, query, sort, limit, after)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) []entity.Place); ok {
-		r0 = returnFunc(query)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, types.PlaceSort, int, *cursor.Cursor) []entity.Place); ok {
+		r0 = returnFunc(ctx, query, sort, limit, after)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Place)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(query)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, types.PlaceSort, int, *cursor.Cursor) int64); ok {
+		r1 = returnFunc(ctx, query, sort, limit, after)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(int64)
+		}
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, types.PlaceSort, int, *cursor.Cursor) error); ok {
+		r2 = returnFunc(ctx, query, sort, limit, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockPlaceRepository_GetByNameOrAddressFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNameOrAddressFull'
@@ -172,31 +234,35 @@ type MockPlaceRepository_GetByNameOrAddressFull_Call struct {
 }
 
 // GetByNameOrAddressFull is a helper method to define mock.On call
+//   - ctx
 //   - query
-func (_e *MockPlaceRepository_Expecter) GetByNameOrAddressFull(query interface{}) *MockPlaceRepository_GetByNameOrAddressFull_Call {
-	return &MockPlaceRepository_GetByNameOrAddressFull_Call{Call: _e.mock.On("GetByNameOrAddressFull", query)}
+//   - sort
+//   - limit
+//   - after
+func (_e *MockPlaceRepository_Expecter) GetByNameOrAddressFull(ctx interface{}, query interface{}, sort interface{}, limit interface{}, after interface{}) *MockPlaceRepository_GetByNameOrAddressFull_Call {
+	return &MockPlaceRepository_GetByNameOrAddressFull_Call{Call: _e.mock.On("GetByNameOrAddressFull", ctx, query, sort, limit, after)}
 }
 
-func (_c *MockPlaceRepository_GetByNameOrAddressFull_Call) Run(run func(query string)) *MockPlaceRepository_GetByNameOrAddressFull_Call {
+func (_c *MockPlaceRepository_GetByNameOrAddressFull_Call) Run(run func(ctx context.Context, query string, sort types.PlaceSort, limit int, after *cursor.Cursor)) *MockPlaceRepository_GetByNameOrAddressFull_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(types.PlaceSort), args[3].(int), args[4].(*cursor.Cursor))
 	})
 	return _c
 }
 
-func (_c *MockPlaceRepository_GetByNameOrAddressFull_Call) Return(places []entity.Place, err error) *MockPlaceRepository_GetByNameOrAddressFull_Call {
-	_c.Call.Return(places, err)
+func (_c *MockPlaceRepository_GetByNameOrAddressFull_Call) Return(places []entity.Place, n int64, err error) *MockPlaceRepository_GetByNameOrAddressFull_Call {
+	_c.Call.Return(places, n, err)
 	return _c
 }
 
-func (_c *MockPlaceRepository_GetByNameOrAddressFull_Call) RunAndReturn(run func(query string) ([]entity.Place, error)) *MockPlaceRepository_GetByNameOrAddressFull_Call {
+func (_c *MockPlaceRepository_GetByNameOrAddressFull_Call) RunAndReturn(run func(ctx context.Context, query string, sort types.PlaceSort, limit int, after *cursor.Cursor) ([]entity.Place, int64, error)) *MockPlaceRepository_GetByNameOrAddressFull_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByPublicID provides a mock function for the type MockPlaceRepository
-func (_mock *MockPlaceRepository) GetByPublicID(placeID string) (entity.Place, error) {
-	ret := _mock.Called(placeID)
+func (_mock *MockPlaceRepository) GetByPublicID(ctx context.Context, placeID string) (entity.Place, error) {
+	ret := _mock.Called(ctx, placeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByPublicID")
@@ -204,16 +270,16 @@ func (_mock *MockPlaceRepository) GetByPublicID(placeID string) (entity.Place, e
 
 	var r0 entity.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (entity.Place, error)); ok {
-		return returnFunc(placeID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Place, error)); ok {
+		return returnFunc(ctx, placeID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) entity.Place); ok {
-		r0 = returnFunc(placeID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Place); ok {
+		r0 = returnFunc(ctx, placeID)
 	} else {
 		r0 = ret.Get(0).(entity.Place)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(placeID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, placeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -226,14 +292,15 @@ type MockPlaceRepository_GetByPublicID_Call struct {
 }
 
 // GetByPublicID is a helper method to define mock.On call
+//   - ctx
 //   - placeID
-func (_e *MockPlaceRepository_Expecter) GetByPublicID(placeID interface{}) *MockPlaceRepository_GetByPublicID_Call {
-	return &MockPlaceRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", placeID)}
+func (_e *MockPlaceRepository_Expecter) GetByPublicID(ctx interface{}, placeID interface{}) *MockPlaceRepository_GetByPublicID_Call {
+	return &MockPlaceRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", ctx, placeID)}
 }
 
-func (_c *MockPlaceRepository_GetByPublicID_Call) Run(run func(placeID string)) *MockPlaceRepository_GetByPublicID_Call {
+func (_c *MockPlaceRepository_GetByPublicID_Call) Run(run func(ctx context.Context, placeID string)) *MockPlaceRepository_GetByPublicID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -243,14 +310,14 @@ func (_c *MockPlaceRepository_GetByPublicID_Call) Return(place entity.Place, err
 	return _c
 }
 
-func (_c *MockPlaceRepository_GetByPublicID_Call) RunAndReturn(run func(placeID string) (entity.Place, error)) *MockPlaceRepository_GetByPublicID_Call {
+func (_c *MockPlaceRepository_GetByPublicID_Call) RunAndReturn(run func(ctx context.Context, placeID string) (entity.Place, error)) *MockPlaceRepository_GetByPublicID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByPublicIDFull provides a mock function for the type MockPlaceRepository
-func (_mock *MockPlaceRepository) GetByPublicIDFull(placeID string) (entity.Place, error) {
-	ret := _mock.Called(placeID)
+func (_mock *MockPlaceRepository) GetByPublicIDFull(ctx context.Context, placeID string) (entity.Place, error) {
+	ret := _mock.Called(ctx, placeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByPublicIDFull")
@@ -258,16 +325,16 @@ func (_mock *MockPlaceRepository) GetByPublicIDFull(placeID string) (entity.Plac
 
 	var r0 entity.Place
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (entity.Place, error)); ok {
-		return returnFunc(placeID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Place, error)); ok {
+		return returnFunc(ctx, placeID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) entity.Place); ok {
-		r0 = returnFunc(placeID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Place); ok {
+		r0 = returnFunc(ctx, placeID)
 	} else {
 		r0 = ret.Get(0).(entity.Place)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(placeID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, placeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -280,14 +347,15 @@ type MockPlaceRepository_GetByPublicIDFull_Call struct {
 }
 
 // GetByPublicIDFull is a helper method to define mock.On call
+//   - ctx
 //   - placeID
-func (_e *MockPlaceRepository_Expecter) GetByPublicIDFull(placeID interface{}) *MockPlaceRepository_GetByPublicIDFull_Call {
-	return &MockPlaceRepository_GetByPublicIDFull_Call{Call: _e.mock.On("GetByPublicIDFull", placeID)}
+func (_e *MockPlaceRepository_Expecter) GetByPublicIDFull(ctx interface{}, placeID interface{}) *MockPlaceRepository_GetByPublicIDFull_Call {
+	return &MockPlaceRepository_GetByPublicIDFull_Call{Call: _e.mock.On("GetByPublicIDFull", ctx, placeID)}
 }
 
-func (_c *MockPlaceRepository_GetByPublicIDFull_Call) Run(run func(placeID string)) *MockPlaceRepository_GetByPublicIDFull_Call {
+func (_c *MockPlaceRepository_GetByPublicIDFull_Call) Run(run func(ctx context.Context, placeID string)) *MockPlaceRepository_GetByPublicIDFull_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -297,459 +365,3233 @@ func (_c *MockPlaceRepository_GetByPublicIDFull_Call) Return(place entity.Place,
 	return _c
 }
 
-func (_c *MockPlaceRepository_GetByPublicIDFull_Call) RunAndReturn(run func(placeID string) (entity.Place, error)) *MockPlaceRepository_GetByPublicIDFull_Call {
+func (_c *MockPlaceRepository_GetByPublicIDFull_Call) RunAndReturn(run func(ctx context.Context, placeID string) (entity.Place, error)) *MockPlaceRepository_GetByPublicIDFull_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockPlaceRepository
-func (_mock *MockPlaceRepository) Update(p entity.Place) error {
-	ret := _mock.Called(p)
+// GetDeletedByPublicID provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) GetDeletedByPublicID(ctx context.Context, placeID string) (entity.Place, error) {
+	ret := _mock.Called(ctx, placeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetDeletedByPublicID")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(entity.Place) error); ok {
-		r0 = returnFunc(p)
+	var r0 entity.Place
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Place, error)); ok {
+		return returnFunc(ctx, placeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Place); ok {
+		r0 = returnFunc(ctx, placeID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(entity.Place)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, placeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPlaceRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockPlaceRepository_Update_Call struct {
+// MockPlaceRepository_GetDeletedByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByPublicID'
+type MockPlaceRepository_GetDeletedByPublicID_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
-//   - p
-func (_e *MockPlaceRepository_Expecter) Update(p interface{}) *MockPlaceRepository_Update_Call {
-	return &MockPlaceRepository_Update_Call{Call: _e.mock.On("Update", p)}
+// GetDeletedByPublicID is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+func (_e *MockPlaceRepository_Expecter) GetDeletedByPublicID(ctx interface{}, placeID interface{}) *MockPlaceRepository_GetDeletedByPublicID_Call {
+	return &MockPlaceRepository_GetDeletedByPublicID_Call{Call: _e.mock.On("GetDeletedByPublicID", ctx, placeID)}
 }
 
-func (_c *MockPlaceRepository_Update_Call) Run(run func(p entity.Place)) *MockPlaceRepository_Update_Call {
+func (_c *MockPlaceRepository_GetDeletedByPublicID_Call) Run(run func(ctx context.Context, placeID string)) *MockPlaceRepository_GetDeletedByPublicID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(entity.Place))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockPlaceRepository_Update_Call) Return(err error) *MockPlaceRepository_Update_Call {
-	_c.Call.Return(err)
+func (_c *MockPlaceRepository_GetDeletedByPublicID_Call) Return(place entity.Place, err error) *MockPlaceRepository_GetDeletedByPublicID_Call {
+	_c.Call.Return(place, err)
 	return _c
 }
 
-func (_c *MockPlaceRepository_Update_Call) RunAndReturn(run func(p entity.Place) error) *MockPlaceRepository_Update_Call {
+func (_c *MockPlaceRepository_GetDeletedByPublicID_Call) RunAndReturn(run func(ctx context.Context, placeID string) (entity.Place, error)) *MockPlaceRepository_GetDeletedByPublicID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPackRepository creates a new instance of MockPackRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPackRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPackRepository {
-	mock := &MockPackRepository{}
-	mock.Mock.Test(t)
+// GetDuplicatesFull provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) GetDuplicatesFull(ctx context.Context, name string, address string, lat *float64, lng *float64) ([]entity.Place, error) {
+	ret := _mock.Called(ctx, name, address, lat, lng)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for GetDuplicatesFull")
+	}
 
-	return mock
+	var r0 []entity.Place
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *float64, *float64) ([]entity.Place, error)); ok {
+		return returnFunc(ctx, name, address, lat, lng)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *float64, *float64) []entity.Place); ok {
+		r0 = returnFunc(ctx, name, address, lat, lng)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Place)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *float64, *float64) error); ok {
+		r1 = returnFunc(ctx, name, address, lat, lng)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPackRepository is an autogenerated mock type for the PackRepository type
-type MockPackRepository struct {
-	mock.Mock
+// MockPlaceRepository_GetDuplicatesFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuplicatesFull'
+type MockPlaceRepository_GetDuplicatesFull_Call struct {
+	*mock.Call
 }
 
-type MockPackRepository_Expecter struct {
-	mock *mock.Mock
+// GetDuplicatesFull is a helper method to define mock.On call
+//   - ctx
+//   - name
+//   - address
+//   - lat
+//   - lng
+func (_e *MockPlaceRepository_Expecter) GetDuplicatesFull(ctx interface{}, name interface{}, address interface{}, lat interface{}, lng interface{}) *MockPlaceRepository_GetDuplicatesFull_Call {
+	return &MockPlaceRepository_GetDuplicatesFull_Call{Call: _e.mock.On("GetDuplicatesFull", ctx, name, address, lat, lng)}
 }
 
-func (_m *MockPackRepository) EXPECT() *MockPackRepository_Expecter {
-	return &MockPackRepository_Expecter{mock: &_m.Mock}
+func (_c *MockPlaceRepository_GetDuplicatesFull_Call) Run(run func(ctx context.Context, name string, address string, lat *float64, lng *float64)) *MockPlaceRepository_GetDuplicatesFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*float64), args[4].(*float64))
+	})
+	return _c
 }
 
-// Create provides a mock function for the type MockPackRepository
-func (_mock *MockPackRepository) Create(p entity.Pack) error {
-	ret := _mock.Called(p)
+func (_c *MockPlaceRepository_GetDuplicatesFull_Call) Return(places []entity.Place, err error) *MockPlaceRepository_GetDuplicatesFull_Call {
+	_c.Call.Return(places, err)
+	return _c
+}
+
+func (_c *MockPlaceRepository_GetDuplicatesFull_Call) RunAndReturn(run func(ctx context.Context, name string, address string, lat *float64, lng *float64) ([]entity.Place, error)) *MockPlaceRepository_GetDuplicatesFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNearbyFull provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) GetNearbyFull(ctx context.Context, lat float64, lng float64, radius float64, limit int) ([]entity.Place, error) {
+	ret := _mock.Called(ctx, lat, lng, radius, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for GetNearbyFull")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(entity.Pack) error); ok {
-		r0 = returnFunc(p)
+	var r0 []entity.Place
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, float64, float64, float64, int) ([]entity.Place, error)); ok {
+		return returnFunc(ctx, lat, lng, radius, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, float64, float64, float64, int) []entity.Place); ok {
+		r0 = returnFunc(ctx, lat, lng, radius, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Place)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, float64, float64, float64, int) error); ok {
+		r1 = returnFunc(ctx, lat, lng, radius, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPackRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockPackRepository_Create_Call struct {
+// MockPlaceRepository_GetNearbyFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNearbyFull'
+type MockPlaceRepository_GetNearbyFull_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - p
-func (_e *MockPackRepository_Expecter) Create(p interface{}) *MockPackRepository_Create_Call {
-	return &MockPackRepository_Create_Call{Call: _e.mock.On("Create", p)}
+// GetNearbyFull is a helper method to define mock.On call
+//   - ctx
+//   - lat
+//   - lng
+//   - radius
+//   - limit
+func (_e *MockPlaceRepository_Expecter) GetNearbyFull(ctx interface{}, lat interface{}, lng interface{}, radius interface{}, limit interface{}) *MockPlaceRepository_GetNearbyFull_Call {
+	return &MockPlaceRepository_GetNearbyFull_Call{Call: _e.mock.On("GetNearbyFull", ctx, lat, lng, radius, limit)}
 }
 
-func (_c *MockPackRepository_Create_Call) Run(run func(p entity.Pack)) *MockPackRepository_Create_Call {
+func (_c *MockPlaceRepository_GetNearbyFull_Call) Run(run func(ctx context.Context, lat float64, lng float64, radius float64, limit int)) *MockPlaceRepository_GetNearbyFull_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(entity.Pack))
+		run(args[0].(context.Context), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(int))
 	})
 	return _c
 }
 
-func (_c *MockPackRepository_Create_Call) Return(err error) *MockPackRepository_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockPlaceRepository_GetNearbyFull_Call) Return(places []entity.Place, err error) *MockPlaceRepository_GetNearbyFull_Call {
+	_c.Call.Return(places, err)
 	return _c
 }
 
-func (_c *MockPackRepository_Create_Call) RunAndReturn(run func(p entity.Pack) error) *MockPackRepository_Create_Call {
+func (_c *MockPlaceRepository_GetNearbyFull_Call) RunAndReturn(run func(ctx context.Context, lat float64, lng float64, radius float64, limit int) ([]entity.Place, error)) *MockPlaceRepository_GetNearbyFull_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByNameOrAuthorFull provides a mock function for the type MockPackRepository
-func (_mock *MockPackRepository) GetByNameOrAuthorFull(query string) ([]entity.Pack, error) {
-	ret := _mock.Called(query)
+// Merge provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) Merge(ctx context.Context, from entity.Place, into entity.Place) error {
+	ret := _mock.Called(ctx, from, into)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByNameOrAuthorFull")
+		panic("no return value specified for Merge")
 	}
 
-	var r0 []entity.Pack
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) ([]entity.Pack, error)); ok {
-		return returnFunc(query)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) []entity.Pack); ok {
-		r0 = returnFunc(query)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Pack)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(query)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Place, entity.Place) error); ok {
+		r0 = returnFunc(ctx, from, into)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockPackRepository_GetByNameOrAuthorFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNameOrAuthorFull'
-type MockPackRepository_GetByNameOrAuthorFull_Call struct {
+// MockPlaceRepository_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type MockPlaceRepository_Merge_Call struct {
 	*mock.Call
 }
 
-// GetByNameOrAuthorFull is a helper method to define mock.On call
-//   - query
-func (_e *MockPackRepository_Expecter) GetByNameOrAuthorFull(query interface{}) *MockPackRepository_GetByNameOrAuthorFull_Call {
-	return &MockPackRepository_GetByNameOrAuthorFull_Call{Call: _e.mock.On("GetByNameOrAuthorFull", query)}
+// Merge is a helper method to define mock.On call
+//   - ctx
+//   - from
+//   - into
+func (_e *MockPlaceRepository_Expecter) Merge(ctx interface{}, from interface{}, into interface{}) *MockPlaceRepository_Merge_Call {
+	return &MockPlaceRepository_Merge_Call{Call: _e.mock.On("Merge", ctx, from, into)}
 }
 
-func (_c *MockPackRepository_GetByNameOrAuthorFull_Call) Run(run func(query string)) *MockPackRepository_GetByNameOrAuthorFull_Call {
+func (_c *MockPlaceRepository_Merge_Call) Run(run func(ctx context.Context, from entity.Place, into entity.Place)) *MockPlaceRepository_Merge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(entity.Place), args[2].(entity.Place))
 	})
 	return _c
 }
 
-func (_c *MockPackRepository_GetByNameOrAuthorFull_Call) Return(packs []entity.Pack, err error) *MockPackRepository_GetByNameOrAuthorFull_Call {
-	_c.Call.Return(packs, err)
+func (_c *MockPlaceRepository_Merge_Call) Return(err error) *MockPlaceRepository_Merge_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPackRepository_GetByNameOrAuthorFull_Call) RunAndReturn(run func(query string) ([]entity.Pack, error)) *MockPackRepository_GetByNameOrAuthorFull_Call {
+func (_c *MockPlaceRepository_Merge_Call) RunAndReturn(run func(ctx context.Context, from entity.Place, into entity.Place) error) *MockPlaceRepository_Merge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByPublicIDFull provides a mock function for the type MockPackRepository
-func (_mock *MockPackRepository) GetByPublicIDFull(id string) (entity.Pack, error) {
-	ret := _mock.Called(id)
+// PurgeDeleted provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]string, error) {
+	ret := _mock.Called(ctx, before)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByPublicIDFull")
+		panic("no return value specified for PurgeDeleted")
 	}
 
-	var r0 entity.Pack
+	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (entity.Pack, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return returnFunc(ctx, before)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) entity.Pack); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = returnFunc(ctx, before)
 	} else {
-		r0 = ret.Get(0).(entity.Pack)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPackRepository_GetByPublicIDFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicIDFull'
-type MockPackRepository_GetByPublicIDFull_Call struct {
+// MockPlaceRepository_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type MockPlaceRepository_PurgeDeleted_Call struct {
 	*mock.Call
 }
 
-// GetByPublicIDFull is a helper method to define mock.On call
-//   - id
-func (_e *MockPackRepository_Expecter) GetByPublicIDFull(id interface{}) *MockPackRepository_GetByPublicIDFull_Call {
-	return &MockPackRepository_GetByPublicIDFull_Call{Call: _e.mock.On("GetByPublicIDFull", id)}
+// PurgeDeleted is a helper method to define mock.On call
+//   - ctx
+//   - before
+func (_e *MockPlaceRepository_Expecter) PurgeDeleted(ctx interface{}, before interface{}) *MockPlaceRepository_PurgeDeleted_Call {
+	return &MockPlaceRepository_PurgeDeleted_Call{Call: _e.mock.On("PurgeDeleted", ctx, before)}
 }
 
-func (_c *MockPackRepository_GetByPublicIDFull_Call) Run(run func(id string)) *MockPackRepository_GetByPublicIDFull_Call {
+func (_c *MockPlaceRepository_PurgeDeleted_Call) Run(run func(ctx context.Context, before time.Time)) *MockPlaceRepository_PurgeDeleted_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockPackRepository_GetByPublicIDFull_Call) Return(pack entity.Pack, err error) *MockPackRepository_GetByPublicIDFull_Call {
-	_c.Call.Return(pack, err)
+func (_c *MockPlaceRepository_PurgeDeleted_Call) Return(strings []string, err error) *MockPlaceRepository_PurgeDeleted_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *MockPackRepository_GetByPublicIDFull_Call) RunAndReturn(run func(id string) (entity.Pack, error)) *MockPackRepository_GetByPublicIDFull_Call {
+func (_c *MockPlaceRepository_PurgeDeleted_Call) RunAndReturn(run func(ctx context.Context, before time.Time) ([]string, error)) *MockPlaceRepository_PurgeDeleted_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockPackRepository
-func (_mock *MockPackRepository) Update(p entity.Pack) error {
-	ret := _mock.Called(p)
+// Restore provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) Restore(ctx context.Context, p entity.Place) error {
+	ret := _mock.Called(ctx, p)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for Restore")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(entity.Pack) error); ok {
-		r0 = returnFunc(p)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Place) error); ok {
+		r0 = returnFunc(ctx, p)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPackRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockPackRepository_Update_Call struct {
+// MockPlaceRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockPlaceRepository_Restore_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
+//   - ctx
 //   - p
-func (_e *MockPackRepository_Expecter) Update(p interface{}) *MockPackRepository_Update_Call {
-	return &MockPackRepository_Update_Call{Call: _e.mock.On("Update", p)}
+func (_e *MockPlaceRepository_Expecter) Restore(ctx interface{}, p interface{}) *MockPlaceRepository_Restore_Call {
+	return &MockPlaceRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, p)}
 }
 
-func (_c *MockPackRepository_Update_Call) Run(run func(p entity.Pack)) *MockPackRepository_Update_Call {
+func (_c *MockPlaceRepository_Restore_Call) Run(run func(ctx context.Context, p entity.Place)) *MockPlaceRepository_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(entity.Pack))
+		run(args[0].(context.Context), args[1].(entity.Place))
 	})
 	return _c
 }
 
-func (_c *MockPackRepository_Update_Call) Return(err error) *MockPackRepository_Update_Call {
+func (_c *MockPlaceRepository_Restore_Call) Return(err error) *MockPlaceRepository_Restore_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPackRepository_Update_Call) RunAndReturn(run func(p entity.Pack) error) *MockPackRepository_Update_Call {
+func (_c *MockPlaceRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, p entity.Place) error) *MockPlaceRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockUserRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockUserRepository {
-	mock := &MockUserRepository{}
-	mock.Mock.Test(t)
+// SetHidden provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) SetHidden(ctx context.Context, p entity.Place, hiddenAt *time.Time) error {
+	ret := _mock.Called(ctx, p, hiddenAt)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for SetHidden")
+	}
 
-	return mock
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Place, *time.Time) error); ok {
+		r0 = returnFunc(ctx, p, hiddenAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockUserRepository is an autogenerated mock type for the UserRepository type
-type MockUserRepository struct {
-	mock.Mock
+// MockPlaceRepository_SetHidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHidden'
+type MockPlaceRepository_SetHidden_Call struct {
+	*mock.Call
 }
 
-type MockUserRepository_Expecter struct {
-	mock *mock.Mock
+// SetHidden is a helper method to define mock.On call
+//   - ctx
+//   - p
+//   - hiddenAt
+func (_e *MockPlaceRepository_Expecter) SetHidden(ctx interface{}, p interface{}, hiddenAt interface{}) *MockPlaceRepository_SetHidden_Call {
+	return &MockPlaceRepository_SetHidden_Call{Call: _e.mock.On("SetHidden", ctx, p, hiddenAt)}
 }
 
-func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
-	return &MockUserRepository_Expecter{mock: &_m.Mock}
+func (_c *MockPlaceRepository_SetHidden_Call) Run(run func(ctx context.Context, p entity.Place, hiddenAt *time.Time)) *MockPlaceRepository_SetHidden_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Place), args[2].(*time.Time))
+	})
+	return _c
 }
 
-// Create provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) Create(u entity.User) error {
-	ret := _mock.Called(u)
+func (_c *MockPlaceRepository_SetHidden_Call) Return(err error) *MockPlaceRepository_SetHidden_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPlaceRepository_SetHidden_Call) RunAndReturn(run func(ctx context.Context, p entity.Place, hiddenAt *time.Time) error) *MockPlaceRepository_SetHidden_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockPlaceRepository
+func (_mock *MockPlaceRepository) Update(ctx context.Context, p entity.Place) error {
+	ret := _mock.Called(ctx, p)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(entity.User) error); ok {
-		r0 = returnFunc(u)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Place) error); ok {
+		r0 = returnFunc(ctx, p)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockUserRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUserRepository_Create_Call struct {
+// MockPlaceRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockPlaceRepository_Update_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - u
-func (_e *MockUserRepository_Expecter) Create(u interface{}) *MockUserRepository_Create_Call {
-	return &MockUserRepository_Create_Call{Call: _e.mock.On("Create", u)}
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - p
+func (_e *MockPlaceRepository_Expecter) Update(ctx interface{}, p interface{}) *MockPlaceRepository_Update_Call {
+	return &MockPlaceRepository_Update_Call{Call: _e.mock.On("Update", ctx, p)}
 }
 
-func (_c *MockUserRepository_Create_Call) Run(run func(u entity.User)) *MockUserRepository_Create_Call {
+func (_c *MockPlaceRepository_Update_Call) Run(run func(ctx context.Context, p entity.Place)) *MockPlaceRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(entity.User))
+		run(args[0].(context.Context), args[1].(entity.Place))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_Create_Call) Return(err error) *MockUserRepository_Create_Call {
+func (_c *MockPlaceRepository_Update_Call) Return(err error) *MockPlaceRepository_Update_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(u entity.User) error) *MockUserRepository_Create_Call {
+func (_c *MockPlaceRepository_Update_Call) RunAndReturn(run func(ctx context.Context, p entity.Place) error) *MockPlaceRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByPublicID provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) GetByPublicID(id string) (entity.User, error) {
-	ret := _mock.Called(id)
+// NewMockPackRepository creates a new instance of MockPackRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPackRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPackRepository {
+	mock := &MockPackRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPackRepository is an autogenerated mock type for the PackRepository type
+type MockPackRepository struct {
+	mock.Mock
+}
+
+type MockPackRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPackRepository) EXPECT() *MockPackRepository_Expecter {
+	return &MockPackRepository_Expecter{mock: &_m.Mock}
+}
+
+// CompleteByPlaceID provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) CompleteByPlaceID(ctx context.Context, userID uuid.UUID, placeID uuid.UUID, completedAt time.Time) ([]entity.Pack, error) {
+	ret := _mock.Called(ctx, userID, placeID, completedAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByPublicID")
+		panic("no return value specified for CompleteByPlaceID")
 	}
 
-	var r0 entity.User
+	var r0 []entity.Pack
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (entity.User, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) ([]entity.Pack, error)); ok {
+		return returnFunc(ctx, userID, placeID, completedAt)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) entity.User); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) []entity.Pack); ok {
+		r0 = returnFunc(ctx, userID, placeID, completedAt)
 	} else {
-		r0 = ret.Get(0).(entity.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Pack)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, placeID, completedAt)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_GetByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicID'
-type MockUserRepository_GetByPublicID_Call struct {
+// MockPackRepository_CompleteByPlaceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteByPlaceID'
+type MockPackRepository_CompleteByPlaceID_Call struct {
 	*mock.Call
 }
 
-// GetByPublicID is a helper method to define mock.On call
-//   - id
-func (_e *MockUserRepository_Expecter) GetByPublicID(id interface{}) *MockUserRepository_GetByPublicID_Call {
-	return &MockUserRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", id)}
+// CompleteByPlaceID is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - placeID
+//   - completedAt
+func (_e *MockPackRepository_Expecter) CompleteByPlaceID(ctx interface{}, userID interface{}, placeID interface{}, completedAt interface{}) *MockPackRepository_CompleteByPlaceID_Call {
+	return &MockPackRepository_CompleteByPlaceID_Call{Call: _e.mock.On("CompleteByPlaceID", ctx, userID, placeID, completedAt)}
 }
 
-func (_c *MockUserRepository_GetByPublicID_Call) Run(run func(id string)) *MockUserRepository_GetByPublicID_Call {
+func (_c *MockPackRepository_CompleteByPlaceID_Call) Run(run func(ctx context.Context, userID uuid.UUID, placeID uuid.UUID, completedAt time.Time)) *MockPackRepository_CompleteByPlaceID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_GetByPublicID_Call) Return(user entity.User, err error) *MockUserRepository_GetByPublicID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockPackRepository_CompleteByPlaceID_Call) Return(packs []entity.Pack, err error) *MockPackRepository_CompleteByPlaceID_Call {
+	_c.Call.Return(packs, err)
 	return _c
 }
 
-func (_c *MockUserRepository_GetByPublicID_Call) RunAndReturn(run func(id string) (entity.User, error)) *MockUserRepository_GetByPublicID_Call {
+func (_c *MockPackRepository_CompleteByPlaceID_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, placeID uuid.UUID, completedAt time.Time) ([]entity.Pack, error)) *MockPackRepository_CompleteByPlaceID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByPublicIDFull provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) GetByPublicIDFull(id string) (entity.User, error) {
-	ret := _mock.Called(id)
+// Create provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) Create(ctx context.Context, p entity.Pack) error {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Pack) error); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPackRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - p
+func (_e *MockPackRepository_Expecter) Create(ctx interface{}, p interface{}) *MockPackRepository_Create_Call {
+	return &MockPackRepository_Create_Call{Call: _e.mock.On("Create", ctx, p)}
+}
+
+func (_c *MockPackRepository_Create_Call) Run(run func(ctx context.Context, p entity.Pack)) *MockPackRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Pack))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_Create_Call) Return(err error) *MockPackRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackRepository_Create_Call) RunAndReturn(run func(ctx context.Context, p entity.Pack) error) *MockPackRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) Delete(ctx context.Context, p entity.Pack) error {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Pack) error); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockPackRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - p
+func (_e *MockPackRepository_Expecter) Delete(ctx interface{}, p interface{}) *MockPackRepository_Delete_Call {
+	return &MockPackRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, p)}
+}
+
+func (_c *MockPackRepository_Delete_Call) Run(run func(ctx context.Context, p entity.Pack)) *MockPackRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Pack))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_Delete_Call) Return(err error) *MockPackRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, p entity.Pack) error) *MockPackRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) Follow(ctx context.Context, p entity.Pack, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, p, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Pack, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, p, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackRepository_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockPackRepository_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx
+//   - p
+//   - userID
+func (_e *MockPackRepository_Expecter) Follow(ctx interface{}, p interface{}, userID interface{}) *MockPackRepository_Follow_Call {
+	return &MockPackRepository_Follow_Call{Call: _e.mock.On("Follow", ctx, p, userID)}
+}
+
+func (_c *MockPackRepository_Follow_Call) Run(run func(ctx context.Context, p entity.Pack, userID uuid.UUID)) *MockPackRepository_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Pack), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_Follow_Call) Return(err error) *MockPackRepository_Follow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackRepository_Follow_Call) RunAndReturn(run func(ctx context.Context, p entity.Pack, userID uuid.UUID) error) *MockPackRepository_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDFull provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) GetByIDFull(ctx context.Context, id uuid.UUID) (entity.Pack, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDFull")
+	}
+
+	var r0 entity.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (entity.Pack, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) entity.Pack); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackRepository_GetByIDFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDFull'
+type MockPackRepository_GetByIDFull_Call struct {
+	*mock.Call
+}
+
+// GetByIDFull is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockPackRepository_Expecter) GetByIDFull(ctx interface{}, id interface{}) *MockPackRepository_GetByIDFull_Call {
+	return &MockPackRepository_GetByIDFull_Call{Call: _e.mock.On("GetByIDFull", ctx, id)}
+}
+
+func (_c *MockPackRepository_GetByIDFull_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockPackRepository_GetByIDFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_GetByIDFull_Call) Return(pack entity.Pack, err error) *MockPackRepository_GetByIDFull_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackRepository_GetByIDFull_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (entity.Pack, error)) *MockPackRepository_GetByIDFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByNameOrAuthorFull provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) GetByNameOrAuthorFull(ctx context.Context, query string, userID string, limit int, after *cursor.Cursor) ([]entity.Pack, int64, error) {
+	ret := _mock.Called(ctx, query, userID, limit, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByNameOrAuthorFull")
+	}
+
+	var r0 []entity.Pack
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, *cursor.Cursor) ([]entity.Pack, int64, error)); ok {
+		return returnFunc(ctx, query, userID, limit, after)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, *cursor.Cursor) []entity.Pack); ok {
+		r0 = returnFunc(ctx, query, userID, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Pack)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int, *cursor.Cursor) int64); ok {
+		r1 = returnFunc(ctx, query, userID, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, int, *cursor.Cursor) error); ok {
+		r2 = returnFunc(ctx, query, userID, limit, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPackRepository_GetByNameOrAuthorFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNameOrAuthorFull'
+type MockPackRepository_GetByNameOrAuthorFull_Call struct {
+	*mock.Call
+}
+
+// GetByNameOrAuthorFull is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - userID
+//   - limit
+//   - after
+func (_e *MockPackRepository_Expecter) GetByNameOrAuthorFull(ctx interface{}, query interface{}, userID interface{}, limit interface{}, after interface{}) *MockPackRepository_GetByNameOrAuthorFull_Call {
+	return &MockPackRepository_GetByNameOrAuthorFull_Call{Call: _e.mock.On("GetByNameOrAuthorFull", ctx, query, userID, limit, after)}
+}
+
+func (_c *MockPackRepository_GetByNameOrAuthorFull_Call) Run(run func(ctx context.Context, query string, userID string, limit int, after *cursor.Cursor)) *MockPackRepository_GetByNameOrAuthorFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int), args[4].(*cursor.Cursor))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_GetByNameOrAuthorFull_Call) Return(packs []entity.Pack, n int64, err error) *MockPackRepository_GetByNameOrAuthorFull_Call {
+	_c.Call.Return(packs, n, err)
+	return _c
+}
+
+func (_c *MockPackRepository_GetByNameOrAuthorFull_Call) RunAndReturn(run func(ctx context.Context, query string, userID string, limit int, after *cursor.Cursor) ([]entity.Pack, int64, error)) *MockPackRepository_GetByNameOrAuthorFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicID provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) GetByPublicID(ctx context.Context, id string) (entity.Pack, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicID")
+	}
+
+	var r0 entity.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Pack, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Pack); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackRepository_GetByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicID'
+type MockPackRepository_GetByPublicID_Call struct {
+	*mock.Call
+}
+
+// GetByPublicID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockPackRepository_Expecter) GetByPublicID(ctx interface{}, id interface{}) *MockPackRepository_GetByPublicID_Call {
+	return &MockPackRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", ctx, id)}
+}
+
+func (_c *MockPackRepository_GetByPublicID_Call) Run(run func(ctx context.Context, id string)) *MockPackRepository_GetByPublicID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_GetByPublicID_Call) Return(pack entity.Pack, err error) *MockPackRepository_GetByPublicID_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackRepository_GetByPublicID_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.Pack, error)) *MockPackRepository_GetByPublicID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicIDFull provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) GetByPublicIDFull(ctx context.Context, id string, userID string) (entity.Pack, error) {
+	ret := _mock.Called(ctx, id, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByPublicIDFull")
 	}
 
-	var r0 entity.User
+	var r0 entity.Pack
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (entity.User, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (entity.Pack, error)); ok {
+		return returnFunc(ctx, id, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) entity.User); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) entity.Pack); ok {
+		r0 = returnFunc(ctx, id, userID)
 	} else {
-		r0 = ret.Get(0).(entity.User)
+		r0 = ret.Get(0).(entity.Pack)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_GetByPublicIDFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicIDFull'
-type MockUserRepository_GetByPublicIDFull_Call struct {
+// MockPackRepository_GetByPublicIDFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicIDFull'
+type MockPackRepository_GetByPublicIDFull_Call struct {
 	*mock.Call
 }
 
 // GetByPublicIDFull is a helper method to define mock.On call
+//   - ctx
 //   - id
-func (_e *MockUserRepository_Expecter) GetByPublicIDFull(id interface{}) *MockUserRepository_GetByPublicIDFull_Call {
-	return &MockUserRepository_GetByPublicIDFull_Call{Call: _e.mock.On("GetByPublicIDFull", id)}
+//   - userID
+func (_e *MockPackRepository_Expecter) GetByPublicIDFull(ctx interface{}, id interface{}, userID interface{}) *MockPackRepository_GetByPublicIDFull_Call {
+	return &MockPackRepository_GetByPublicIDFull_Call{Call: _e.mock.On("GetByPublicIDFull", ctx, id, userID)}
 }
 
-func (_c *MockUserRepository_GetByPublicIDFull_Call) Run(run func(id string)) *MockUserRepository_GetByPublicIDFull_Call {
+func (_c *MockPackRepository_GetByPublicIDFull_Call) Run(run func(ctx context.Context, id string, userID string)) *MockPackRepository_GetByPublicIDFull_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_GetByPublicIDFull_Call) Return(user entity.User, err error) *MockUserRepository_GetByPublicIDFull_Call {
-	_c.Call.Return(user, err)
+func (_c *MockPackRepository_GetByPublicIDFull_Call) Return(pack entity.Pack, err error) *MockPackRepository_GetByPublicIDFull_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackRepository_GetByPublicIDFull_Call) RunAndReturn(run func(ctx context.Context, id string, userID string) (entity.Pack, error)) *MockPackRepository_GetByPublicIDFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedByPublicID provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) GetDeletedByPublicID(ctx context.Context, id string) (entity.Pack, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedByPublicID")
+	}
+
+	var r0 entity.Pack
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Pack, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Pack); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Pack)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackRepository_GetDeletedByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByPublicID'
+type MockPackRepository_GetDeletedByPublicID_Call struct {
+	*mock.Call
+}
+
+// GetDeletedByPublicID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockPackRepository_Expecter) GetDeletedByPublicID(ctx interface{}, id interface{}) *MockPackRepository_GetDeletedByPublicID_Call {
+	return &MockPackRepository_GetDeletedByPublicID_Call{Call: _e.mock.On("GetDeletedByPublicID", ctx, id)}
+}
+
+func (_c *MockPackRepository_GetDeletedByPublicID_Call) Run(run func(ctx context.Context, id string)) *MockPackRepository_GetDeletedByPublicID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_GetDeletedByPublicID_Call) Return(pack entity.Pack, err error) *MockPackRepository_GetDeletedByPublicID_Call {
+	_c.Call.Return(pack, err)
+	return _c
+}
+
+func (_c *MockPackRepository_GetDeletedByPublicID_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.Pack, error)) *MockPackRepository_GetDeletedByPublicID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeleted provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]string, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeleted")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPackRepository_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type MockPackRepository_PurgeDeleted_Call struct {
+	*mock.Call
+}
+
+// PurgeDeleted is a helper method to define mock.On call
+//   - ctx
+//   - before
+func (_e *MockPackRepository_Expecter) PurgeDeleted(ctx interface{}, before interface{}) *MockPackRepository_PurgeDeleted_Call {
+	return &MockPackRepository_PurgeDeleted_Call{Call: _e.mock.On("PurgeDeleted", ctx, before)}
+}
+
+func (_c *MockPackRepository_PurgeDeleted_Call) Run(run func(ctx context.Context, before time.Time)) *MockPackRepository_PurgeDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_PurgeDeleted_Call) Return(strings []string, err error) *MockPackRepository_PurgeDeleted_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockPackRepository_PurgeDeleted_Call) RunAndReturn(run func(ctx context.Context, before time.Time) ([]string, error)) *MockPackRepository_PurgeDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) Restore(ctx context.Context, p entity.Pack) error {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Pack) error); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockPackRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx
+//   - p
+func (_e *MockPackRepository_Expecter) Restore(ctx interface{}, p interface{}) *MockPackRepository_Restore_Call {
+	return &MockPackRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, p)}
+}
+
+func (_c *MockPackRepository_Restore_Call) Run(run func(ctx context.Context, p entity.Pack)) *MockPackRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Pack))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_Restore_Call) Return(err error) *MockPackRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, p entity.Pack) error) *MockPackRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAuthor provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) SetAuthor(ctx context.Context, p entity.Pack, authorID uuid.UUID) error {
+	ret := _mock.Called(ctx, p, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAuthor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Pack, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, p, authorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackRepository_SetAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAuthor'
+type MockPackRepository_SetAuthor_Call struct {
+	*mock.Call
+}
+
+// SetAuthor is a helper method to define mock.On call
+//   - ctx
+//   - p
+//   - authorID
+func (_e *MockPackRepository_Expecter) SetAuthor(ctx interface{}, p interface{}, authorID interface{}) *MockPackRepository_SetAuthor_Call {
+	return &MockPackRepository_SetAuthor_Call{Call: _e.mock.On("SetAuthor", ctx, p, authorID)}
+}
+
+func (_c *MockPackRepository_SetAuthor_Call) Run(run func(ctx context.Context, p entity.Pack, authorID uuid.UUID)) *MockPackRepository_SetAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Pack), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_SetAuthor_Call) Return(err error) *MockPackRepository_SetAuthor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackRepository_SetAuthor_Call) RunAndReturn(run func(ctx context.Context, p entity.Pack, authorID uuid.UUID) error) *MockPackRepository_SetAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHidden provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) SetHidden(ctx context.Context, p entity.Pack, hiddenAt *time.Time) error {
+	ret := _mock.Called(ctx, p, hiddenAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHidden")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Pack, *time.Time) error); ok {
+		r0 = returnFunc(ctx, p, hiddenAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackRepository_SetHidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHidden'
+type MockPackRepository_SetHidden_Call struct {
+	*mock.Call
+}
+
+// SetHidden is a helper method to define mock.On call
+//   - ctx
+//   - p
+//   - hiddenAt
+func (_e *MockPackRepository_Expecter) SetHidden(ctx interface{}, p interface{}, hiddenAt interface{}) *MockPackRepository_SetHidden_Call {
+	return &MockPackRepository_SetHidden_Call{Call: _e.mock.On("SetHidden", ctx, p, hiddenAt)}
+}
+
+func (_c *MockPackRepository_SetHidden_Call) Run(run func(ctx context.Context, p entity.Pack, hiddenAt *time.Time)) *MockPackRepository_SetHidden_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Pack), args[2].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_SetHidden_Call) Return(err error) *MockPackRepository_SetHidden_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackRepository_SetHidden_Call) RunAndReturn(run func(ctx context.Context, p entity.Pack, hiddenAt *time.Time) error) *MockPackRepository_SetHidden_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) Unfollow(ctx context.Context, p entity.Pack, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, p, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Pack, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, p, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackRepository_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockPackRepository_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - ctx
+//   - p
+//   - userID
+func (_e *MockPackRepository_Expecter) Unfollow(ctx interface{}, p interface{}, userID interface{}) *MockPackRepository_Unfollow_Call {
+	return &MockPackRepository_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, p, userID)}
+}
+
+func (_c *MockPackRepository_Unfollow_Call) Run(run func(ctx context.Context, p entity.Pack, userID uuid.UUID)) *MockPackRepository_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Pack), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_Unfollow_Call) Return(err error) *MockPackRepository_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackRepository_Unfollow_Call) RunAndReturn(run func(ctx context.Context, p entity.Pack, userID uuid.UUID) error) *MockPackRepository_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockPackRepository
+func (_mock *MockPackRepository) Update(ctx context.Context, p entity.Pack) error {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Pack) error); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockPackRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - p
+func (_e *MockPackRepository_Expecter) Update(ctx interface{}, p interface{}) *MockPackRepository_Update_Call {
+	return &MockPackRepository_Update_Call{Call: _e.mock.On("Update", ctx, p)}
+}
+
+func (_c *MockPackRepository_Update_Call) Run(run func(ctx context.Context, p entity.Pack)) *MockPackRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Pack))
+	})
+	return _c
+}
+
+func (_c *MockPackRepository_Update_Call) Return(err error) *MockPackRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackRepository_Update_Call) RunAndReturn(run func(ctx context.Context, p entity.Pack) error) *MockPackRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserRepository {
+	mock := &MockUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserRepository is an autogenerated mock type for the UserRepository type
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
+	return &MockUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Create(ctx context.Context, u entity.User) error {
+	ret := _mock.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.User) error); ok {
+		r0 = returnFunc(ctx, u)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - u
+func (_e *MockUserRepository_Expecter) Create(ctx interface{}, u interface{}) *MockUserRepository_Create_Call {
+	return &MockUserRepository_Create_Call{Call: _e.mock.On("Create", ctx, u)}
+}
+
+func (_c *MockUserRepository_Create_Call) Run(run func(ctx context.Context, u entity.User)) *MockUserRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.User))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Create_Call) Return(err error) *MockUserRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(ctx context.Context, u entity.User) error) *MockUserRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicID provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByPublicID(ctx context.Context, id string) (entity.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicID")
+	}
+
+	var r0 entity.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicID'
+type MockUserRepository_GetByPublicID_Call struct {
+	*mock.Call
+}
+
+// GetByPublicID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserRepository_Expecter) GetByPublicID(ctx interface{}, id interface{}) *MockUserRepository_GetByPublicID_Call {
+	return &MockUserRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", ctx, id)}
+}
+
+func (_c *MockUserRepository_GetByPublicID_Call) Run(run func(ctx context.Context, id string)) *MockUserRepository_GetByPublicID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByPublicID_Call) Return(user entity.User, err error) *MockUserRepository_GetByPublicID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByPublicID_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.User, error)) *MockUserRepository_GetByPublicID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicIDFull provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByPublicIDFull(ctx context.Context, id string) (entity.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicIDFull")
+	}
+
+	var r0 entity.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByPublicIDFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicIDFull'
+type MockUserRepository_GetByPublicIDFull_Call struct {
+	*mock.Call
+}
+
+// GetByPublicIDFull is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserRepository_Expecter) GetByPublicIDFull(ctx interface{}, id interface{}) *MockUserRepository_GetByPublicIDFull_Call {
+	return &MockUserRepository_GetByPublicIDFull_Call{Call: _e.mock.On("GetByPublicIDFull", ctx, id)}
+}
+
+func (_c *MockUserRepository_GetByPublicIDFull_Call) Run(run func(ctx context.Context, id string)) *MockUserRepository_GetByPublicIDFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByPublicIDFull_Call) Return(user entity.User, err error) *MockUserRepository_GetByPublicIDFull_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByPublicIDFull_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.User, error)) *MockUserRepository_GetByPublicIDFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicIDWithStats provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByPublicIDWithStats(ctx context.Context, id string) (entity.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicIDWithStats")
+	}
+
+	var r0 entity.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByPublicIDWithStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicIDWithStats'
+type MockUserRepository_GetByPublicIDWithStats_Call struct {
+	*mock.Call
+}
+
+// GetByPublicIDWithStats is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserRepository_Expecter) GetByPublicIDWithStats(ctx interface{}, id interface{}) *MockUserRepository_GetByPublicIDWithStats_Call {
+	return &MockUserRepository_GetByPublicIDWithStats_Call{Call: _e.mock.On("GetByPublicIDWithStats", ctx, id)}
+}
+
+func (_c *MockUserRepository_GetByPublicIDWithStats_Call) Run(run func(ctx context.Context, id string)) *MockUserRepository_GetByPublicIDWithStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByPublicIDWithStats_Call) Return(user entity.User, err error) *MockUserRepository_GetByPublicIDWithStats_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByPublicIDWithStats_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.User, error)) *MockUserRepository_GetByPublicIDWithStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Update(ctx context.Context, u entity.User) error {
+	ret := _mock.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.User) error); ok {
+		r0 = returnFunc(ctx, u)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockUserRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - u
+func (_e *MockUserRepository_Expecter) Update(ctx interface{}, u interface{}) *MockUserRepository_Update_Call {
+	return &MockUserRepository_Update_Call{Call: _e.mock.On("Update", ctx, u)}
+}
+
+func (_c *MockUserRepository_Update_Call) Run(run func(ctx context.Context, u entity.User)) *MockUserRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.User))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Update_Call) Return(err error) *MockUserRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_Update_Call) RunAndReturn(run func(ctx context.Context, u entity.User) error) *MockUserRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserFollowRepository creates a new instance of MockUserFollowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserFollowRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserFollowRepository {
+	mock := &MockUserFollowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserFollowRepository is an autogenerated mock type for the UserFollowRepository type
+type MockUserFollowRepository struct {
+	mock.Mock
+}
+
+type MockUserFollowRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserFollowRepository) EXPECT() *MockUserFollowRepository_Expecter {
+	return &MockUserFollowRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserFollowRepository
+func (_mock *MockUserFollowRepository) Create(ctx context.Context, f entity.UserFollow) error {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.UserFollow) error); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserFollowRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserFollowRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - f
+func (_e *MockUserFollowRepository_Expecter) Create(ctx interface{}, f interface{}) *MockUserFollowRepository_Create_Call {
+	return &MockUserFollowRepository_Create_Call{Call: _e.mock.On("Create", ctx, f)}
+}
+
+func (_c *MockUserFollowRepository_Create_Call) Run(run func(ctx context.Context, f entity.UserFollow)) *MockUserFollowRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.UserFollow))
+	})
+	return _c
+}
+
+func (_c *MockUserFollowRepository_Create_Call) Return(err error) *MockUserFollowRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserFollowRepository_Create_Call) RunAndReturn(run func(ctx context.Context, f entity.UserFollow) error) *MockUserFollowRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockUserFollowRepository
+func (_mock *MockUserFollowRepository) Delete(ctx context.Context, f entity.UserFollow) error {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.UserFollow) error); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserFollowRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserFollowRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - f
+func (_e *MockUserFollowRepository_Expecter) Delete(ctx interface{}, f interface{}) *MockUserFollowRepository_Delete_Call {
+	return &MockUserFollowRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, f)}
+}
+
+func (_c *MockUserFollowRepository_Delete_Call) Run(run func(ctx context.Context, f entity.UserFollow)) *MockUserFollowRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.UserFollow))
+	})
+	return _c
+}
+
+func (_c *MockUserFollowRepository_Delete_Call) Return(err error) *MockUserFollowRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserFollowRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, f entity.UserFollow) error) *MockUserFollowRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMediaRepository creates a new instance of MockMediaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMediaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMediaRepository {
+	mock := &MockMediaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMediaRepository is an autogenerated mock type for the MediaRepository type
+type MockMediaRepository struct {
+	mock.Mock
+}
+
+type MockMediaRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMediaRepository) EXPECT() *MockMediaRepository_Expecter {
+	return &MockMediaRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockMediaRepository
+func (_mock *MockMediaRepository) Create(ctx context.Context, m entity.Media) error {
+	ret := _mock.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Media) error); ok {
+		r0 = returnFunc(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMediaRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockMediaRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - m
+func (_e *MockMediaRepository_Expecter) Create(ctx interface{}, m interface{}) *MockMediaRepository_Create_Call {
+	return &MockMediaRepository_Create_Call{Call: _e.mock.On("Create", ctx, m)}
+}
+
+func (_c *MockMediaRepository_Create_Call) Run(run func(ctx context.Context, m entity.Media)) *MockMediaRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Media))
+	})
+	return _c
+}
+
+func (_c *MockMediaRepository_Create_Call) Return(err error) *MockMediaRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMediaRepository_Create_Call) RunAndReturn(run func(ctx context.Context, m entity.Media) error) *MockMediaRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockMediaRepository
+func (_mock *MockMediaRepository) Delete(ctx context.Context, m entity.Media) error {
+	ret := _mock.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Media) error); ok {
+		r0 = returnFunc(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMediaRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMediaRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - m
+func (_e *MockMediaRepository_Expecter) Delete(ctx interface{}, m interface{}) *MockMediaRepository_Delete_Call {
+	return &MockMediaRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, m)}
+}
+
+func (_c *MockMediaRepository_Delete_Call) Run(run func(ctx context.Context, m entity.Media)) *MockMediaRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Media))
+	})
+	return _c
+}
+
+func (_c *MockMediaRepository_Delete_Call) Return(err error) *MockMediaRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMediaRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, m entity.Media) error) *MockMediaRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicID provides a mock function for the type MockMediaRepository
+func (_mock *MockMediaRepository) GetByPublicID(ctx context.Context, id string) (entity.Media, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicID")
+	}
+
+	var r0 entity.Media
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Media, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Media); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Media)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMediaRepository_GetByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicID'
+type MockMediaRepository_GetByPublicID_Call struct {
+	*mock.Call
+}
+
+// GetByPublicID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockMediaRepository_Expecter) GetByPublicID(ctx interface{}, id interface{}) *MockMediaRepository_GetByPublicID_Call {
+	return &MockMediaRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", ctx, id)}
+}
+
+func (_c *MockMediaRepository_GetByPublicID_Call) Run(run func(ctx context.Context, id string)) *MockMediaRepository_GetByPublicID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMediaRepository_GetByPublicID_Call) Return(media entity.Media, err error) *MockMediaRepository_GetByPublicID_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaRepository_GetByPublicID_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.Media, error)) *MockMediaRepository_GetByPublicID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReviewRepository creates a new instance of MockReviewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReviewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReviewRepository {
+	mock := &MockReviewRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReviewRepository is an autogenerated mock type for the ReviewRepository type
+type MockReviewRepository struct {
+	mock.Mock
+}
+
+type MockReviewRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReviewRepository) EXPECT() *MockReviewRepository_Expecter {
+	return &MockReviewRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockReviewRepository
+func (_mock *MockReviewRepository) Create(ctx context.Context, r entity.Review) error {
+	ret := _mock.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Review) error); ok {
+		r0 = returnFunc(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReviewRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReviewRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - r
+func (_e *MockReviewRepository_Expecter) Create(ctx interface{}, r interface{}) *MockReviewRepository_Create_Call {
+	return &MockReviewRepository_Create_Call{Call: _e.mock.On("Create", ctx, r)}
+}
+
+func (_c *MockReviewRepository_Create_Call) Run(run func(ctx context.Context, r entity.Review)) *MockReviewRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Review))
+	})
+	return _c
+}
+
+func (_c *MockReviewRepository_Create_Call) Return(err error) *MockReviewRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReviewRepository_Create_Call) RunAndReturn(run func(ctx context.Context, r entity.Review) error) *MockReviewRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockReviewRepository
+func (_mock *MockReviewRepository) Delete(ctx context.Context, r entity.Review) error {
+	ret := _mock.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Review) error); ok {
+		r0 = returnFunc(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReviewRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockReviewRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - r
+func (_e *MockReviewRepository_Expecter) Delete(ctx interface{}, r interface{}) *MockReviewRepository_Delete_Call {
+	return &MockReviewRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, r)}
+}
+
+func (_c *MockReviewRepository_Delete_Call) Run(run func(ctx context.Context, r entity.Review)) *MockReviewRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Review))
+	})
+	return _c
+}
+
+func (_c *MockReviewRepository_Delete_Call) Return(err error) *MockReviewRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReviewRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, r entity.Review) error) *MockReviewRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPlaceIDFull provides a mock function for the type MockReviewRepository
+func (_mock *MockReviewRepository) GetByPlaceIDFull(ctx context.Context, placeID uuid.UUID, limit int, after *cursor.Cursor) ([]entity.Review, int64, error) {
+	ret := _mock.Called(ctx, placeID, limit, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPlaceIDFull")
+	}
+
+	var r0 []entity.Review
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *cursor.Cursor) ([]entity.Review, int64, error)); ok {
+		return returnFunc(ctx, placeID, limit, after)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *cursor.Cursor) []entity.Review); ok {
+		r0 = returnFunc(ctx, placeID, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Review)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, *cursor.Cursor) int64); ok {
+		r1 = returnFunc(ctx, placeID, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, *cursor.Cursor) error); ok {
+		r2 = returnFunc(ctx, placeID, limit, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockReviewRepository_GetByPlaceIDFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPlaceIDFull'
+type MockReviewRepository_GetByPlaceIDFull_Call struct {
+	*mock.Call
+}
+
+// GetByPlaceIDFull is a helper method to define mock.On call
+//   - ctx
+//   - placeID
+//   - limit
+//   - after
+func (_e *MockReviewRepository_Expecter) GetByPlaceIDFull(ctx interface{}, placeID interface{}, limit interface{}, after interface{}) *MockReviewRepository_GetByPlaceIDFull_Call {
+	return &MockReviewRepository_GetByPlaceIDFull_Call{Call: _e.mock.On("GetByPlaceIDFull", ctx, placeID, limit, after)}
+}
+
+func (_c *MockReviewRepository_GetByPlaceIDFull_Call) Run(run func(ctx context.Context, placeID uuid.UUID, limit int, after *cursor.Cursor)) *MockReviewRepository_GetByPlaceIDFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*cursor.Cursor))
+	})
+	return _c
+}
+
+func (_c *MockReviewRepository_GetByPlaceIDFull_Call) Return(reviews []entity.Review, n int64, err error) *MockReviewRepository_GetByPlaceIDFull_Call {
+	_c.Call.Return(reviews, n, err)
+	return _c
+}
+
+func (_c *MockReviewRepository_GetByPlaceIDFull_Call) RunAndReturn(run func(ctx context.Context, placeID uuid.UUID, limit int, after *cursor.Cursor) ([]entity.Review, int64, error)) *MockReviewRepository_GetByPlaceIDFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicID provides a mock function for the type MockReviewRepository
+func (_mock *MockReviewRepository) GetByPublicID(ctx context.Context, id string) (entity.Review, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicID")
+	}
+
+	var r0 entity.Review
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Review, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Review); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Review)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReviewRepository_GetByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicID'
+type MockReviewRepository_GetByPublicID_Call struct {
+	*mock.Call
+}
+
+// GetByPublicID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockReviewRepository_Expecter) GetByPublicID(ctx interface{}, id interface{}) *MockReviewRepository_GetByPublicID_Call {
+	return &MockReviewRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", ctx, id)}
+}
+
+func (_c *MockReviewRepository_GetByPublicID_Call) Run(run func(ctx context.Context, id string)) *MockReviewRepository_GetByPublicID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockReviewRepository_GetByPublicID_Call) Return(review entity.Review, err error) *MockReviewRepository_GetByPublicID_Call {
+	_c.Call.Return(review, err)
+	return _c
+}
+
+func (_c *MockReviewRepository_GetByPublicID_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.Review, error)) *MockReviewRepository_GetByPublicID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockReviewRepository
+func (_mock *MockReviewRepository) Update(ctx context.Context, r entity.Review) error {
+	ret := _mock.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Review) error); ok {
+		r0 = returnFunc(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReviewRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockReviewRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - r
+func (_e *MockReviewRepository_Expecter) Update(ctx interface{}, r interface{}) *MockReviewRepository_Update_Call {
+	return &MockReviewRepository_Update_Call{Call: _e.mock.On("Update", ctx, r)}
+}
+
+func (_c *MockReviewRepository_Update_Call) Run(run func(ctx context.Context, r entity.Review)) *MockReviewRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Review))
+	})
+	return _c
+}
+
+func (_c *MockReviewRepository_Update_Call) Return(err error) *MockReviewRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReviewRepository_Update_Call) RunAndReturn(run func(ctx context.Context, r entity.Review) error) *MockReviewRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVisitRepository creates a new instance of MockVisitRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVisitRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVisitRepository {
+	mock := &MockVisitRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockVisitRepository is an autogenerated mock type for the VisitRepository type
+type MockVisitRepository struct {
+	mock.Mock
+}
+
+type MockVisitRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVisitRepository) EXPECT() *MockVisitRepository_Expecter {
+	return &MockVisitRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockVisitRepository
+func (_mock *MockVisitRepository) Create(ctx context.Context, v entity.Visit) error {
+	ret := _mock.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Visit) error); ok {
+		r0 = returnFunc(ctx, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVisitRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockVisitRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - v
+func (_e *MockVisitRepository_Expecter) Create(ctx interface{}, v interface{}) *MockVisitRepository_Create_Call {
+	return &MockVisitRepository_Create_Call{Call: _e.mock.On("Create", ctx, v)}
+}
+
+func (_c *MockVisitRepository_Create_Call) Run(run func(ctx context.Context, v entity.Visit)) *MockVisitRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Visit))
+	})
+	return _c
+}
+
+func (_c *MockVisitRepository_Create_Call) Return(err error) *MockVisitRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVisitRepository_Create_Call) RunAndReturn(run func(ctx context.Context, v entity.Visit) error) *MockVisitRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockVisitRepository
+func (_mock *MockVisitRepository) Delete(ctx context.Context, v entity.Visit) error {
+	ret := _mock.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Visit) error); ok {
+		r0 = returnFunc(ctx, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVisitRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockVisitRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - v
+func (_e *MockVisitRepository_Expecter) Delete(ctx interface{}, v interface{}) *MockVisitRepository_Delete_Call {
+	return &MockVisitRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, v)}
+}
+
+func (_c *MockVisitRepository_Delete_Call) Run(run func(ctx context.Context, v entity.Visit)) *MockVisitRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Visit))
+	})
+	return _c
+}
+
+func (_c *MockVisitRepository_Delete_Call) Return(err error) *MockVisitRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVisitRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, v entity.Visit) error) *MockVisitRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicID provides a mock function for the type MockVisitRepository
+func (_mock *MockVisitRepository) GetByPublicID(ctx context.Context, id string) (entity.Visit, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicID")
+	}
+
+	var r0 entity.Visit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.Visit, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.Visit); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Visit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVisitRepository_GetByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicID'
+type MockVisitRepository_GetByPublicID_Call struct {
+	*mock.Call
+}
+
+// GetByPublicID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockVisitRepository_Expecter) GetByPublicID(ctx interface{}, id interface{}) *MockVisitRepository_GetByPublicID_Call {
+	return &MockVisitRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", ctx, id)}
+}
+
+func (_c *MockVisitRepository_GetByPublicID_Call) Run(run func(ctx context.Context, id string)) *MockVisitRepository_GetByPublicID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVisitRepository_GetByPublicID_Call) Return(v entity.Visit, err error) *MockVisitRepository_GetByPublicID_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockVisitRepository_GetByPublicID_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.Visit, error)) *MockVisitRepository_GetByPublicID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserIDFull provides a mock function for the type MockVisitRepository
+func (_mock *MockVisitRepository) GetByUserIDFull(ctx context.Context, userID uuid.UUID, from *time.Time, to *time.Time, limit int, after *cursor.Cursor) ([]entity.Visit, int64, error) {
+	ret := _mock.Called(ctx, userID, from, to, limit, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserIDFull")
+	}
+
+	var r0 []entity.Visit
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time, int, *cursor.Cursor) ([]entity.Visit, int64, error)); ok {
+		return returnFunc(ctx, userID, from, to, limit, after)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time, int, *cursor.Cursor) []entity.Visit); ok {
+		r0 = returnFunc(ctx, userID, from, to, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Visit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, *time.Time, int, *cursor.Cursor) int64); ok {
+		r1 = returnFunc(ctx, userID, from, to, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, *time.Time, *time.Time, int, *cursor.Cursor) error); ok {
+		r2 = returnFunc(ctx, userID, from, to, limit, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockVisitRepository_GetByUserIDFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserIDFull'
+type MockVisitRepository_GetByUserIDFull_Call struct {
+	*mock.Call
+}
+
+// GetByUserIDFull is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - from
+//   - to
+//   - limit
+//   - after
+func (_e *MockVisitRepository_Expecter) GetByUserIDFull(ctx interface{}, userID interface{}, from interface{}, to interface{}, limit interface{}, after interface{}) *MockVisitRepository_GetByUserIDFull_Call {
+	return &MockVisitRepository_GetByUserIDFull_Call{Call: _e.mock.On("GetByUserIDFull", ctx, userID, from, to, limit, after)}
+}
+
+func (_c *MockVisitRepository_GetByUserIDFull_Call) Run(run func(ctx context.Context, userID uuid.UUID, from *time.Time, to *time.Time, limit int, after *cursor.Cursor)) *MockVisitRepository_GetByUserIDFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(*time.Time), args[4].(int), args[5].(*cursor.Cursor))
+	})
+	return _c
+}
+
+func (_c *MockVisitRepository_GetByUserIDFull_Call) Return(visits []entity.Visit, n int64, err error) *MockVisitRepository_GetByUserIDFull_Call {
+	_c.Call.Return(visits, n, err)
+	return _c
+}
+
+func (_c *MockVisitRepository_GetByUserIDFull_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, from *time.Time, to *time.Time, limit int, after *cursor.Cursor) ([]entity.Visit, int64, error)) *MockVisitRepository_GetByUserIDFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockActivityRepository creates a new instance of MockActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockActivityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockActivityRepository {
+	mock := &MockActivityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockActivityRepository is an autogenerated mock type for the ActivityRepository type
+type MockActivityRepository struct {
+	mock.Mock
+}
+
+type MockActivityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockActivityRepository) EXPECT() *MockActivityRepository_Expecter {
+	return &MockActivityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockActivityRepository
+func (_mock *MockActivityRepository) Create(ctx context.Context, a entity.Activity) error {
+	ret := _mock.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.Activity) error); ok {
+		r0 = returnFunc(ctx, a)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockActivityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockActivityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - a
+func (_e *MockActivityRepository_Expecter) Create(ctx interface{}, a interface{}) *MockActivityRepository_Create_Call {
+	return &MockActivityRepository_Create_Call{Call: _e.mock.On("Create", ctx, a)}
+}
+
+func (_c *MockActivityRepository_Create_Call) Run(run func(ctx context.Context, a entity.Activity)) *MockActivityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Activity))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_Create_Call) Return(err error) *MockActivityRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockActivityRepository_Create_Call) RunAndReturn(run func(ctx context.Context, a entity.Activity) error) *MockActivityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeedFull provides a mock function for the type MockActivityRepository
+func (_mock *MockActivityRepository) GetFeedFull(ctx context.Context, userID string, limit int, after *cursor.Cursor) ([]entity.Activity, int64, error) {
+	ret := _mock.Called(ctx, userID, limit, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedFull")
+	}
+
+	var r0 []entity.Activity
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, *cursor.Cursor) ([]entity.Activity, int64, error)); ok {
+		return returnFunc(ctx, userID, limit, after)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, *cursor.Cursor) []entity.Activity); ok {
+		r0 = returnFunc(ctx, userID, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Activity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, *cursor.Cursor) int64); ok {
+		r1 = returnFunc(ctx, userID, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, int, *cursor.Cursor) error); ok {
+		r2 = returnFunc(ctx, userID, limit, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockActivityRepository_GetFeedFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeedFull'
+type MockActivityRepository_GetFeedFull_Call struct {
+	*mock.Call
+}
+
+// GetFeedFull is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - limit
+//   - after
+func (_e *MockActivityRepository_Expecter) GetFeedFull(ctx interface{}, userID interface{}, limit interface{}, after interface{}) *MockActivityRepository_GetFeedFull_Call {
+	return &MockActivityRepository_GetFeedFull_Call{Call: _e.mock.On("GetFeedFull", ctx, userID, limit, after)}
+}
+
+func (_c *MockActivityRepository_GetFeedFull_Call) Run(run func(ctx context.Context, userID string, limit int, after *cursor.Cursor)) *MockActivityRepository_GetFeedFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(*cursor.Cursor))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_GetFeedFull_Call) Return(activitys []entity.Activity, n int64, err error) *MockActivityRepository_GetFeedFull_Call {
+	_c.Call.Return(activitys, n, err)
+	return _c
+}
+
+func (_c *MockActivityRepository_GetFeedFull_Call) RunAndReturn(run func(ctx context.Context, userID string, limit int, after *cursor.Cursor) ([]entity.Activity, int64, error)) *MockActivityRepository_GetFeedFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPackCollaboratorRepository creates a new instance of MockPackCollaboratorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPackCollaboratorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPackCollaboratorRepository {
+	mock := &MockPackCollaboratorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPackCollaboratorRepository is an autogenerated mock type for the PackCollaboratorRepository type
+type MockPackCollaboratorRepository struct {
+	mock.Mock
+}
+
+type MockPackCollaboratorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPackCollaboratorRepository) EXPECT() *MockPackCollaboratorRepository_Expecter {
+	return &MockPackCollaboratorRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockPackCollaboratorRepository
+func (_mock *MockPackCollaboratorRepository) Create(ctx context.Context, c entity.PackCollaborator) error {
+	ret := _mock.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.PackCollaborator) error); ok {
+		r0 = returnFunc(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackCollaboratorRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPackCollaboratorRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - c
+func (_e *MockPackCollaboratorRepository_Expecter) Create(ctx interface{}, c interface{}) *MockPackCollaboratorRepository_Create_Call {
+	return &MockPackCollaboratorRepository_Create_Call{Call: _e.mock.On("Create", ctx, c)}
+}
+
+func (_c *MockPackCollaboratorRepository_Create_Call) Run(run func(ctx context.Context, c entity.PackCollaborator)) *MockPackCollaboratorRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.PackCollaborator))
+	})
+	return _c
+}
+
+func (_c *MockPackCollaboratorRepository_Create_Call) Return(err error) *MockPackCollaboratorRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackCollaboratorRepository_Create_Call) RunAndReturn(run func(ctx context.Context, c entity.PackCollaborator) error) *MockPackCollaboratorRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockPackCollaboratorRepository
+func (_mock *MockPackCollaboratorRepository) Delete(ctx context.Context, c entity.PackCollaborator) error {
+	ret := _mock.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.PackCollaborator) error); ok {
+		r0 = returnFunc(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackCollaboratorRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockPackCollaboratorRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - c
+func (_e *MockPackCollaboratorRepository_Expecter) Delete(ctx interface{}, c interface{}) *MockPackCollaboratorRepository_Delete_Call {
+	return &MockPackCollaboratorRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, c)}
+}
+
+func (_c *MockPackCollaboratorRepository_Delete_Call) Run(run func(ctx context.Context, c entity.PackCollaborator)) *MockPackCollaboratorRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.PackCollaborator))
+	})
+	return _c
+}
+
+func (_c *MockPackCollaboratorRepository_Delete_Call) Return(err error) *MockPackCollaboratorRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackCollaboratorRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, c entity.PackCollaborator) error) *MockPackCollaboratorRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockPackCollaboratorRepository
+func (_mock *MockPackCollaboratorRepository) Update(ctx context.Context, c entity.PackCollaborator) error {
+	ret := _mock.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.PackCollaborator) error); ok {
+		r0 = returnFunc(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPackCollaboratorRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockPackCollaboratorRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - c
+func (_e *MockPackCollaboratorRepository_Expecter) Update(ctx interface{}, c interface{}) *MockPackCollaboratorRepository_Update_Call {
+	return &MockPackCollaboratorRepository_Update_Call{Call: _e.mock.On("Update", ctx, c)}
+}
+
+func (_c *MockPackCollaboratorRepository_Update_Call) Run(run func(ctx context.Context, c entity.PackCollaborator)) *MockPackCollaboratorRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.PackCollaborator))
+	})
+	return _c
+}
+
+func (_c *MockPackCollaboratorRepository_Update_Call) Return(err error) *MockPackCollaboratorRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPackCollaboratorRepository_Update_Call) RunAndReturn(run func(ctx context.Context, c entity.PackCollaborator) error) *MockPackCollaboratorRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShareLinkRepository creates a new instance of MockShareLinkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShareLinkRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShareLinkRepository {
+	mock := &MockShareLinkRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShareLinkRepository is an autogenerated mock type for the ShareLinkRepository type
+type MockShareLinkRepository struct {
+	mock.Mock
+}
+
+type MockShareLinkRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShareLinkRepository) EXPECT() *MockShareLinkRepository_Expecter {
+	return &MockShareLinkRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockShareLinkRepository
+func (_mock *MockShareLinkRepository) Create(ctx context.Context, l entity.ShareLink) error {
+	ret := _mock.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.ShareLink) error); ok {
+		r0 = returnFunc(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShareLinkRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockShareLinkRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - l
+func (_e *MockShareLinkRepository_Expecter) Create(ctx interface{}, l interface{}) *MockShareLinkRepository_Create_Call {
+	return &MockShareLinkRepository_Create_Call{Call: _e.mock.On("Create", ctx, l)}
+}
+
+func (_c *MockShareLinkRepository_Create_Call) Run(run func(ctx context.Context, l entity.ShareLink)) *MockShareLinkRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ShareLink))
+	})
+	return _c
+}
+
+func (_c *MockShareLinkRepository_Create_Call) Return(err error) *MockShareLinkRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShareLinkRepository_Create_Call) RunAndReturn(run func(ctx context.Context, l entity.ShareLink) error) *MockShareLinkRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveByPackID provides a mock function for the type MockShareLinkRepository
+func (_mock *MockShareLinkRepository) GetActiveByPackID(ctx context.Context, packID uuid.UUID) ([]entity.ShareLink, error) {
+	ret := _mock.Called(ctx, packID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveByPackID")
+	}
+
+	var r0 []entity.ShareLink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.ShareLink, error)); ok {
+		return returnFunc(ctx, packID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.ShareLink); ok {
+		r0 = returnFunc(ctx, packID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ShareLink)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, packID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShareLinkRepository_GetActiveByPackID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveByPackID'
+type MockShareLinkRepository_GetActiveByPackID_Call struct {
+	*mock.Call
+}
+
+// GetActiveByPackID is a helper method to define mock.On call
+//   - ctx
+//   - packID
+func (_e *MockShareLinkRepository_Expecter) GetActiveByPackID(ctx interface{}, packID interface{}) *MockShareLinkRepository_GetActiveByPackID_Call {
+	return &MockShareLinkRepository_GetActiveByPackID_Call{Call: _e.mock.On("GetActiveByPackID", ctx, packID)}
+}
+
+func (_c *MockShareLinkRepository_GetActiveByPackID_Call) Run(run func(ctx context.Context, packID uuid.UUID)) *MockShareLinkRepository_GetActiveByPackID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockShareLinkRepository_GetActiveByPackID_Call) Return(shareLinks []entity.ShareLink, err error) *MockShareLinkRepository_GetActiveByPackID_Call {
+	_c.Call.Return(shareLinks, err)
+	return _c
+}
+
+func (_c *MockShareLinkRepository_GetActiveByPackID_Call) RunAndReturn(run func(ctx context.Context, packID uuid.UUID) ([]entity.ShareLink, error)) *MockShareLinkRepository_GetActiveByPackID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByPublicID provides a mock function for the type MockShareLinkRepository
+func (_mock *MockShareLinkRepository) GetByPublicID(ctx context.Context, id string) (entity.ShareLink, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicID")
+	}
+
+	var r0 entity.ShareLink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entity.ShareLink, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entity.ShareLink); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.ShareLink)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShareLinkRepository_GetByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicID'
+type MockShareLinkRepository_GetByPublicID_Call struct {
+	*mock.Call
+}
+
+// GetByPublicID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockShareLinkRepository_Expecter) GetByPublicID(ctx interface{}, id interface{}) *MockShareLinkRepository_GetByPublicID_Call {
+	return &MockShareLinkRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", ctx, id)}
+}
+
+func (_c *MockShareLinkRepository_GetByPublicID_Call) Run(run func(ctx context.Context, id string)) *MockShareLinkRepository_GetByPublicID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockShareLinkRepository_GetByPublicID_Call) Return(shareLink entity.ShareLink, err error) *MockShareLinkRepository_GetByPublicID_Call {
+	_c.Call.Return(shareLink, err)
+	return _c
+}
+
+func (_c *MockShareLinkRepository_GetByPublicID_Call) RunAndReturn(run func(ctx context.Context, id string) (entity.ShareLink, error)) *MockShareLinkRepository_GetByPublicID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockShareLinkRepository
+func (_mock *MockShareLinkRepository) Update(ctx context.Context, l entity.ShareLink) error {
+	ret := _mock.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.ShareLink) error); ok {
+		r0 = returnFunc(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShareLinkRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockShareLinkRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - l
+func (_e *MockShareLinkRepository_Expecter) Update(ctx interface{}, l interface{}) *MockShareLinkRepository_Update_Call {
+	return &MockShareLinkRepository_Update_Call{Call: _e.mock.On("Update", ctx, l)}
+}
+
+func (_c *MockShareLinkRepository_Update_Call) Run(run func(ctx context.Context, l entity.ShareLink)) *MockShareLinkRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ShareLink))
+	})
+	return _c
+}
+
+func (_c *MockShareLinkRepository_Update_Call) Return(err error) *MockShareLinkRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShareLinkRepository_Update_Call) RunAndReturn(run func(ctx context.Context, l entity.ShareLink) error) *MockShareLinkRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModerationRepository creates a new instance of MockModerationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModerationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModerationRepository {
+	mock := &MockModerationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModerationRepository is an autogenerated mock type for the ModerationRepository type
+type MockModerationRepository struct {
+	mock.Mock
+}
+
+type MockModerationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModerationRepository) EXPECT() *MockModerationRepository_Expecter {
+	return &MockModerationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) Create(ctx context.Context, a entity.ModerationAction) error {
+	ret := _mock.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.ModerationAction) error); ok {
+		r0 = returnFunc(ctx, a)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockModerationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - a
+func (_e *MockModerationRepository_Expecter) Create(ctx interface{}, a interface{}) *MockModerationRepository_Create_Call {
+	return &MockModerationRepository_Create_Call{Call: _e.mock.On("Create", ctx, a)}
+}
+
+func (_c *MockModerationRepository_Create_Call) Run(run func(ctx context.Context, a entity.ModerationAction)) *MockModerationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ModerationAction))
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_Create_Call) Return(err error) *MockModerationRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationRepository_Create_Call) RunAndReturn(run func(ctx context.Context, a entity.ModerationAction) error) *MockModerationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllFull provides a mock function for the type MockModerationRepository
+func (_mock *MockModerationRepository) GetAllFull(ctx context.Context, limit int, after *cursor.Cursor) ([]entity.ModerationAction, int64, error) {
+	ret := _mock.Called(ctx, limit, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllFull")
+	}
+
+	var r0 []entity.ModerationAction
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, *cursor.Cursor) ([]entity.ModerationAction, int64, error)); ok {
+		return returnFunc(ctx, limit, after)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, *cursor.Cursor) []entity.ModerationAction); ok {
+		r0 = returnFunc(ctx, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ModerationAction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, *cursor.Cursor) int64); ok {
+		r1 = returnFunc(ctx, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, *cursor.Cursor) error); ok {
+		r2 = returnFunc(ctx, limit, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockModerationRepository_GetAllFull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllFull'
+type MockModerationRepository_GetAllFull_Call struct {
+	*mock.Call
+}
+
+// GetAllFull is a helper method to define mock.On call
+//   - ctx
+//   - limit
+//   - after
+func (_e *MockModerationRepository_Expecter) GetAllFull(ctx interface{}, limit interface{}, after interface{}) *MockModerationRepository_GetAllFull_Call {
+	return &MockModerationRepository_GetAllFull_Call{Call: _e.mock.On("GetAllFull", ctx, limit, after)}
+}
+
+func (_c *MockModerationRepository_GetAllFull_Call) Run(run func(ctx context.Context, limit int, after *cursor.Cursor)) *MockModerationRepository_GetAllFull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*cursor.Cursor))
+	})
+	return _c
+}
+
+func (_c *MockModerationRepository_GetAllFull_Call) Return(moderationActions []entity.ModerationAction, n int64, err error) *MockModerationRepository_GetAllFull_Call {
+	_c.Call.Return(moderationActions, n, err)
+	return _c
+}
+
+func (_c *MockModerationRepository_GetAllFull_Call) RunAndReturn(run func(ctx context.Context, limit int, after *cursor.Cursor) ([]entity.ModerationAction, int64, error)) *MockModerationRepository_GetAllFull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUnitOfWork creates a new instance of MockUnitOfWork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUnitOfWork(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUnitOfWork {
+	mock := &MockUnitOfWork{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUnitOfWork is an autogenerated mock type for the UnitOfWork type
+type MockUnitOfWork struct {
+	mock.Mock
+}
+
+type MockUnitOfWork_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUnitOfWork) EXPECT() *MockUnitOfWork_Expecter {
+	return &MockUnitOfWork_Expecter{mock: &_m.Mock}
+}
+
+// Do provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Do")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUnitOfWork_Do_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Do'
+type MockUnitOfWork_Do_Call struct {
+	*mock.Call
+}
+
+// Do is a helper method to define mock.On call
+//   - ctx
+//   - fn
+func (_e *MockUnitOfWork_Expecter) Do(ctx interface{}, fn interface{}) *MockUnitOfWork_Do_Call {
+	return &MockUnitOfWork_Do_Call{Call: _e.mock.On("Do", ctx, fn)}
+}
+
+func (_c *MockUnitOfWork_Do_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *MockUnitOfWork_Do_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(ctx context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_Do_Call) Return(err error) *MockUnitOfWork_Do_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUnitOfWork_Do_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error) error) *MockUnitOfWork_Do_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHealthRepository creates a new instance of MockHealthRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHealthRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHealthRepository {
+	mock := &MockHealthRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHealthRepository is an autogenerated mock type for the HealthRepository type
+type MockHealthRepository struct {
+	mock.Mock
+}
+
+type MockHealthRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHealthRepository) EXPECT() *MockHealthRepository_Expecter {
+	return &MockHealthRepository_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockHealthRepository
+func (_mock *MockHealthRepository) Ping(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockHealthRepository_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockHealthRepository_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+//   - ctx
+func (_e *MockHealthRepository_Expecter) Ping(ctx interface{}) *MockHealthRepository_Ping_Call {
+	return &MockHealthRepository_Ping_Call{Call: _e.mock.On("Ping", ctx)}
+}
+
+func (_c *MockHealthRepository_Ping_Call) Run(run func(ctx context.Context)) *MockHealthRepository_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockHealthRepository_Ping_Call) Return(err error) *MockHealthRepository_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockHealthRepository_Ping_Call) RunAndReturn(run func(ctx context.Context) error) *MockHealthRepository_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPurger creates a new instance of MockPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPurger {
+	mock := &MockPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPurger is an autogenerated mock type for the Purger type
+type MockPurger struct {
+	mock.Mock
+}
+
+type MockPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPurger) EXPECT() *MockPurger_Expecter {
+	return &MockPurger_Expecter{mock: &_m.Mock}
+}
+
+// PurgeDeleted provides a mock function for the type MockPurger
+func (_mock *MockPurger) PurgeDeleted(ctx context.Context, before time.Time) error {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeleted")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPurger_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type MockPurger_PurgeDeleted_Call struct {
+	*mock.Call
+}
+
+// PurgeDeleted is a helper method to define mock.On call
+//   - ctx
+//   - before
+func (_e *MockPurger_Expecter) PurgeDeleted(ctx interface{}, before interface{}) *MockPurger_PurgeDeleted_Call {
+	return &MockPurger_PurgeDeleted_Call{Call: _e.mock.On("PurgeDeleted", ctx, before)}
+}
+
+func (_c *MockPurger_PurgeDeleted_Call) Run(run func(ctx context.Context, before time.Time)) *MockPurger_PurgeDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPurger_PurgeDeleted_Call) Return(err error) *MockPurger_PurgeDeleted_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserRepository_GetByPublicIDFull_Call) RunAndReturn(run func(id string) (entity.User, error)) *MockUserRepository_GetByPublicIDFull_Call {
+func (_c *MockPurger_PurgeDeleted_Call) RunAndReturn(run func(ctx context.Context, before time.Time) error) *MockPurger_PurgeDeleted_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/enum/pack_visibility"
+	"locpack-backend/pkg/utils/cursor"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type activityRepoImpl struct {
+	db adapter.Database
+}
+
+func NewActivityRepository(db adapter.Database) storage.ActivityRepository {
+	return &activityRepoImpl{db}
+}
+
+// GetFeedFull returns the activities of the users followed by the user,
+// newest first. Activities about packs the user cannot list and about hidden
+// or deleted content are left out.
+func (r *activityRepoImpl) GetFeedFull(ctx context.Context, userID string, limit int, after *cursor.Cursor) ([]entity.Activity, int64, error) {
+	var a []entity.Activity
+	var total int64
+
+	db := conn(ctx, r.db)
+	subquery := db.Session(&gorm.Session{NewDB: true})
+
+	filter := db.
+		Model(&entity.Activity{}).
+		Where(
+			"activities.user_id IN (?)",
+			subquery.
+				Table("user_follows").
+				Select("user_follows.followee_id").
+				Joins("JOIN users ON users.id = user_follows.follower_id").
+				Where("lower(users.public_id) = lower(?)", userID),
+		).
+		Where(
+			"activities.pack_id IS NULL OR activities.pack_id IN (?)",
+			subquery.
+				Model(&entity.Pack{}).
+				Select("packs.id").
+				Where("packs.hidden_at IS NULL").
+				Scopes(visibleTo(userID, pack_visibility.Public)),
+		).
+		Where(
+			"activities.place_id IS NULL OR activities.place_id IN (?)",
+			subquery.
+				Model(&entity.Place{}).
+				Select("places.id").
+				Where("places.hidden_at IS NULL"),
+		).
+		Session(&gorm.Session{})
+
+	result := filter.Count(&total)
+	if result.Error != nil {
+		return a, 0, translateError(result.Error)
+	}
+
+	result = filter.
+		Preload("User").
+		Preload("Pack").
+		Preload("Place").
+		Order("activities.created_at DESC, activities.public_id DESC").
+		Scopes(keysetAfter(after, "activities.created_at", "activities.public_id")).
+		Limit(limit).
+		Find(&a)
+	return a, total, translateError(result.Error)
+}
+
+func (r *activityRepoImpl) Create(ctx context.Context, a entity.Activity) error {
+	result := conn(ctx, r.db).Omit(clause.Associations).Create(&a)
+	return translateError(result.Error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"locpack-backend/internal/storage"
+
+	"gorm.io/gorm"
+)
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fmt.Errorf("%w: %w", storage.ErrNotFound, err)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return fmt.Errorf("%w: %w", storage.ErrDuplicate, err)
+	}
+	return err
+}
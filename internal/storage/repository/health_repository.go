@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/pkg/adapter"
+)
+
+type healthRepoImpl struct {
+	db adapter.Database
+}
+
+func NewHealthRepository(db adapter.Database) storage.HealthRepository {
+	return &healthRepoImpl{db}
+}
+
+func (r *healthRepoImpl) Ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
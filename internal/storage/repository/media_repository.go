@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+
+	"gorm.io/gorm/clause"
+)
+
+type mediaRepoImpl struct {
+	db adapter.Database
+}
+
+func NewMediaRepository(db adapter.Database) storage.MediaRepository {
+	return &mediaRepoImpl{db}
+}
+
+func (r *mediaRepoImpl) GetByPublicID(ctx context.Context, id string) (entity.Media, error) {
+	var m entity.Media
+	result := conn(ctx, r.db).First(&m, "public_id = ?", id)
+	return m, translateError(result.Error)
+}
+
+func (r *mediaRepoImpl) Create(ctx context.Context, m entity.Media) error {
+	result := conn(ctx, r.db).Omit(clause.Associations).Create(&m)
+	return translateError(result.Error)
+}
+
+func (r *mediaRepoImpl) Delete(ctx context.Context, m entity.Media) error {
+	result := conn(ctx, r.db).Delete(&m)
+	return translateError(result.Error)
+}
+
+// purgeMedia deletes the media attached through column to the owners
+// selected by the subquery, and returns the keys of their files, which are
+// not removed with the rows.
+func purgeMedia(tx adapter.Database, column string, owners adapter.Database) ([]string, error) {
+	var m []entity.Media
+	result := tx.Raw("DELETE FROM media WHERE "+column+" IN (?) RETURNING key, thumbnail_key", owners).Scan(&m)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	keys := make([]string, 0, 2*len(m))
+	for _, media := range m {
+		keys = append(keys, media.Key, media.ThumbnailKey)
+	}
+	return keys, nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/utils/cursor"
+)
+
+type moderationRepoImpl struct {
+	db adapter.Database
+}
+
+func NewModerationRepository(db adapter.Database) storage.ModerationRepository {
+	return &moderationRepoImpl{db}
+}
+
+func (r *moderationRepoImpl) GetAllFull(ctx context.Context, limit int, after *cursor.Cursor) ([]entity.ModerationAction, int64, error) {
+	var a []entity.ModerationAction
+	var total int64
+
+	result := conn(ctx, r.db).Model(&entity.ModerationAction{}).Count(&total)
+	if result.Error != nil {
+		return a, 0, translateError(result.Error)
+	}
+
+	result = conn(ctx, r.db).
+		Preload("Moderator").
+		Order("moderation_actions.created_at DESC, moderation_actions.public_id DESC").
+		Scopes(keysetAfter(after, "moderation_actions.created_at", "moderation_actions.public_id")).
+		Limit(limit).
+		Find(&a)
+	return a, total, translateError(result.Error)
+}
+
+func (r *moderationRepoImpl) Create(ctx context.Context, a entity.ModerationAction) error {
+	result := conn(ctx, r.db).Create(&a)
+	return translateError(result.Error)
+}
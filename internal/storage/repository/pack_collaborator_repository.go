@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+
+	"gorm.io/gorm/clause"
+)
+
+type packCollaboratorRepoImpl struct {
+	db adapter.Database
+}
+
+func NewPackCollaboratorRepository(db adapter.Database) storage.PackCollaboratorRepository {
+	return &packCollaboratorRepoImpl{db}
+}
+
+func (r *packCollaboratorRepoImpl) Create(ctx context.Context, c entity.PackCollaborator) error {
+	result := conn(ctx, r.db).Omit(clause.Associations).Create(&c)
+	return translateError(result.Error)
+}
+
+func (r *packCollaboratorRepoImpl) Update(ctx context.Context, c entity.PackCollaborator) error {
+	result := conn(ctx, r.db).Omit(clause.Associations).Save(&c)
+	return translateError(result.Error)
+}
+
+func (r *packCollaboratorRepoImpl) Delete(ctx context.Context, c entity.PackCollaborator) error {
+	result := conn(ctx, r.db).Delete(&c)
+	return translateError(result.Error)
+}
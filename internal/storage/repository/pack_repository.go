@@ -1,11 +1,42 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"locpack-backend/internal/storage"
 	"locpack-backend/internal/storage/entity"
 	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/enum/pack_visibility"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// completedAtColumn selects when the user, given by public ID, completed the
+// pack while following it.
+const completedAtColumn = "(SELECT pack_followed_users.completed_at FROM pack_followed_users " +
+	"JOIN users ON users.id = pack_followed_users.user_id " +
+	"WHERE pack_followed_users.pack_id = packs.id AND lower(users.public_id) = lower(?)) AS completed_at"
+
+// completableExpr matches the follows of packs that are not hidden or deleted
+// and contain the place, whose visible places have all been visited by the
+// follower. Follows already completed match only when the place was visited
+// for the first time, that is when places were added to the pack since.
+const completableExpr = "pack_followed_users.user_id = ? " +
+	"AND pack_followed_users.pack_id IN (SELECT pack_places.pack_id FROM pack_places " +
+	"JOIN packs ON packs.id = pack_places.pack_id AND packs.deleted_at IS NULL AND packs.hidden_at IS NULL " +
+	"WHERE pack_places.place_id = ?) " +
+	"AND NOT EXISTS (SELECT 1 FROM pack_places " +
+	"JOIN places ON places.id = pack_places.place_id AND places.deleted_at IS NULL AND places.hidden_at IS NULL " +
+	"WHERE pack_places.pack_id = pack_followed_users.pack_id " +
+	"AND NOT EXISTS (SELECT 1 FROM visits WHERE visits.user_id = ? AND visits.place_id = pack_places.place_id)) " +
+	"AND (pack_followed_users.completed_at IS NULL " +
+	"OR (SELECT count(*) FROM visits WHERE visits.user_id = ? AND visits.place_id = ?) = 1)"
+
 type packRepoImpl struct {
 	db adapter.Database
 }
@@ -14,29 +45,230 @@ func NewPackRepository(db adapter.Database) storage.PackRepository {
 	return &packRepoImpl{db}
 }
 
-func (r *packRepoImpl) GetByPublicIDFull(id string) (entity.Pack, error) {
+func (r *packRepoImpl) GetByPublicID(ctx context.Context, id string) (entity.Pack, error) {
+	var p entity.Pack
+	result := conn(ctx, r.db).Preload("Author").First(&p, "public_id = ?", id)
+	return p, translateError(result.Error)
+}
+
+// GetByPublicIDFull returns a pack that userID may open by its ID, which
+// excludes private packs of other users.
+func (r *packRepoImpl) GetByPublicIDFull(ctx context.Context, id string, userID string) (entity.Pack, error) {
 	var p entity.Pack
-	result := r.db.Preload("FollowedUsers").Preload("Author").First(&p, "public_id = ?", id)
-	return p, result.Error
+	result := connForUpdate(ctx, r.db).
+		Scopes(preloadFull, visibleTo(userID, pack_visibility.Public, pack_visibility.Unlisted)).
+		Select("packs.*, "+completedAtColumn, userID).
+		First(&p, "public_id = ? AND hidden_at IS NULL", id)
+	return p, translateError(result.Error)
 }
 
-func (r *packRepoImpl) GetByNameOrAuthorFull(query string) ([]entity.Pack, error) {
+// GetByIDFull returns a pack regardless of its visibility. Internal IDs are
+// never exposed, so callers must have been granted access to the pack.
+func (r *packRepoImpl) GetByIDFull(ctx context.Context, id uuid.UUID) (entity.Pack, error) {
+	var p entity.Pack
+	result := connForUpdate(ctx, r.db).
+		Scopes(preloadFull).
+		First(&p, "id = ? AND hidden_at IS NULL", id)
+	return p, translateError(result.Error)
+}
+
+// GetByNameOrAuthorFull returns packs that userID may find matching the query
+// past the cursor, sorted by relevance, which is their rank, then newest
+// first.
+func (r *packRepoImpl) GetByNameOrAuthorFull(ctx context.Context, query string, userID string, limit int, after *cursor.Cursor) ([]entity.Pack, int64, error) {
 	var p []entity.Pack
-	result := r.db.
+	var total int64
+
+	filter := conn(ctx, r.db).
+		Model(&entity.Pack{}).
 		Joins("JOIN users ON users.id = packs.author_id").
-		Preload("FollowedUsers").
+		Where("packs.hidden_at IS NULL").
+		Scopes(visibleTo(userID, pack_visibility.Public))
+	if len(query) != 0 {
+		filter = filter.Where(packMatchExpr, query, query, query, query, query)
+	}
+	filter = filter.Session(&gorm.Session{})
+
+	result := filter.Count(&total)
+	if result.Error != nil {
+		return p, 0, translateError(result.Error)
+	}
+
+	list := filter.
+		Preload("FollowedUsers", "lower(users.public_id) = lower(?)", userID).
+		Preload("Collaborators", "user_id IN (SELECT id FROM users WHERE lower(public_id) = lower(?))", userID).
+		Preload("Collaborators.User").
 		Preload("Author").
-		Where("LOWER(packs.name) LIKE LOWER(?) OR LOWER(packs.public_id) LIKE LOWER(?) OR LOWER(users.public_id) LIKE LOWER(?)", "%"+query+"%", "%"+query+"%", "%"+query+"%").
+		Preload("Cover")
+	scores := []clause.Expr{}
+	if len(query) != 0 {
+		list = list.
+			Select("packs.*, "+packRankExpr+" AS rank, "+headline("packs.name", "name_headline"), query, query, query, query, headlineOptions).
+			Order("rank DESC, packs.created_at DESC, packs.public_id DESC")
+		scores = append(scores, clause.Expr{SQL: packRankExpr, Vars: []any{query, query, query}})
+	} else {
+		list = list.Select("packs.*").Order("packs.created_at DESC, packs.public_id DESC")
+	}
+
+	result = list.
+		Scopes(keysetAfter(after, "packs.created_at", "packs.public_id", scores...)).
+		Limit(limit).
 		Find(&p)
-	return p, result.Error
+	return p, total, translateError(result.Error)
+}
+
+func (r *packRepoImpl) GetDeletedByPublicID(ctx context.Context, id string) (entity.Pack, error) {
+	var p entity.Pack
+	result := conn(ctx, r.db).Unscoped().Preload("Author").First(&p, "public_id = ? AND deleted_at IS NOT NULL", id)
+	return p, translateError(result.Error)
+}
+
+func (r *packRepoImpl) Create(ctx context.Context, p entity.Pack) error {
+	createErr := conn(ctx, r.db).Create(&p).Error
+	return translateError(createErr)
+}
+
+// Update saves p with its place entries and increments its version. It fails
+// with storage.ErrStale when the pack was updated since p was read.
+func (r *packRepoImpl) Update(ctx context.Context, p entity.Pack) error {
+	return conn(ctx, r.db).Transaction(func(tx adapter.Database) error {
+		err := bumpVersion(tx, &entity.Pack{}, p.ID, p.Version)
+		if err != nil {
+			return err
+		}
+
+		p.Version++
+		err = tx.Omit("Places", "PlaceEntries", "FollowedUsers", "Collaborators", "Cover").Save(&p).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("pack_id = ?", p.ID).Delete(&entity.PackPlace{}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(p.PlaceEntries) == 0 {
+			return nil
+		}
+
+		for i := range p.PlaceEntries {
+			p.PlaceEntries[i].PackID = p.ID
+		}
+		return tx.Omit(clause.Associations).Create(&p.PlaceEntries).Error
+	})
 }
 
-func (r *packRepoImpl) Create(p entity.Pack) error {
-	createErr := r.db.Create(&p).Error
-	return createErr
+func (r *packRepoImpl) SetHidden(ctx context.Context, p entity.Pack, hiddenAt *time.Time) error {
+	result := conn(ctx, r.db).Model(&p).Update("hidden_at", hiddenAt)
+	return result.Error
+}
+
+func (r *packRepoImpl) SetAuthor(ctx context.Context, p entity.Pack, authorID uuid.UUID) error {
+	result := conn(ctx, r.db).Model(&p).Update("author_id", authorID)
+	return translateError(result.Error)
 }
 
-func (r *packRepoImpl) Update(p entity.Pack) error {
-	result := r.db.Save(&p)
+func (r *packRepoImpl) Delete(ctx context.Context, p entity.Pack) error {
+	result := conn(ctx, r.db).Delete(&p)
 	return result.Error
 }
+
+func (r *packRepoImpl) Restore(ctx context.Context, p entity.Pack) error {
+	result := conn(ctx, r.db).Unscoped().Model(&p).Update("deleted_at", nil)
+	return result.Error
+}
+
+// Follow adds the user to the followers of the pack. Followers are kept
+// outside of the pack row, so following does not change its version.
+func (r *packRepoImpl) Follow(ctx context.Context, p entity.Pack, userID uuid.UUID) error {
+	result := conn(ctx, r.db).Exec("INSERT INTO pack_followed_users (pack_id, user_id) VALUES (?, ?) ON CONFLICT DO NOTHING", p.ID, userID)
+	return translateError(result.Error)
+}
+
+// Unfollow removes the user from the followers of the pack, together with
+// their completion of it.
+func (r *packRepoImpl) Unfollow(ctx context.Context, p entity.Pack, userID uuid.UUID) error {
+	result := conn(ctx, r.db).Exec("DELETE FROM pack_followed_users WHERE pack_id = ? AND user_id = ?", p.ID, userID)
+	return translateError(result.Error)
+}
+
+// CompleteByPlaceID marks as completed at completedAt the packs followed by
+// the user that the visit of the place completed, and returns them with
+// their authors.
+func (r *packRepoImpl) CompleteByPlaceID(ctx context.Context, userID uuid.UUID, placeID uuid.UUID, completedAt time.Time) ([]entity.Pack, error) {
+	var p []entity.Pack
+	var packsIDs []uuid.UUID
+
+	result := conn(ctx, r.db).
+		Raw(
+			"UPDATE pack_followed_users SET completed_at = ? WHERE "+completableExpr+" RETURNING pack_id",
+			completedAt, userID, placeID, userID, userID, placeID,
+		).
+		Scan(&packsIDs)
+	if result.Error != nil || len(packsIDs) == 0 {
+		return p, translateError(result.Error)
+	}
+
+	result = conn(ctx, r.db).Preload("Author").Find(&p, "id IN ?", packsIDs)
+	return p, translateError(result.Error)
+}
+
+func (r *packRepoImpl) PurgeDeleted(ctx context.Context, before time.Time) ([]string, error) {
+	var keys []string
+	err := conn(ctx, r.db).Transaction(func(tx adapter.Database) error {
+		deleted := tx.Unscoped().Model(&entity.Pack{}).Select("id").Where("deleted_at < ?", before)
+
+		for _, table := range []string{"pack_places", "place_packs", "pack_followed_users"} {
+			err := tx.Exec("DELETE FROM "+table+" WHERE pack_id IN (?)", deleted).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+		keys, err = purgeMedia(tx, "pack_id", deleted)
+		if err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Where("deleted_at < ?", before).Delete(&entity.Pack{}).Error
+	})
+	return keys, err
+}
+
+// visibleTo limits packs to the given visibilities. Authors and
+// collaborators who accepted their invitation see their packs regardless of
+// visibility.
+func visibleTo(userID string, visibilities ...types.PackVisibility) func(adapter.Database) adapter.Database {
+	return func(db adapter.Database) adapter.Database {
+		return db.Where(
+			"packs.visibility IN ? OR packs.author_id IN (?) OR packs.id IN (?)",
+			visibilities,
+			db.Session(&gorm.Session{NewDB: true}).
+				Table("users").
+				Select("id").
+				Where("lower(public_id) = lower(?)", userID),
+			db.Session(&gorm.Session{NewDB: true}).
+				Table("pack_collaborators").
+				Select("pack_collaborators.pack_id").
+				Joins("JOIN users ON users.id = pack_collaborators.user_id").
+				Where("lower(users.public_id) = lower(?) AND pack_collaborators.accepted_at IS NOT NULL", userID),
+		)
+	}
+}
+
+func preloadFull(db adapter.Database) adapter.Database {
+	return db.
+		Preload("FollowedUsers").
+		Preload("Author").
+		Preload("PlaceEntries", func(db adapter.Database) adapter.Database {
+			return db.Order("pack_places.position, pack_places.place_id")
+		}).
+		Preload("PlaceEntries.Place", func(db adapter.Database) adapter.Database {
+			return db.Select("places.*, " + ratingColumns).Where("places.hidden_at IS NULL")
+		}).
+		Preload("PlaceEntries.Place.Visitors.User").
+		Preload("Collaborators.User").
+		Preload("Cover")
+}
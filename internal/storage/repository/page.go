@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"strings"
+
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/utils/cursor"
+
+	"gorm.io/gorm/clause"
+)
+
+// keysetAfter limits a list to the items past the cursor. The list must be sorted
+// by the scores, then by the time and ID columns, all descending, which is
+// the order a cursor.Cursor describes.
+func keysetAfter(c *cursor.Cursor, timeColumn string, idColumn string, scores ...clause.Expr) func(adapter.Database) adapter.Database {
+	return func(db adapter.Database) adapter.Database {
+		if c == nil {
+			return db
+		}
+
+		keys := make([]string, 0, len(scores)+2)
+		vars := []any{}
+		for _, score := range scores {
+			keys = append(keys, score.SQL)
+			vars = append(vars, score.Vars...)
+		}
+		keys = append(keys, timeColumn, idColumn)
+		for _, score := range c.Scores {
+			vars = append(vars, score)
+		}
+		vars = append(vars, c.Time, c.ID)
+
+		params := strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ")
+		return db.Where("("+strings.Join(keys, ", ")+") < ("+params+")", vars...)
+	}
+}
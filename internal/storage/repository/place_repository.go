@@ -1,9 +1,62 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
 	"locpack-backend/internal/storage"
 	"locpack-backend/internal/storage/entity"
 	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/enum/place_sort"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371000
+
+// distanceExpr is the haversine great-circle distance in meters between a place
+// and the point given by the (latitude, latitude, longitude) arguments. Rounding
+// can push the argument of ASIN slightly above 1 for antipodal points, so it is
+// clamped.
+const distanceExpr = "6371000 * 2 * ASIN(LEAST(1, SQRT(" +
+	"POWER(SIN(RADIANS(places.latitude - ?) / 2), 2) + " +
+	"COS(RADIANS(?)) * COS(RADIANS(places.latitude)) * POWER(SIN(RADIANS(places.longitude - ?) / 2), 2))))"
+
+// Places are considered duplicates when their names are similar and, if both
+// have coordinates, they are close to each other, otherwise when their
+// addresses are similar. Names and addresses are compared case and accent
+// insensitively, so that "Louvre" matches "louvre museum".
+const (
+	normalizeExpr = "unaccent(lower(%s))"
+
+	duplicateNameSimilarity    = 0.6
+	duplicateAddressSimilarity = 0.5
+	duplicateDistance          = 200
+	maxDuplicates              = 5
+)
+
+// similarityExpr is the word similarity between the column given by %s and
+// the (text, text) arguments in whichever direction matches better.
+var similarityExpr = fmt.Sprintf(
+	"GREATEST(word_similarity(%[1]s, %[2]s), word_similarity(%[2]s, %[1]s))",
+	fmt.Sprintf(normalizeExpr, "?"),
+	fmt.Sprintf(normalizeExpr, "%[1]s"),
+)
+
+// ratingColumns selects the average rating and the number of reviews of
+// places. Places without reviews have an average of 0. The average is a
+// double rather than a numeric, so that it is the same value when it comes
+// back in a cursor and pages do not skip or repeat places tied on it.
+const (
+	ratingAverageExpr = "COALESCE((SELECT avg(reviews.rating)::float8 FROM reviews WHERE reviews.place_id = places.id), 0)"
+	ratingCountExpr   = "(SELECT count(*) FROM reviews WHERE reviews.place_id = places.id)"
+	ratingColumns     = ratingAverageExpr + " AS rating_average, " + ratingCountExpr + " AS rating_count"
 )
 
 type placeRepoImpl struct {
@@ -14,36 +67,252 @@ func NewPlaceRepository(db adapter.Database) storage.PlaceRepository {
 	return &placeRepoImpl{db}
 }
 
-func (r *placeRepoImpl) GetByPublicID(id string) (entity.Place, error) {
+func (r *placeRepoImpl) GetByPublicID(ctx context.Context, id string) (entity.Place, error) {
 	var p entity.Place
-	result := r.db.First(&p, "public_id = ?", id)
-	return p, result.Error
+	result := conn(ctx, r.db).First(&p, "public_id = ?", id)
+	return p, translateError(result.Error)
 }
 
-func (r *placeRepoImpl) GetByPublicIDFull(id string) (entity.Place, error) {
+// GetByPublicIDFull returns a visible place with its visitors, gallery and
+// rating.
+func (r *placeRepoImpl) GetByPublicIDFull(ctx context.Context, id string) (entity.Place, error) {
 	var p entity.Place
-	result := r.db.Preload("Visitors").First(&p, "public_id = ?", id)
-	return p, result.Error
+	result := connForUpdate(ctx, r.db).
+		Preload("Visitors.User").
+		Preload("Media", func(db adapter.Database) adapter.Database {
+			return db.Order("media.created_at, media.id")
+		}).
+		Select("places.*, "+ratingColumns).
+		First(&p, "public_id = ? AND hidden_at IS NULL", id)
+	return p, translateError(result.Error)
 }
 
-func (r *placeRepoImpl) GetByNameOrAddress(query string) ([]entity.Place, error) {
+func (r *placeRepoImpl) GetByNameOrAddress(ctx context.Context, query string) ([]entity.Place, error) {
 	var p []entity.Place
-	result := r.db.Find(&p, "lower(name) LIKE lower(?) OR lower(address) LIKE lower(?)", "%"+query+"%", "%"+query+"%")
-	return p, result.Error
+	result := conn(ctx, r.db).
+		Where(placeMatchExpr, query, query, query).
+		Where("places.hidden_at IS NULL").
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  placeRankExpr + " DESC, places.created_at DESC, places.id",
+			Vars: []any{query, query, query},
+		}}).
+		Find(&p)
+	return p, translateError(result.Error)
 }
 
-func (r *placeRepoImpl) GetByNameOrAddressFull(query string) ([]entity.Place, error) {
+// GetByNameOrAddressFull returns visible places matching the query past the
+// cursor. They are sorted by rating when sort is place_sort.Rating, then by
+// relevance, newest first when nothing else tells them apart. The rank of
+// each place is its relevance.
+func (r *placeRepoImpl) GetByNameOrAddressFull(ctx context.Context, query string, sort types.PlaceSort, limit int, after *cursor.Cursor) ([]entity.Place, int64, error) {
 	var p []entity.Place
-	result := r.db.Preload("Visitors").Find(&p, "lower(name) LIKE lower(?) OR lower(address) LIKE lower(?)", "%"+query+"%", "%"+query+"%")
-	return p, result.Error
+	var total int64
+
+	filter := conn(ctx, r.db).
+		Model(&entity.Place{}).
+		Where("places.hidden_at IS NULL")
+	if len(query) != 0 {
+		filter = filter.Where(placeMatchExpr, query, query, query)
+	}
+	filter = filter.Session(&gorm.Session{})
+
+	result := filter.Count(&total)
+	if result.Error != nil {
+		return p, 0, translateError(result.Error)
+	}
+
+	order := ""
+	scores := []clause.Expr{}
+	if sort == place_sort.Rating {
+		order = "rating_average DESC, rating_count DESC, "
+		scores = append(scores, clause.Expr{SQL: ratingAverageExpr}, clause.Expr{SQL: ratingCountExpr})
+	}
+
+	list := filter.Preload("Visitors.User")
+	if len(query) != 0 {
+		list = list.
+			Select(
+				"places.*, "+ratingColumns+", "+placeRankExpr+" AS rank, "+
+					headline("places.name", "name_headline")+", "+headline("places.address", "address_headline"),
+				query, query, query, query, headlineOptions, query, headlineOptions,
+			).
+			Order(order + "rank DESC, places.created_at DESC, places.public_id DESC")
+		scores = append(scores, clause.Expr{SQL: placeRankExpr, Vars: []any{query, query, query}})
+	} else {
+		list = list.
+			Select("places.*, " + ratingColumns).
+			Order(order + "places.created_at DESC, places.public_id DESC")
+	}
+
+	result = list.
+		Scopes(keysetAfter(after, "places.created_at", "places.public_id", scores...)).
+		Limit(limit).
+		Find(&p)
+	return p, total, translateError(result.Error)
+}
+
+// GetNearbyFull returns up to limit visible places within radius meters of
+// the given point, nearest first. The bounding box of the circle narrows the
+// rows down before the exact distance is computed.
+func (r *placeRepoImpl) GetNearbyFull(ctx context.Context, lat float64, lng float64, radius float64, limit int) ([]entity.Place, error) {
+	query := conn(ctx, r.db).
+		Preload("Visitors.User").
+		Select("places.*, "+ratingColumns+", "+distanceExpr+" AS distance", lat, lat, lng).
+		Where("places.latitude BETWEEN ? AND ?", lat-radiusDegrees(radius), lat+radiusDegrees(radius))
+	// Near the poles a degree of longitude shrinks to nothing, and a box that
+	// crosses the antimeridian wraps around, so longitude is left unbounded.
+	if cos := math.Cos(lat * math.Pi / 180); math.Abs(lat)+radiusDegrees(radius) < 90 && cos > 0 {
+		dLng := radiusDegrees(radius) / cos
+		if lng-dLng >= -180 && lng+dLng <= 180 {
+			query = query.Where("places.longitude BETWEEN ? AND ?", lng-dLng, lng+dLng)
+		}
+	}
+
+	var p []entity.Place
+	result := query.
+		Where(distanceExpr+" <= ?", lat, lat, lng, radius).
+		Where("places.hidden_at IS NULL").
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: distanceExpr, Vars: []any{lat, lat, lng}}}).
+		Limit(limit).
+		Find(&p)
+	return p, translateError(result.Error)
+}
+
+// radiusDegrees converts a distance in meters to degrees of latitude.
+func radiusDegrees(radius float64) float64 {
+	return radius / earthRadius * 180 / math.Pi
 }
 
-func (r *placeRepoImpl) Create(p entity.Place) error {
-	createErr := r.db.Create(&p).Error
-	return createErr
+// GetDuplicatesFull returns up to maxDuplicates visible places that are
+// likely the same as a place with the given attributes, most similar first.
+// Nil coordinates mean that the location is unknown.
+func (r *placeRepoImpl) GetDuplicatesFull(ctx context.Context, name string, address string, lat *float64, lng *float64) ([]entity.Place, error) {
+	nameSimilarity := fmt.Sprintf(similarityExpr, "places.name")
+	addressSimilarity := fmt.Sprintf(similarityExpr, "places.address")
+
+	query := conn(ctx, r.db).
+		Preload("Visitors.User").
+		Select("places.*, "+ratingColumns).
+		Where("places.hidden_at IS NULL").
+		Where(nameSimilarity+" >= ?", name, name, duplicateNameSimilarity)
+	if lat != nil && lng != nil {
+		query = query.
+			Select("places.*, "+ratingColumns+", "+distanceExpr+" AS distance", *lat, *lat, *lng).
+			Where(
+				"(places.latitude IS NULL AND "+addressSimilarity+" >= ? OR "+distanceExpr+" <= ?)",
+				address, address, duplicateAddressSimilarity, *lat, *lat, *lng, duplicateDistance,
+			)
+	} else {
+		query = query.Where(addressSimilarity+" >= ?", address, address, duplicateAddressSimilarity)
+	}
+
+	var p []entity.Place
+	result := query.
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  nameSimilarity + " DESC, places.created_at, places.id",
+			Vars: []any{name, name},
+		}}).
+		Limit(maxDuplicates).
+		Find(&p)
+	return p, translateError(result.Error)
 }
 
-func (r *placeRepoImpl) Update(p entity.Place) error {
-	result := r.db.Save(&p)
+func (r *placeRepoImpl) GetDeletedByPublicID(ctx context.Context, id string) (entity.Place, error) {
+	var p entity.Place
+	result := conn(ctx, r.db).Unscoped().Preload("Author").First(&p, "public_id = ? AND deleted_at IS NOT NULL", id)
+	return p, translateError(result.Error)
+}
+
+func (r *placeRepoImpl) Create(ctx context.Context, p entity.Place) error {
+	createErr := conn(ctx, r.db).Omit("Visitors").Create(&p).Error
+	return translateError(createErr)
+}
+
+// Update saves p and increments its version. It fails with storage.ErrStale
+// when the place was updated since p was read.
+func (r *placeRepoImpl) Update(ctx context.Context, p entity.Place) error {
+	return conn(ctx, r.db).Transaction(func(tx adapter.Database) error {
+		err := bumpVersion(tx, &entity.Place{}, p.ID, p.Version)
+		if err != nil {
+			return err
+		}
+
+		p.Version++
+		return tx.Omit("Media", "Visitors").Save(&p).Error
+	})
+}
+
+func (r *placeRepoImpl) SetHidden(ctx context.Context, p entity.Place, hiddenAt *time.Time) error {
+	result := conn(ctx, r.db).Model(&p).Update("hidden_at", hiddenAt)
+	return result.Error
+}
+
+func (r *placeRepoImpl) Delete(ctx context.Context, p entity.Place) error {
+	result := conn(ctx, r.db).Delete(&p)
+	return result.Error
+}
+
+func (r *placeRepoImpl) Restore(ctx context.Context, p entity.Place) error {
+	result := conn(ctx, r.db).Unscoped().Model(&p).Update("deleted_at", nil)
 	return result.Error
 }
+
+// Merge moves the packs, reviews, visits, media and activities of from to
+// into and deletes from. Rows that into already has are dropped instead of
+// being duplicated.
+func (r *placeRepoImpl) Merge(ctx context.Context, from entity.Place, into entity.Place) error {
+	return conn(ctx, r.db).Transaction(func(tx adapter.Database) error {
+		tables := []struct{ name, key string }{
+			{"pack_places", "pack_id"},
+			{"place_packs", "pack_id"},
+			{"reviews", "author_id"},
+		}
+		for _, table := range tables {
+			err := tx.Exec(
+				"UPDATE "+table.name+" SET place_id = ? WHERE place_id = ? AND "+table.key+
+					" NOT IN (SELECT "+table.key+" FROM "+table.name+" WHERE place_id = ?)",
+				into.ID, from.ID, into.ID,
+			).Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.Exec("DELETE FROM "+table.name+" WHERE place_id = ?", from.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, table := range []string{"visits", "activities", "media"} {
+			err := tx.Exec("UPDATE "+table+" SET place_id = ? WHERE place_id = ?", into.ID, from.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Delete(&from).Error
+	})
+}
+
+func (r *placeRepoImpl) PurgeDeleted(ctx context.Context, before time.Time) ([]string, error) {
+	var keys []string
+	err := conn(ctx, r.db).Transaction(func(tx adapter.Database) error {
+		deleted := tx.Unscoped().Model(&entity.Place{}).Select("id").Where("deleted_at < ?", before)
+
+		for _, table := range []string{"pack_places", "place_packs"} {
+			err := tx.Exec("DELETE FROM "+table+" WHERE place_id IN (?)", deleted).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+		keys, err = purgeMedia(tx, "place_id", deleted)
+		if err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Where("deleted_at < ?", before).Delete(&entity.Place{}).Error
+	})
+	return keys, err
+}
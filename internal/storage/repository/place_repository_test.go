@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"locpack-backend/pkg/enum/place_sort"
+	"locpack-backend/pkg/utils/cursor"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestPlaceRepository_GetByNameOrAddressFull_RatingCursor(t *testing.T) {
+	t.Parallel()
+
+	// A dry run builds the statements without a database, and the callback
+	// records them.
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.NoError(t, err)
+
+	var sqls []string
+	var vars [][]any
+	err = db.Callback().Query().After("gorm:query").Register("test:record", func(tx *gorm.DB) {
+		sqls = append(sqls, tx.Statement.SQL.String())
+		vars = append(vars, tx.Statement.Vars)
+	})
+	require.NoError(t, err)
+
+	after := &cursor.Cursor{Scores: []float64{11.0 / 3, 3}, Time: time.Now(), ID: "place1"}
+	_, _, err = NewPlaceRepository(db).GetByNameOrAddressFull(context.Background(), "", place_sort.Rating, 2, after)
+	require.NoError(t, err)
+
+	// The count comes first, then the page, whose select, order and keyset
+	// all compare the same double average that the cursor carries.
+	require.Len(t, sqls, 2)
+	assert.Equal(t, 2, strings.Count(sqls[1], ratingAverageExpr))
+	assert.Contains(t, sqls[1], "ORDER BY rating_average DESC")
+	assert.Contains(t, ratingAverageExpr, "::float8")
+	assert.Equal(t, 11.0/3, vars[1][0])
+}
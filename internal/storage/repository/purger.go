@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/pkg/adapter"
+)
+
+// RunPurger permanently removes soft-deleted records once their retention
+// period is over, along with the files of their media. It blocks until ctx
+// is cancelled.
+func RunPurger(ctx context.Context, interval time.Duration, retention time.Duration, blobStorage adapter.BlobStorage, purgers ...storage.Purger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, purger := range purgers {
+			keys, err := purger.PurgeDeleted(ctx, time.Now().Add(-retention))
+			if err != nil {
+				log.Printf("purge deleted records: %v", err)
+			}
+			// The records are gone, so a file that fails to be deleted is
+			// only left unreachable.
+			for _, key := range keys {
+				err = blobStorage.Delete(ctx, key)
+				if err != nil {
+					log.Printf("purge deleted records: delete blob %s: %v", key, err)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
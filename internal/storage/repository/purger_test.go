@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"locpack-backend/internal/storage"
+)
+
+// recordingBlobs records the keys of the deleted files.
+type recordingBlobs struct {
+	deleted []string
+}
+
+func (b *recordingBlobs) Put(context.Context, string, string, []byte) error {
+	return nil
+}
+
+func (b *recordingBlobs) Delete(_ context.Context, key string) error {
+	b.deleted = append(b.deleted, key)
+	return nil
+}
+
+func (b *recordingBlobs) URL(key string) string {
+	return key
+}
+
+func TestRunPurger_DeletesMediaFiles(t *testing.T) {
+	t.Parallel()
+
+	placeRepo := storage.NewMockPlaceRepository(t)
+	packRepo := storage.NewMockPackRepository(t)
+	blobs := &recordingBlobs{}
+
+	placeRepo.EXPECT().PurgeDeleted(mock.Anything, mock.Anything).Return([]string{"places/a.png", "places/a_thumb.png"}, nil).Once()
+	packRepo.EXPECT().PurgeDeleted(mock.Anything, mock.Anything).Return(nil, errors.New("purge failed")).Once()
+
+	// A cancelled context makes the purger stop after its first pass.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	RunPurger(ctx, time.Hour, time.Hour, blobs, placeRepo, packRepo)
+
+	assert.Equal(t, []string{"places/a.png", "places/a_thumb.png"}, blobs.deleted)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/utils/cursor"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reviewRepoImpl struct {
+	db adapter.Database
+}
+
+func NewReviewRepository(db adapter.Database) storage.ReviewRepository {
+	return &reviewRepoImpl{db}
+}
+
+func (r *reviewRepoImpl) GetByPublicID(ctx context.Context, id string) (entity.Review, error) {
+	var rv entity.Review
+	result := conn(ctx, r.db).First(&rv, "public_id = ?", id)
+	return rv, translateError(result.Error)
+}
+
+// GetByPlaceIDFull returns the reviews of the place with their authors,
+// newest first.
+func (r *reviewRepoImpl) GetByPlaceIDFull(ctx context.Context, placeID uuid.UUID, limit int, after *cursor.Cursor) ([]entity.Review, int64, error) {
+	var rv []entity.Review
+	var total int64
+
+	filter := conn(ctx, r.db).
+		Model(&entity.Review{}).
+		Where("reviews.place_id = ?", placeID).
+		Session(&gorm.Session{})
+
+	result := filter.Count(&total)
+	if result.Error != nil {
+		return rv, 0, translateError(result.Error)
+	}
+
+	result = filter.
+		Preload("Author").
+		Order("reviews.created_at DESC, reviews.public_id DESC").
+		Scopes(keysetAfter(after, "reviews.created_at", "reviews.public_id")).
+		Limit(limit).
+		Find(&rv)
+	return rv, total, translateError(result.Error)
+}
+
+func (r *reviewRepoImpl) Create(ctx context.Context, rv entity.Review) error {
+	result := conn(ctx, r.db).Omit(clause.Associations).Create(&rv)
+	return translateError(result.Error)
+}
+
+func (r *reviewRepoImpl) Update(ctx context.Context, rv entity.Review) error {
+	result := conn(ctx, r.db).Omit(clause.Associations).Save(&rv)
+	return translateError(result.Error)
+}
+
+func (r *reviewRepoImpl) Delete(ctx context.Context, rv entity.Review) error {
+	result := conn(ctx, r.db).Delete(&rv)
+	return translateError(result.Error)
+}
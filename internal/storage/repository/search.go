@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"fmt"
+
+	"locpack-backend/internal/storage"
+)
+
+// Searches combine full-text matching, which handles word order and ranks
+// results, with trigram word similarity, which tolerates typos and partial
+// words. Both are served by GIN indexes.
+const (
+	// tsQueryExpr parses the (query) argument as a full-text query.
+	tsQueryExpr = "websearch_to_tsquery('simple', ?)"
+
+	placeMatchExpr = "places.search @@ " + tsQueryExpr + " OR ? <% places.name OR ? <% places.address"
+	placeRankExpr  = "ts_rank(places.search, " + tsQueryExpr + ") + " +
+		"GREATEST(word_similarity(?, places.name), word_similarity(?, places.address))"
+
+	packMatchExpr = "packs.search @@ " + tsQueryExpr + " OR ? <% packs.name OR ? <% users.username " +
+		"OR lower(packs.public_id) = lower(?) OR lower(users.public_id) = lower(?)"
+	packRankExpr = "ts_rank(packs.search, " + tsQueryExpr + ") + " +
+		"GREATEST(word_similarity(?, packs.name), word_similarity(?, users.username))"
+
+	// headlineExpr highlights the words of the (query, options) arguments
+	// in the column given by %s.
+	headlineExpr = "ts_headline('simple', %s, " + tsQueryExpr + ", ?)"
+)
+
+var headlineOptions = fmt.Sprintf(
+	`StartSel="%s", StopSel="%s", HighlightAll=true`,
+	storage.HighlightStart,
+	storage.HighlightStop,
+)
+
+func headline(column string, alias string) string {
+	return fmt.Sprintf(headlineExpr, column) + " AS " + alias
+}
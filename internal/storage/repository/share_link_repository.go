@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+
+	"github.com/google/uuid"
+)
+
+type shareLinkRepoImpl struct {
+	db adapter.Database
+}
+
+func NewShareLinkRepository(db adapter.Database) storage.ShareLinkRepository {
+	return &shareLinkRepoImpl{db}
+}
+
+func (r *shareLinkRepoImpl) GetByPublicID(ctx context.Context, id string) (entity.ShareLink, error) {
+	var l entity.ShareLink
+	result := conn(ctx, r.db).First(&l, "public_id = ?", id)
+	return l, translateError(result.Error)
+}
+
+func (r *shareLinkRepoImpl) GetActiveByPackID(ctx context.Context, packID uuid.UUID) ([]entity.ShareLink, error) {
+	var l []entity.ShareLink
+	result := conn(ctx, r.db).
+		Where("pack_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > now())", packID).
+		Order("created_at DESC, id").
+		Find(&l)
+	return l, translateError(result.Error)
+}
+
+func (r *shareLinkRepoImpl) Create(ctx context.Context, l entity.ShareLink) error {
+	result := conn(ctx, r.db).Create(&l)
+	return translateError(result.Error)
+}
+
+func (r *shareLinkRepoImpl) Update(ctx context.Context, l entity.ShareLink) error {
+	result := conn(ctx, r.db).Save(&l)
+	return translateError(result.Error)
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/pkg/adapter"
+
+	"gorm.io/gorm/clause"
+)
+
+type txKey struct{}
+
+type unitOfWorkImpl struct {
+	db adapter.Database
+}
+
+func NewUnitOfWork(db adapter.Database) storage.UnitOfWork {
+	return &unitOfWorkImpl{db}
+}
+
+// Do runs fn in a transaction that is committed when fn returns nil and
+// rolled back otherwise. Nested calls join the outer transaction.
+func (u *unitOfWorkImpl) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(adapter.Database); ok {
+		return fn(ctx)
+	}
+
+	return u.db.WithContext(ctx).Transaction(func(tx adapter.Database) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn returns the transaction started by a unit of work for ctx, or db
+// when the call is not part of one.
+func conn(ctx context.Context, db adapter.Database) adapter.Database {
+	if tx, ok := ctx.Value(txKey{}).(adapter.Database); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// connForUpdate is conn that also locks the selected rows until the unit of
+// work ends, so that concurrent read-modify-write operations are serialised.
+func connForUpdate(ctx context.Context, db adapter.Database) adapter.Database {
+	if tx, ok := ctx.Value(txKey{}).(adapter.Database); ok {
+		return tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+
+	"gorm.io/gorm/clause"
+)
+
+type userFollowRepoImpl struct {
+	db adapter.Database
+}
+
+func NewUserFollowRepository(db adapter.Database) storage.UserFollowRepository {
+	return &userFollowRepoImpl{db}
+}
+
+// Create follows the user, doing nothing when it is already followed.
+func (r *userFollowRepoImpl) Create(ctx context.Context, f entity.UserFollow) error {
+	result := conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&f)
+	return translateError(result.Error)
+}
+
+func (r *userFollowRepoImpl) Delete(ctx context.Context, f entity.UserFollow) error {
+	result := conn(ctx, r.db).Delete(&f)
+	return translateError(result.Error)
+}
@@ -1,9 +1,14 @@
 package repository
 
 import (
+	"context"
+
 	"locpack-backend/internal/storage"
 	"locpack-backend/internal/storage/entity"
 	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/enum/pack_visibility"
+
+	"gorm.io/gorm/clause"
 )
 
 type userRepoImpl struct {
@@ -14,24 +19,70 @@ func NewUserRepository(db adapter.Database) storage.UserRepository {
 	return &userRepoImpl{db}
 }
 
-func (r *userRepoImpl) GetByPublicID(id string) (entity.User, error) {
+func (r *userRepoImpl) GetByPublicID(ctx context.Context, id string) (entity.User, error) {
 	var u entity.User
-	result := r.db.First(&u, "lower(public_id) = lower(?)", id)
-	return u, result.Error
+	result := conn(ctx, r.db).First(&u, "lower(public_id) = lower(?)", id)
+	return u, translateError(result.Error)
 }
 
-func (r *userRepoImpl) GetByPublicIDFull(id string) (entity.User, error) {
+func (r *userRepoImpl) GetByPublicIDFull(ctx context.Context, id string) (entity.User, error) {
 	var u entity.User
-	result := r.db.
-		Preload("FollowedPacks").
+	result := conn(ctx, r.db).
+		Preload("FollowedPacks", func(db adapter.Database) adapter.Database {
+			return db.
+				Select("packs.*, "+completedAtColumn, id).
+				Where("packs.hidden_at IS NULL").
+				Scopes(visibleTo(id, pack_visibility.Public, pack_visibility.Unlisted))
+		}).
 		Preload("FollowedPacks.Author").
+		Preload("FollowedPacks.Cover").
+		Preload("FollowedPacks.PlaceEntries", func(db adapter.Database) adapter.Database {
+			return db.Order("pack_places.position, pack_places.place_id")
+		}).
+		Preload("FollowedPacks.PlaceEntries.Place", func(db adapter.Database) adapter.Database {
+			return db.Select("places.*, " + ratingColumns).Where("places.hidden_at IS NULL")
+		}).
+		Preload("FollowedPacks.PlaceEntries.Place.Visitors.User").
 		Preload("CreatedPacks").
 		Preload("CreatedPacks.Author").
+		Preload("CreatedPacks.Cover").
+		First(&u, "lower(public_id) = lower(?)", id)
+	return u, translateError(result.Error)
+}
+
+// GetByPublicIDWithStats returns the user with its follower and following
+// counts and the counts of its visible created packs, visited places, the
+// countries of those places and the places without a country.
+func (r *userRepoImpl) GetByPublicIDWithStats(ctx context.Context, id string) (entity.User, error) {
+	var u entity.User
+	result := conn(ctx, r.db).
+		Select(
+			"users.*, "+
+				"(SELECT count(*) FROM user_follows WHERE user_follows.followee_id = users.id) AS follower_count, "+
+				"(SELECT count(*) FROM user_follows WHERE user_follows.follower_id = users.id) AS following_count, "+
+				"(SELECT count(*) FROM packs WHERE packs.author_id = users.id "+
+				"AND packs.visibility = ? AND packs.hidden_at IS NULL AND packs.deleted_at IS NULL) AS created_pack_count, "+
+				"(SELECT count(*) FROM user_visited_places JOIN places ON places.id = user_visited_places.place_id "+
+				"WHERE user_visited_places.user_id = users.id "+
+				"AND places.hidden_at IS NULL AND places.deleted_at IS NULL) AS visited_place_count, "+
+				"(SELECT count(DISTINCT places.country_code) FROM user_visited_places JOIN places ON places.id = user_visited_places.place_id "+
+				"WHERE user_visited_places.user_id = users.id AND places.country_code <> '' "+
+				"AND places.hidden_at IS NULL AND places.deleted_at IS NULL) AS country_count, "+
+				"(SELECT count(*) FROM user_visited_places JOIN places ON places.id = user_visited_places.place_id "+
+				"WHERE user_visited_places.user_id = users.id AND places.country_code = '' "+
+				"AND places.hidden_at IS NULL AND places.deleted_at IS NULL) AS unknown_country_place_count",
+			pack_visibility.Public,
+		).
 		First(&u, "lower(public_id) = lower(?)", id)
-	return u, result.Error
+	return u, translateError(result.Error)
+}
+
+func (r *userRepoImpl) Create(ctx context.Context, u entity.User) error {
+	result := conn(ctx, r.db).Create(&u)
+	return translateError(result.Error)
 }
 
-func (r *userRepoImpl) Create(u entity.User) error {
-	result := r.db.Create(&u)
-	return result.Error
+func (r *userRepoImpl) Update(ctx context.Context, u entity.User) error {
+	result := conn(ctx, r.db).Omit(clause.Associations).Save(&u)
+	return translateError(result.Error)
 }
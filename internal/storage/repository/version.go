@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"locpack-backend/internal/storage"
+	"locpack-backend/pkg/adapter"
+
+	"github.com/google/uuid"
+)
+
+// bumpVersion increments the version of the record with the given ID if it
+// is still version. Otherwise the record was updated since it was read and
+// storage.ErrStale is returned.
+func bumpVersion(tx adapter.Database, model any, id uuid.UUID, version int64) error {
+	result := tx.Model(model).Where("id = ? AND version = ?", id, version).Update("version", version+1)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return storage.ErrStale
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"locpack-backend/internal/storage"
+	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/utils/cursor"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type visitRepoImpl struct {
+	db adapter.Database
+}
+
+func NewVisitRepository(db adapter.Database) storage.VisitRepository {
+	return &visitRepoImpl{db}
+}
+
+func (r *visitRepoImpl) GetByPublicID(ctx context.Context, id string) (entity.Visit, error) {
+	var v entity.Visit
+	result := conn(ctx, r.db).First(&v, "public_id = ?", id)
+	return v, translateError(result.Error)
+}
+
+// GetByUserIDFull returns the visits of the user to visible places with the
+// places, latest first. Only visits at or after from and before to are
+// returned when they are set.
+func (r *visitRepoImpl) GetByUserIDFull(ctx context.Context, userID uuid.UUID, from *time.Time, to *time.Time, limit int, after *cursor.Cursor) ([]entity.Visit, int64, error) {
+	var v []entity.Visit
+	var total int64
+
+	filter := conn(ctx, r.db).
+		Model(&entity.Visit{}).
+		Where("visits.user_id = ?", userID).
+		Where("visits.place_id IN (SELECT id FROM places WHERE hidden_at IS NULL AND deleted_at IS NULL)")
+	if from != nil {
+		filter = filter.Where("visits.visited_at >= ?", *from)
+	}
+	if to != nil {
+		filter = filter.Where("visits.visited_at < ?", *to)
+	}
+	filter = filter.Session(&gorm.Session{})
+
+	result := filter.Count(&total)
+	if result.Error != nil {
+		return v, 0, translateError(result.Error)
+	}
+
+	result = filter.
+		Preload("Place").
+		Order("visits.visited_at DESC, visits.public_id DESC").
+		Scopes(keysetAfter(after, "visits.visited_at", "visits.public_id")).
+		Limit(limit).
+		Find(&v)
+	return v, total, translateError(result.Error)
+}
+
+func (r *visitRepoImpl) Create(ctx context.Context, v entity.Visit) error {
+	result := conn(ctx, r.db).Omit(clause.Associations).Create(&v)
+	return translateError(result.Error)
+}
+
+func (r *visitRepoImpl) Delete(ctx context.Context, v entity.Visit) error {
+	result := conn(ctx, r.db).Delete(&v)
+	return translateError(result.Error)
+}
@@ -1,27 +1,134 @@
 package storage
 
 import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
 	"locpack-backend/internal/storage/entity"
+	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/utils/cursor"
+)
+
+var (
+	ErrNotFound  = errors.New("record not found")
+	ErrDuplicate = errors.New("duplicate record")
+	ErrStale     = errors.New("stale record")
+)
+
+// Search results wrap matched words of their headlines in these markers.
+// They are control characters, so that they cannot be confused with the
+// text that services escape before rendering highlights.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
 )
 
 type PlaceRepository interface {
-	GetByPublicID(placeID string) (entity.Place, error)
-	GetByPublicIDFull(placeID string) (entity.Place, error)
-	GetByNameOrAddress(query string) ([]entity.Place, error)
-	GetByNameOrAddressFull(query string) ([]entity.Place, error)
-	Create(p entity.Place) error
-	Update(p entity.Place) error
+	GetByPublicID(ctx context.Context, placeID string) (entity.Place, error)
+	GetByPublicIDFull(ctx context.Context, placeID string) (entity.Place, error)
+	GetByNameOrAddress(ctx context.Context, query string) ([]entity.Place, error)
+	GetByNameOrAddressFull(ctx context.Context, query string, sort types.PlaceSort, limit int, after *cursor.Cursor) ([]entity.Place, int64, error)
+	GetNearbyFull(ctx context.Context, lat float64, lng float64, radius float64, limit int) ([]entity.Place, error)
+	GetDuplicatesFull(ctx context.Context, name string, address string, lat *float64, lng *float64) ([]entity.Place, error)
+	GetDeletedByPublicID(ctx context.Context, placeID string) (entity.Place, error)
+	Create(ctx context.Context, p entity.Place) error
+	Update(ctx context.Context, p entity.Place) error
+	SetHidden(ctx context.Context, p entity.Place, hiddenAt *time.Time) error
+	Delete(ctx context.Context, p entity.Place) error
+	Restore(ctx context.Context, p entity.Place) error
+	Merge(ctx context.Context, from entity.Place, into entity.Place) error
+	PurgeDeleted(ctx context.Context, before time.Time) ([]string, error)
 }
 
 type PackRepository interface {
-	GetByPublicIDFull(id string) (entity.Pack, error)
-	GetByNameOrAuthorFull(query string) ([]entity.Pack, error)
-	Create(p entity.Pack) error
-	Update(p entity.Pack) error
+	GetByPublicID(ctx context.Context, id string) (entity.Pack, error)
+	GetByPublicIDFull(ctx context.Context, id string, userID string) (entity.Pack, error)
+	GetByIDFull(ctx context.Context, id uuid.UUID) (entity.Pack, error)
+	GetByNameOrAuthorFull(ctx context.Context, query string, userID string, limit int, after *cursor.Cursor) ([]entity.Pack, int64, error)
+	GetDeletedByPublicID(ctx context.Context, id string) (entity.Pack, error)
+	Create(ctx context.Context, p entity.Pack) error
+	Update(ctx context.Context, p entity.Pack) error
+	SetHidden(ctx context.Context, p entity.Pack, hiddenAt *time.Time) error
+	SetAuthor(ctx context.Context, p entity.Pack, authorID uuid.UUID) error
+	Delete(ctx context.Context, p entity.Pack) error
+	Restore(ctx context.Context, p entity.Pack) error
+	Follow(ctx context.Context, p entity.Pack, userID uuid.UUID) error
+	Unfollow(ctx context.Context, p entity.Pack, userID uuid.UUID) error
+	CompleteByPlaceID(ctx context.Context, userID uuid.UUID, placeID uuid.UUID, completedAt time.Time) ([]entity.Pack, error)
+	PurgeDeleted(ctx context.Context, before time.Time) ([]string, error)
 }
 
 type UserRepository interface {
-	GetByPublicID(id string) (entity.User, error)
-	GetByPublicIDFull(id string) (entity.User, error)
-	Create(u entity.User) error
+	GetByPublicID(ctx context.Context, id string) (entity.User, error)
+	GetByPublicIDFull(ctx context.Context, id string) (entity.User, error)
+	GetByPublicIDWithStats(ctx context.Context, id string) (entity.User, error)
+	Create(ctx context.Context, u entity.User) error
+	Update(ctx context.Context, u entity.User) error
+}
+
+type UserFollowRepository interface {
+	Create(ctx context.Context, f entity.UserFollow) error
+	Delete(ctx context.Context, f entity.UserFollow) error
+}
+
+type MediaRepository interface {
+	GetByPublicID(ctx context.Context, id string) (entity.Media, error)
+	Create(ctx context.Context, m entity.Media) error
+	Delete(ctx context.Context, m entity.Media) error
+}
+
+type ReviewRepository interface {
+	GetByPublicID(ctx context.Context, id string) (entity.Review, error)
+	GetByPlaceIDFull(ctx context.Context, placeID uuid.UUID, limit int, after *cursor.Cursor) ([]entity.Review, int64, error)
+	Create(ctx context.Context, r entity.Review) error
+	Update(ctx context.Context, r entity.Review) error
+	Delete(ctx context.Context, r entity.Review) error
+}
+
+type VisitRepository interface {
+	GetByPublicID(ctx context.Context, id string) (entity.Visit, error)
+	GetByUserIDFull(ctx context.Context, userID uuid.UUID, from *time.Time, to *time.Time, limit int, after *cursor.Cursor) ([]entity.Visit, int64, error)
+	Create(ctx context.Context, v entity.Visit) error
+	Delete(ctx context.Context, v entity.Visit) error
+}
+
+type ActivityRepository interface {
+	GetFeedFull(ctx context.Context, userID string, limit int, after *cursor.Cursor) ([]entity.Activity, int64, error)
+	Create(ctx context.Context, a entity.Activity) error
+}
+
+type PackCollaboratorRepository interface {
+	Create(ctx context.Context, c entity.PackCollaborator) error
+	Update(ctx context.Context, c entity.PackCollaborator) error
+	Delete(ctx context.Context, c entity.PackCollaborator) error
+}
+
+type ShareLinkRepository interface {
+	GetByPublicID(ctx context.Context, id string) (entity.ShareLink, error)
+	GetActiveByPackID(ctx context.Context, packID uuid.UUID) ([]entity.ShareLink, error)
+	Create(ctx context.Context, l entity.ShareLink) error
+	Update(ctx context.Context, l entity.ShareLink) error
+}
+
+type ModerationRepository interface {
+	GetAllFull(ctx context.Context, limit int, after *cursor.Cursor) ([]entity.ModerationAction, int64, error)
+	Create(ctx context.Context, a entity.ModerationAction) error
+}
+
+// UnitOfWork runs several repository calls atomically. Repositories take
+// part in the transaction when they are called with the context passed to fn.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+}
+
+// Purger permanently removes soft-deleted records, and returns the keys of
+// the files of the media removed with them.
+type Purger interface {
+	PurgeDeleted(ctx context.Context, before time.Time) ([]string, error)
 }
@@ -1,6 +1,9 @@
 package adapter
 
 import (
+	"context"
+	"errors"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -13,9 +16,24 @@ type API = *gin.Engine
 type APIHandler = gin.HandlerFunc
 type APIContext = *gin.Context
 
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrUserExists         = errors.New("user already exists")
+)
+
 type Auth interface {
-	Register(username string, email string, password string) (uuid.UUID, error)
-	Login(username string, password string) (types.AccessToken, error)
-	Refresh(value string) (types.AccessToken, error)
-	DecodeToken(accessToken string) (types.Token, error)
+	Register(ctx context.Context, username string, email string, password string) (uuid.UUID, error)
+	Login(ctx context.Context, username string, password string) (types.AccessToken, error)
+	Refresh(ctx context.Context, value string) (types.AccessToken, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+	DecodeToken(ctx context.Context, accessToken string) (types.Token, error)
+	Ping(ctx context.Context) error
+}
+
+// BlobStorage stores uploaded files under keys and makes them available at
+// public URLs.
+type BlobStorage interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
 }
@@ -1,6 +1,11 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"locpack-backend/pkg/adapter"
@@ -14,6 +19,46 @@ func New(cfg *cfg.API) adapter.API {
 	config.AllowAllOrigins = true
 	config.AllowCredentials = true
 	config.AddAllowHeaders("*")
+	config.AddExposeHeaders("ETag")
 	router.Use(cors.New(config))
 	return router
 }
+
+// Serve runs the API until ctx is done. Then it stops accepting connections
+// and waits for in-flight requests for at most the shutdown timeout.
+func Serve(ctx context.Context, cfg *cfg.API, handler http.Handler) error {
+	server := &http.Server{
+		Addr:              cfg.Address,
+		Handler:           handler,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	// Readiness already fails, give load balancers time to notice it before
+	// the listener is closed.
+	time.Sleep(cfg.ShutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	err := server.Shutdown(shutdownCtx)
+	if err != nil {
+		return err
+	}
+
+	err = <-errs
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"locpack-backend/pkg/cfg"
+)
+
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
+func TestServe_DrainsInFlightRequests(t *testing.T) {
+	config := &cfg.API{Address: freeAddress(t), ShutdownTimeout: 5 * time.Second}
+
+	started := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- Serve(ctx, config, handler)
+	}()
+
+	responses := make(chan *http.Response, 1)
+	go func() {
+		var resp *http.Response
+		var err error
+		for i := 0; i < 50; i++ {
+			resp, err = http.Get("http://" + config.Address)
+			if err == nil {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		require.NoError(t, err)
+		responses <- resp
+	}()
+
+	<-started
+	cancel()
+
+	resp := <-responses
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NoError(t, <-served)
+
+	_, err := http.Get("http://" + config.Address)
+	assert.Error(t, err)
+}
@@ -2,129 +2,44 @@ package auth
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/Nerzal/gocloak/v13"
-	"github.com/google/uuid"
+	"github.com/golang-jwt/jwt/v5"
 	"locpack-backend/pkg/adapter"
 	"locpack-backend/pkg/cfg"
-	"locpack-backend/pkg/types"
+	"locpack-backend/pkg/enum/role"
 )
 
-const userRole = "user"
-
-type authImpl struct {
-	cfg    *cfg.Auth
-	client *gocloak.GoCloak
-}
-
-func New(cfg *cfg.Auth) adapter.Auth {
-	client := gocloak.NewClient(cfg.URL)
-	return &authImpl{cfg, client}
-}
-
-func (a *authImpl) Register(username string, email string, password string) (uuid.UUID, error) {
-	ctx := context.Background()
-
-	token, err := a.client.LoginAdmin(ctx, a.cfg.AdminUsername, a.cfg.AdminPassword, a.cfg.Realm)
-	if err != nil {
-		return uuid.UUID{}, err
-	}
-
-	user := gocloak.User{
-		Username:      gocloak.StringP(username),
-		Email:         gocloak.StringP(email),
-		Enabled:       gocloak.BoolP(true),
-		EmailVerified: gocloak.BoolP(true),
-		Credentials: &[]gocloak.CredentialRepresentation{
-			{
-				Type:      gocloak.StringP("password"),
-				Value:     gocloak.StringP(password),
-				Temporary: gocloak.BoolP(false),
-			},
-		},
-	}
-
-	userID, err := a.client.CreateUser(ctx, token.AccessToken, a.cfg.Realm, user)
-	if err != nil {
-		return uuid.UUID{}, err
-	}
-
-	role, err := a.client.GetRealmRole(ctx, token.AccessToken, a.cfg.Realm, userRole)
-	if err != nil {
-		return uuid.UUID{}, err
-	}
-
-	err = a.client.AddRealmRoleToUser(ctx, token.AccessToken, a.cfg.Realm, userID, []gocloak.Role{*role})
-	if err != nil {
-		return uuid.UUID{}, err
-	}
-
-	userUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return uuid.UUID{}, err
-	}
-
-	return userUUID, err
-}
+const (
+	ProviderKeycloak = "keycloak"
+	ProviderLocal    = "local"
+)
 
-func (a *authImpl) Login(username string, password string) (types.AccessToken, error) {
-	ctx := context.Background()
+const userRole = role.User
 
-	token, err := a.client.Login(ctx, a.cfg.ClientID, a.cfg.ClientSecret, a.cfg.Realm, username, password)
-	if err != nil {
-		return types.AccessToken{}, err
+// New returns the configured auth provider. Background work of the provider,
+// such as refreshing signing keys, stops when ctx is done.
+func New(ctx context.Context, cfg *cfg.Auth, db adapter.Database) (adapter.Auth, error) {
+	switch cfg.Provider {
+	case ProviderKeycloak:
+		return newKeycloak(ctx, cfg), nil
+	case ProviderLocal:
+		return newLocal(cfg, db)
+	default:
+		return nil, fmt.Errorf("unknown auth provider %q", cfg.Provider)
 	}
-
-	resultToken := types.AccessToken{
-		Value:        token.AccessToken,
-		RefreshToken: token.RefreshToken,
-		ExpiresIn:    float64(token.ExpiresIn),
-	}
-
-	return resultToken, err
 }
 
-func (a *authImpl) Refresh(value string) (types.AccessToken, error) {
-	ctx := context.Background()
-
-	token, err := a.client.RefreshToken(ctx, value, a.cfg.ClientID, a.cfg.ClientSecret, a.cfg.Realm)
-	if err != nil {
-		return types.AccessToken{}, err
-	}
-
-	resultToken := types.AccessToken{
-		Value:        token.AccessToken,
-		RefreshToken: token.RefreshToken,
-		ExpiresIn:    float64(token.ExpiresIn),
-	}
-
-	return resultToken, err
+// tokenClaims are the Keycloak access token claims used by the backend.
+// The local provider issues tokens with the same shape.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	AuthorizedParty   string      `json:"azp,omitempty"`
+	PreferredUsername string      `json:"preferred_username"`
+	Email             string      `json:"email"`
+	RealmAccess       realmAccess `json:"realm_access"`
 }
 
-func (a *authImpl) DecodeToken(accessToken string) (types.Token, error) {
-	ctx := context.Background()
-
-	token, jwtClaims, err := a.client.DecodeAccessToken(ctx, accessToken, a.cfg.Realm)
-	if err != nil {
-		return types.Token{}, err
-	}
-
-	claims := *jwtClaims
-	realmAccess := claims["realm_access"].(map[string]interface{})
-
-	var roles []string
-	for _, role := range realmAccess["roles"].([]interface{}) {
-		role := role.(string)
-		roles = append(roles, role)
-	}
-
-	tokenInsight := types.Token{
-		Valid:     token.Valid,
-		Username:  claims["preferred_username"].(string),
-		Email:     claims["email"].(string),
-		ExpiresIn: claims["exp"].(float64),
-		Roles:     roles,
-	}
-
-	return tokenInsight, err
+type realmAccess struct {
+	Roles []string `json:"roles"`
 }
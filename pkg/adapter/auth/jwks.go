@@ -0,0 +1,232 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minForcedRefresh limits refreshes triggered by unknown key IDs, so that
+// tokens with made up kids cannot be used to flood the identity provider.
+const minForcedRefresh = 30 * time.Second
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwks caches the signing keys of the identity provider. Keys are kept when
+// a refresh fails, so tokens are still verified during provider outages.
+type jwks struct {
+	url      string
+	client   *http.Client
+	interval time.Duration
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	attemptedAt time.Time
+	refreshing  sync.Mutex
+}
+
+func newJWKS(url string, interval time.Duration) *jwks {
+	return &jwks{
+		url:      url,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		interval: interval,
+		keys:     map[string]crypto.PublicKey{},
+	}
+}
+
+// Run refreshes the keys every interval until ctx is done.
+func (k *jwks) Run(ctx context.Context) {
+	ticker := time.NewTicker(k.interval)
+	defer ticker.Stop()
+
+	for {
+		err := k.refresh(ctx, 0)
+		if err != nil {
+			log.Printf("jwks: refresh failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Key returns the key with the given ID. An unknown ID triggers a refresh
+// because the provider may have rotated its keys since the last one.
+func (k *jwks) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok := k.lookup(kid)
+	if ok {
+		return key, nil
+	}
+
+	err := k.refresh(ctx, minForcedRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok = k.lookup(kid)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, kid)
+	}
+	return key, nil
+}
+
+// Len returns the number of cached keys.
+func (k *jwks) Len() int {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return len(k.keys)
+}
+
+func (k *jwks) lookup(kid string) (crypto.PublicKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+// refresh fetches the keys unless the last attempt was less than minAge ago,
+// whether or not it succeeded, so that an unreachable provider is not asked
+// again by every request. The age is checked after taking the refresh lock,
+// so that requests that waited for a concurrent refresh reuse its result
+// instead of repeating it.
+func (k *jwks) refresh(ctx context.Context, minAge time.Duration) error {
+	k.refreshing.Lock()
+	defer k.refreshing.Unlock()
+
+	k.mu.Lock()
+	recent := time.Since(k.attemptedAt) < minAge
+	if !recent {
+		k.attemptedAt = time.Now()
+	}
+	k.mu.Unlock()
+	if recent {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("jwks endpoint responded with %s", resp.Status)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&set)
+	if err != nil {
+		return err
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		publicKey, err := key.publicKey()
+		if err != nil {
+			log.Printf("jwks: skipping key %q: %v", key.Kid, err)
+			continue
+		}
+		keys[key.Kid] = publicKey
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.mu.Unlock()
+
+	return nil
+}
+
+func (j jwk) publicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBigInt(j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(j.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := decodeBigInt(j.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(j.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// keyfunc resolves the verification key of a token by its kid header.
+func (k *jwks) keyfunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("token has no kid header")
+		}
+		return k.Key(ctx, kid)
+	}
+}
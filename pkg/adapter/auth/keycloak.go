@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/cfg"
+	"locpack-backend/pkg/types"
+)
+
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+type keycloakAuthImpl struct {
+	cfg    *cfg.Auth
+	client *gocloak.GoCloak
+	keys   *jwks
+}
+
+func newKeycloak(ctx context.Context, cfg *cfg.Auth) adapter.Auth {
+	client := gocloak.NewClient(cfg.URL)
+
+	certsURL := strings.TrimSuffix(cfg.URL, "/") + "/realms/" + cfg.Realm + "/protocol/openid-connect/certs"
+	keys := newJWKS(certsURL, cfg.JWKSRefreshInterval)
+	go keys.Run(ctx)
+
+	return &keycloakAuthImpl{cfg, client, keys}
+}
+
+func (a *keycloakAuthImpl) Register(ctx context.Context, username string, email string, password string) (uuid.UUID, error) {
+	token, err := a.client.LoginAdmin(ctx, a.cfg.AdminUsername, a.cfg.AdminPassword, a.cfg.Realm)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	user := gocloak.User{
+		Username:      gocloak.StringP(username),
+		Email:         gocloak.StringP(email),
+		Enabled:       gocloak.BoolP(true),
+		EmailVerified: gocloak.BoolP(true),
+		Credentials: &[]gocloak.CredentialRepresentation{
+			{
+				Type:      gocloak.StringP("password"),
+				Value:     gocloak.StringP(password),
+				Temporary: gocloak.BoolP(false),
+			},
+		},
+	}
+
+	userID, err := a.client.CreateUser(ctx, token.AccessToken, a.cfg.Realm, user)
+	if err != nil {
+		return uuid.UUID{}, translateError(err, http.StatusConflict, adapter.ErrUserExists)
+	}
+
+	err = a.addUserRole(ctx, token.AccessToken, userID)
+	if err != nil {
+		// A user without the role cannot use the API, so it is removed
+		// rather than left behind half registered.
+		deleteErr := a.client.DeleteUser(context.WithoutCancel(ctx), token.AccessToken, a.cfg.Realm, userID)
+		return uuid.UUID{}, errors.Join(err, deleteErr)
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return userUUID, err
+}
+
+func (a *keycloakAuthImpl) Login(ctx context.Context, username string, password string) (types.AccessToken, error) {
+	token, err := a.client.Login(ctx, a.cfg.ClientID, a.cfg.ClientSecret, a.cfg.Realm, username, password)
+	if err != nil {
+		return types.AccessToken{}, translateError(err, http.StatusUnauthorized, adapter.ErrInvalidCredentials)
+	}
+
+	resultToken := types.AccessToken{
+		Value:        token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresIn:    float64(token.ExpiresIn),
+	}
+
+	return resultToken, err
+}
+
+func (a *keycloakAuthImpl) Refresh(ctx context.Context, value string) (types.AccessToken, error) {
+	token, err := a.client.RefreshToken(ctx, value, a.cfg.ClientID, a.cfg.ClientSecret, a.cfg.Realm)
+	if err != nil {
+		return types.AccessToken{}, translateError(err, http.StatusBadRequest, adapter.ErrInvalidCredentials)
+	}
+
+	resultToken := types.AccessToken{
+		Value:        token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresIn:    float64(token.ExpiresIn),
+	}
+
+	return resultToken, err
+}
+
+// Delete removes the user from the realm. A user that does not exist is
+// considered deleted.
+func (a *keycloakAuthImpl) Delete(ctx context.Context, userID uuid.UUID) error {
+	token, err := a.client.LoginAdmin(ctx, a.cfg.AdminUsername, a.cfg.AdminPassword, a.cfg.Realm)
+	if err != nil {
+		return err
+	}
+
+	err = a.client.DeleteUser(ctx, token.AccessToken, a.cfg.Realm, userID.String())
+	var apiErr *gocloak.APIError
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// DecodeToken verifies the token offline against the cached realm keys,
+// so authenticated requests do not depend on Keycloak being reachable.
+func (a *keycloakAuthImpl) DecodeToken(ctx context.Context, accessToken string) (types.Token, error) {
+	claims := tokenClaims{}
+	token, err := jwt.ParseWithClaims(
+		accessToken,
+		&claims,
+		a.keys.keyfunc(ctx),
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(a.issuer()),
+		jwt.WithLeeway(a.cfg.ClockSkew),
+	)
+	if err != nil {
+		return types.Token{}, err
+	}
+	if claims.ExpiresAt == nil {
+		return types.Token{}, errors.New("token has no expiration")
+	}
+	if !a.audienceAllowed(claims) {
+		return types.Token{}, jwt.ErrTokenInvalidAudience
+	}
+
+	tokenInsight := types.Token{
+		Valid:     token.Valid,
+		Username:  claims.PreferredUsername,
+		Email:     claims.Email,
+		ExpiresIn: float64(claims.ExpiresAt.Unix()),
+		Roles:     claims.RealmAccess.Roles,
+	}
+
+	return tokenInsight, nil
+}
+
+// Ping reports whether tokens can be verified. Cached keys are enough, so
+// that a brief Keycloak outage does not make every instance unready.
+func (a *keycloakAuthImpl) Ping(ctx context.Context) error {
+	if a.keys.Len() > 0 {
+		return nil
+	}
+	return a.keys.refresh(ctx, 0)
+}
+
+func (a *keycloakAuthImpl) addUserRole(ctx context.Context, adminToken string, userID string) error {
+	role, err := a.client.GetRealmRole(ctx, adminToken, a.cfg.Realm, userRole)
+	if err != nil {
+		return err
+	}
+	return a.client.AddRealmRoleToUser(ctx, adminToken, a.cfg.Realm, userID, []gocloak.Role{*role})
+}
+
+func (a *keycloakAuthImpl) issuer() string {
+	if a.cfg.Issuer != "" {
+		return a.cfg.Issuer
+	}
+	return strings.TrimSuffix(a.cfg.URL, "/") + "/realms/" + a.cfg.Realm
+}
+
+// audienceAllowed accepts tokens that name the audience either in aud or,
+// as Keycloak does for the requesting client, in azp.
+func (a *keycloakAuthImpl) audienceAllowed(claims tokenClaims) bool {
+	audience := a.cfg.Audience
+	if audience == "" {
+		audience = a.cfg.ClientID
+	}
+
+	if claims.AuthorizedParty == audience {
+		return true
+	}
+	for _, aud := range claims.Audience {
+		if aud == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// translateError wraps a Keycloak error responded with the given status
+// code into target so that callers can tell it from provider failures.
+func translateError(err error, code int, target error) error {
+	var apiErr *gocloak.APIError
+	if errors.As(err, &apiErr) && apiErr.Code == code {
+		return fmt.Errorf("%w: %w", target, err)
+	}
+	return err
+}
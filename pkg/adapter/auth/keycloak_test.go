@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"locpack-backend/pkg/cfg"
+)
+
+type testProvider struct {
+	mu   sync.Mutex
+	keys map[string]*rsa.PrivateKey
+	down bool
+	hits int
+}
+
+func (p *testProvider) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.hits++
+	if p.down {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var keys []jwk
+	for kid, key := range p.keys {
+		keys = append(keys, jwk{
+			Kid: kid,
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	_ = json.NewEncoder(w).Encode(map[string][]jwk{"keys": keys})
+}
+
+func (p *testProvider) rotate(t *testing.T, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p.mu.Lock()
+	p.keys = map[string]*rsa.PrivateKey{kid: key}
+	p.mu.Unlock()
+	return key
+}
+
+func (p *testProvider) setDown(down bool) {
+	p.mu.Lock()
+	p.down = down
+	p.mu.Unlock()
+}
+
+func newTestKeycloak(t *testing.T) (*keycloakAuthImpl, *testProvider) {
+	provider := &testProvider{}
+	server := httptest.NewServer(provider)
+	t.Cleanup(server.Close)
+
+	config := &cfg.Auth{
+		URL:       "http://keycloak",
+		Realm:     "master",
+		ClientID:  "locpack-backend",
+		ClockSkew: 30 * time.Second,
+	}
+	return &keycloakAuthImpl{cfg: config, keys: newJWKS(server.URL, time.Hour)}, provider
+}
+
+func signTestToken(t *testing.T, key *rsa.PrivateKey, kid string, mutate func(*tokenClaims)) string {
+	now := time.Now()
+	claims := tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "http://keycloak/realms/master",
+			Audience:  jwt.ClaimStrings{"account"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+		},
+		AuthorizedParty:   "locpack-backend",
+		PreferredUsername: "john",
+		Email:             "john@example.com",
+		RealmAccess:       realmAccess{Roles: []string{"user"}},
+	}
+	if mutate != nil {
+		mutate(&claims)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestKeycloakAuth_DecodeToken(t *testing.T) {
+	a, provider := newTestKeycloak(t)
+	key := provider.rotate(t, "k1")
+
+	token, err := a.DecodeToken(context.Background(), signTestToken(t, key, "k1", nil))
+	require.NoError(t, err)
+	assert.True(t, token.Valid)
+	assert.Equal(t, "john", token.Username)
+	assert.Equal(t, "john@example.com", token.Email)
+	assert.Equal(t, []string{"user"}, token.Roles)
+
+	_, err = a.DecodeToken(context.Background(), signTestToken(t, key, "k1", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 1, provider.hits)
+}
+
+func TestKeycloakAuth_DecodeToken_KeyRotation(t *testing.T) {
+	a, provider := newTestKeycloak(t)
+	oldKey := provider.rotate(t, "k1")
+	_, err := a.DecodeToken(context.Background(), signTestToken(t, oldKey, "k1", nil))
+	require.NoError(t, err)
+
+	a.keys.attemptedAt = time.Time{}
+	newKey := provider.rotate(t, "k2")
+
+	_, err = a.DecodeToken(context.Background(), signTestToken(t, newKey, "k2", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 2, provider.hits)
+}
+
+func TestKeycloakAuth_DecodeToken_UnknownKeyRateLimited(t *testing.T) {
+	a, provider := newTestKeycloak(t)
+	key := provider.rotate(t, "k1")
+	_, err := a.DecodeToken(context.Background(), signTestToken(t, key, "k1", nil))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = a.DecodeToken(context.Background(), signTestToken(t, key, "unknown", nil))
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	}
+	assert.Equal(t, 1, provider.hits)
+}
+
+func TestKeycloakAuth_DecodeToken_UnknownKeyDuringOutage(t *testing.T) {
+	a, provider := newTestKeycloak(t)
+	key := provider.rotate(t, "k1")
+	_, err := a.DecodeToken(context.Background(), signTestToken(t, key, "k1", nil))
+	require.NoError(t, err)
+
+	provider.setDown(true)
+	a.keys.attemptedAt = time.Time{}
+	for i := 0; i < 3; i++ {
+		_, err = a.DecodeToken(context.Background(), signTestToken(t, key, "unknown", nil))
+		assert.Error(t, err)
+	}
+
+	// Failed attempts are throttled like successful ones.
+	assert.Equal(t, 2, provider.hits)
+}
+
+func TestKeycloakAuth_DecodeToken_ConcurrentRotation(t *testing.T) {
+	a, provider := newTestKeycloak(t)
+	oldKey := provider.rotate(t, "k1")
+	_, err := a.DecodeToken(context.Background(), signTestToken(t, oldKey, "k1", nil))
+	require.NoError(t, err)
+
+	a.keys.attemptedAt = time.Time{}
+	token := signTestToken(t, provider.rotate(t, "k2"), "k2", nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := a.DecodeToken(context.Background(), token)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, provider.hits)
+}
+
+func TestJWKS_RunStopsWithContext(t *testing.T) {
+	a, provider := newTestKeycloak(t)
+	provider.rotate(t, "k1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		a.keys.Run(ctx)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool { return a.keys.Len() == 1 }, time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after the context was cancelled")
+	}
+}
+
+func TestKeycloakAuth_DecodeToken_ProviderOutage(t *testing.T) {
+	a, provider := newTestKeycloak(t)
+	key := provider.rotate(t, "k1")
+	_, err := a.DecodeToken(context.Background(), signTestToken(t, key, "k1", nil))
+	require.NoError(t, err)
+
+	provider.setDown(true)
+	a.keys.attemptedAt = time.Time{}
+	assert.Error(t, a.keys.refresh(context.Background(), 0))
+
+	_, err = a.DecodeToken(context.Background(), signTestToken(t, key, "k1", nil))
+	assert.NoError(t, err)
+}
+
+func TestKeycloakAuth_DecodeToken_Claims(t *testing.T) {
+	a, provider := newTestKeycloak(t)
+	key := provider.rotate(t, "k1")
+
+	tests := []struct {
+		name   string
+		mutate func(*tokenClaims)
+		err    error
+	}{
+		{
+			name:   "wrong issuer",
+			mutate: func(c *tokenClaims) { c.Issuer = "http://evil/realms/master" },
+			err:    jwt.ErrTokenInvalidIssuer,
+		},
+		{
+			name:   "wrong audience",
+			mutate: func(c *tokenClaims) { c.AuthorizedParty = "other-client" },
+			err:    jwt.ErrTokenInvalidAudience,
+		},
+		{
+			name:   "audience in aud",
+			mutate: func(c *tokenClaims) { c.AuthorizedParty = ""; c.Audience = jwt.ClaimStrings{"locpack-backend"} },
+		},
+		{
+			name:   "expired within leeway",
+			mutate: func(c *tokenClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-10 * time.Second)) },
+		},
+		{
+			name:   "expired beyond leeway",
+			mutate: func(c *tokenClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute)) },
+			err:    jwt.ErrTokenExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := a.DecodeToken(context.Background(), signTestToken(t, key, "k1", tt.mutate))
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
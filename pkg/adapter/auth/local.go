@@ -0,0 +1,278 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/cfg"
+	"locpack-backend/pkg/types"
+)
+
+const (
+	localIssuer       = "locpack-backend"
+	minJWTSecretBytes = 32
+)
+
+// dummyHash is compared against when a user does not exist, so that login
+// takes the same time for unknown usernames and wrong passwords.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("locpack-backend"), bcrypt.DefaultCost)
+
+type credential struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Username     string
+	Email        string
+	PasswordHash string
+	Roles        string
+}
+
+func (credential) TableName() string {
+	return "auth_credentials"
+}
+
+type refreshToken struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CreatedAt    time.Time
+	CredentialID uuid.UUID
+	FamilyID     uuid.UUID
+	TokenHash    string
+	ExpiresAt    time.Time
+	RevokedAt    *time.Time
+}
+
+func (refreshToken) TableName() string {
+	return "auth_refresh_tokens"
+}
+
+type localAuthImpl struct {
+	cfg *cfg.Auth
+	db  adapter.Database
+}
+
+func newLocal(cfg *cfg.Auth, db adapter.Database) (adapter.Auth, error) {
+	if len(cfg.JWTSecret) < minJWTSecretBytes {
+		return nil, errors.New("local auth provider requires a JWT secret of at least 32 bytes")
+	}
+	return &localAuthImpl{cfg, db}, nil
+}
+
+func (a *localAuthImpl) Register(ctx context.Context, username string, email string, password string) (uuid.UUID, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	c := credential{
+		ID:           uuid.New(),
+		Username:     strings.ToLower(username),
+		Email:        email,
+		PasswordHash: string(hash),
+		Roles:        userRole,
+	}
+
+	err = a.db.WithContext(ctx).Create(&c).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return uuid.UUID{}, adapter.ErrUserExists
+	}
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return c.ID, nil
+}
+
+func (a *localAuthImpl) Login(ctx context.Context, username string, password string) (types.AccessToken, error) {
+	var c credential
+	result := a.db.WithContext(ctx).Limit(1).Find(&c, "username = ?", strings.ToLower(username))
+	if result.Error != nil {
+		return types.AccessToken{}, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return types.AccessToken{}, adapter.ErrInvalidCredentials
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(c.PasswordHash), []byte(password))
+	if err != nil {
+		return types.AccessToken{}, adapter.ErrInvalidCredentials
+	}
+
+	var token types.AccessToken
+	err = a.db.WithContext(ctx).Transaction(func(tx adapter.Database) error {
+		token, err = a.issue(tx, c, uuid.New())
+		return err
+	})
+	return token, err
+}
+
+// Refresh rotates a refresh token. Every token can be used once; presenting
+// an already rotated token revokes its whole family, because it means that
+// the token has leaked.
+func (a *localAuthImpl) Refresh(ctx context.Context, value string) (types.AccessToken, error) {
+	var token types.AccessToken
+	reused := false
+
+	err := a.db.WithContext(ctx).Transaction(func(tx adapter.Database) error {
+		var rt refreshToken
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Limit(1).
+			Find(&rt, "token_hash = ?", hashRefreshToken(value))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 || rt.ExpiresAt.Before(time.Now()) {
+			return adapter.ErrInvalidCredentials
+		}
+
+		if rt.RevokedAt != nil {
+			reused = true
+			return tx.Model(&refreshToken{}).
+				Where("family_id = ? AND revoked_at IS NULL", rt.FamilyID).
+				Update("revoked_at", time.Now()).Error
+		}
+
+		err := tx.Model(&rt).Update("revoked_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+
+		var c credential
+		err = tx.First(&c, "id = ?", rt.CredentialID).Error
+		if err != nil {
+			return err
+		}
+
+		token, err = a.issue(tx, c, rt.FamilyID)
+		return err
+	})
+	if err != nil {
+		return types.AccessToken{}, err
+	}
+	if reused {
+		return types.AccessToken{}, adapter.ErrInvalidCredentials
+	}
+
+	return token, nil
+}
+
+// Delete removes the credential together with its refresh tokens.
+func (a *localAuthImpl) Delete(ctx context.Context, userID uuid.UUID) error {
+	return a.db.WithContext(ctx).Delete(&credential{}, "id = ?", userID).Error
+}
+
+func (a *localAuthImpl) DecodeToken(ctx context.Context, accessToken string) (types.Token, error) {
+	claims := tokenClaims{}
+	token, err := jwt.ParseWithClaims(
+		accessToken,
+		&claims,
+		func(*jwt.Token) (interface{}, error) { return []byte(a.cfg.JWTSecret), nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(localIssuer),
+	)
+	if err != nil {
+		return types.Token{}, err
+	}
+	if claims.ExpiresAt == nil {
+		return types.Token{}, errors.New("token has no expiration")
+	}
+
+	tokenInsight := types.Token{
+		Valid:     token.Valid,
+		Username:  claims.PreferredUsername,
+		Email:     claims.Email,
+		ExpiresIn: float64(claims.ExpiresAt.Unix()),
+		Roles:     claims.RealmAccess.Roles,
+	}
+
+	return tokenInsight, nil
+}
+
+// Ping always succeeds: credentials live in the application database, which
+// is checked on its own.
+func (a *localAuthImpl) Ping(ctx context.Context) error {
+	return nil
+}
+
+// issue signs an access token and stores a new refresh token of the family.
+func (a *localAuthImpl) issue(tx adapter.Database, c credential, familyID uuid.UUID) (types.AccessToken, error) {
+	now := time.Now()
+
+	accessToken, err := a.sign(c, now)
+	if err != nil {
+		return types.AccessToken{}, err
+	}
+
+	value, err := newRefreshToken()
+	if err != nil {
+		return types.AccessToken{}, err
+	}
+
+	err = tx.Where("credential_id = ? AND expires_at < ?", c.ID, now).Delete(&refreshToken{}).Error
+	if err != nil {
+		return types.AccessToken{}, err
+	}
+
+	err = tx.Create(&refreshToken{
+		ID:           uuid.New(),
+		CredentialID: c.ID,
+		FamilyID:     familyID,
+		TokenHash:    hashRefreshToken(value),
+		ExpiresAt:    now.Add(a.cfg.RefreshTokenTTL),
+	}).Error
+	if err != nil {
+		return types.AccessToken{}, err
+	}
+
+	resultToken := types.AccessToken{
+		Value:        accessToken,
+		RefreshToken: value,
+		ExpiresIn:    a.cfg.AccessTokenTTL.Seconds(),
+	}
+
+	return resultToken, nil
+}
+
+func (a *localAuthImpl) sign(c credential, now time.Time) (string, error) {
+	claims := tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    localIssuer,
+			Subject:   c.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(a.cfg.AccessTokenTTL)),
+		},
+		PreferredUsername: c.Username,
+		Email:             c.Email,
+		RealmAccess:       realmAccess{Roles: strings.Split(c.Roles, ",")},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(a.cfg.JWTSecret))
+}
+
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashRefreshToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"locpack-backend/pkg/cfg"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func newTestLocal(t *testing.T, secret string, ttl time.Duration) *localAuthImpl {
+	a, err := newLocal(&cfg.Auth{JWTSecret: secret, AccessTokenTTL: ttl}, nil)
+	require.NoError(t, err)
+	return a.(*localAuthImpl)
+}
+
+func TestLocalAuth_ShortSecret(t *testing.T) {
+	_, err := newLocal(&cfg.Auth{JWTSecret: "short"}, nil)
+	assert.Error(t, err)
+}
+
+func TestLocalAuth_DecodeToken(t *testing.T) {
+	a := newTestLocal(t, testSecret, time.Minute)
+	c := credential{ID: uuid.New(), Username: "john", Email: "john@example.com", Roles: "user,admin"}
+
+	signed, err := a.sign(c, time.Now())
+	require.NoError(t, err)
+
+	token, err := a.DecodeToken(context.Background(), signed)
+	require.NoError(t, err)
+	assert.True(t, token.Valid)
+	assert.Equal(t, "john", token.Username)
+	assert.Equal(t, "john@example.com", token.Email)
+	assert.Equal(t, []string{"user", "admin"}, token.Roles)
+}
+
+func TestLocalAuth_DecodeToken_Expired(t *testing.T) {
+	a := newTestLocal(t, testSecret, time.Minute)
+
+	signed, err := a.sign(credential{ID: uuid.New(), Roles: userRole}, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	_, err = a.DecodeToken(context.Background(), signed)
+	assert.ErrorIs(t, err, jwt.ErrTokenExpired)
+}
+
+func TestLocalAuth_DecodeToken_WrongSecret(t *testing.T) {
+	a := newTestLocal(t, testSecret, time.Minute)
+	other := newTestLocal(t, testSecret+"-other", time.Minute)
+
+	signed, err := other.sign(credential{ID: uuid.New(), Roles: userRole}, time.Now())
+	require.NoError(t, err)
+
+	_, err = a.DecodeToken(context.Background(), signed)
+	assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+}
+
+func TestLocalAuth_DecodeToken_WrongAlgorithm(t *testing.T) {
+	a := newTestLocal(t, testSecret, time.Minute)
+
+	claims := jwt.RegisteredClaims{
+		Issuer:    localIssuer,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS512, claims).SignedString([]byte(testSecret))
+	require.NoError(t, err)
+
+	_, err = a.DecodeToken(context.Background(), signed)
+	assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+}
+
+func TestLocalAuth_HashRefreshToken(t *testing.T) {
+	value, err := newRefreshToken()
+	require.NoError(t, err)
+
+	assert.Len(t, value, 43)
+	assert.Equal(t, hashRefreshToken(value), hashRefreshToken(value))
+	assert.NotEqual(t, value, hashRefreshToken(value))
+}
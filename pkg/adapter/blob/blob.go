@@ -0,0 +1,29 @@
+package blob
+
+import (
+	"fmt"
+	"strings"
+
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/cfg"
+)
+
+const (
+	ProviderLocal = "local"
+	ProviderS3    = "s3"
+)
+
+func New(cfg *cfg.Blob) (adapter.BlobStorage, error) {
+	switch cfg.Provider {
+	case ProviderLocal:
+		return newLocal(cfg)
+	case ProviderS3:
+		return newS3(cfg)
+	default:
+		return nil, fmt.Errorf("unknown blob provider %q", cfg.Provider)
+	}
+}
+
+func publicURL(base string, key string) string {
+	return strings.TrimRight(base, "/") + "/" + key
+}
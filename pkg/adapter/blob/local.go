@@ -0,0 +1,91 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/cfg"
+)
+
+type localBlobImpl struct {
+	cfg *cfg.Blob
+}
+
+func newLocal(cfg *cfg.Blob) (adapter.BlobStorage, error) {
+	if len(cfg.Dir) == 0 {
+		return nil, errors.New("local blob provider requires a directory")
+	}
+	return &localBlobImpl{cfg}, nil
+}
+
+// Put writes the file atomically, so that it is never served half-written.
+func (b *localBlobImpl) Put(_ context.Context, key string, _ string, data []byte) error {
+	path, err := b.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tmp.Name(), 0o644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (b *localBlobImpl) Delete(_ context.Context, key string) error {
+	path, err := b.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// URL returns the file under the public URL, or under the path it is served
+// at by the API when no public URL is configured.
+func (b *localBlobImpl) URL(key string) string {
+	if len(b.cfg.PublicURL) != 0 {
+		return publicURL(b.cfg.PublicURL, key)
+	}
+	return publicURL(b.cfg.ServePath, key)
+}
+
+// path maps a key to a file in the directory, rejecting keys that would
+// escape it.
+func (b *localBlobImpl) path(key string) (string, error) {
+	if !fs.ValidPath(key) || strings.HasPrefix(filepath.Base(key), ".") {
+		return "", errors.New("invalid blob key")
+	}
+	return filepath.Join(b.cfg.Dir, filepath.FromSlash(key)), nil
+}
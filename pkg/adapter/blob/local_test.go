@@ -0,0 +1,54 @@
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"locpack-backend/pkg/cfg"
+)
+
+func newTestLocal(t *testing.T, publicURL string) (*localBlobImpl, string) {
+	dir := t.TempDir()
+	b, err := newLocal(&cfg.Blob{Dir: dir, ServePath: "/media", PublicURL: publicURL})
+	require.NoError(t, err)
+	return b.(*localBlobImpl), dir
+}
+
+func TestLocalBlob_PutAndDelete(t *testing.T) {
+	b, dir := newTestLocal(t, "")
+
+	err := b.Put(context.Background(), "places/p1/m1.jpg", "image/jpeg", []byte("data"))
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "places", "p1", "m1.jpg"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	err = b.Delete(context.Background(), "places/p1/m1.jpg")
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "places", "p1", "m1.jpg"))
+	assert.True(t, os.IsNotExist(err))
+
+	err = b.Delete(context.Background(), "places/p1/m1.jpg")
+	assert.NoError(t, err)
+}
+
+func TestLocalBlob_InvalidKey(t *testing.T) {
+	b, _ := newTestLocal(t, "")
+
+	for _, key := range []string{"../escape.jpg", "/absolute.jpg", "places/.hidden", ""} {
+		assert.Error(t, b.Put(context.Background(), key, "image/jpeg", []byte("data")), key)
+	}
+}
+
+func TestLocalBlob_URL(t *testing.T) {
+	b, _ := newTestLocal(t, "")
+	assert.Equal(t, "/media/places/p1/m1.jpg", b.URL("places/p1/m1.jpg"))
+
+	b, _ = newTestLocal(t, "https://cdn.example.com/media/")
+	assert.Equal(t, "https://cdn.example.com/media/places/p1/m1.jpg", b.URL("places/p1/m1.jpg"))
+}
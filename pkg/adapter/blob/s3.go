@@ -0,0 +1,162 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"locpack-backend/pkg/adapter"
+	"locpack-backend/pkg/cfg"
+)
+
+const (
+	s3Timeout     = 30 * time.Second
+	s3Algorithm   = "AWS4-HMAC-SHA256"
+	s3Service     = "s3"
+	s3DateFormat  = "20060102"
+	s3TimeFormat  = "20060102T150405Z"
+	s3SignedNames = "host;x-amz-content-sha256;x-amz-date"
+)
+
+// s3BlobImpl talks to any S3-compatible service with path-style requests
+// signed with AWS Signature Version 4.
+type s3BlobImpl struct {
+	cfg      *cfg.Blob
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+func newS3(cfg *cfg.Blob) (adapter.BlobStorage, error) {
+	if len(cfg.Endpoint) == 0 || len(cfg.Bucket) == 0 || len(cfg.AccessKey) == 0 || len(cfg.SecretKey) == 0 {
+		return nil, errors.New("s3 blob provider requires an endpoint, a bucket and credentials")
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || len(endpoint.Host) == 0 {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+
+	return &s3BlobImpl{cfg, endpoint, &http.Client{Timeout: s3Timeout}, time.Now}, nil
+}
+
+func (b *s3BlobImpl) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	return b.do(ctx, http.MethodPut, key, contentType, data)
+}
+
+func (b *s3BlobImpl) Delete(ctx context.Context, key string) error {
+	return b.do(ctx, http.MethodDelete, key, "", nil)
+}
+
+// URL returns the object under the public URL, or directly from the bucket
+// when no public URL is configured.
+func (b *s3BlobImpl) URL(key string) string {
+	if len(b.cfg.PublicURL) != 0 {
+		return publicURL(b.cfg.PublicURL, key)
+	}
+	return publicURL(b.endpoint.String()+"/"+b.cfg.Bucket, key)
+}
+
+func (b *s3BlobImpl) do(ctx context.Context, method string, key string, contentType string, data []byte) error {
+	path := b.endpoint.EscapedPath() + "/" + uriEncode(b.cfg.Bucket) + "/" + uriEncode(key)
+
+	request, err := http.NewRequestWithContext(ctx, method, b.endpoint.Scheme+"://"+b.endpoint.Host+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if len(contentType) != 0 {
+		request.Header.Set("Content-Type", contentType)
+	}
+	b.sign(request, path, data)
+
+	response, err := b.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode == http.StatusNotFound && method == http.MethodDelete {
+		return nil
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("s3 %s %s: %s", method, key, response.Status)
+	}
+
+	return nil
+}
+
+func (b *s3BlobImpl) sign(request *http.Request, path string, data []byte) {
+	now := b.now().UTC()
+	date := now.Format(s3DateFormat)
+	payloadHash := sha256Hex(data)
+
+	request.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		path,
+		"",
+		"host:" + request.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + now.Format(s3TimeFormat),
+		"",
+		s3SignedNames,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + b.cfg.Region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		now.Format(s3TimeFormat),
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+b.cfg.SecretKey), date)
+	key = hmacSHA256(key, b.cfg.Region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, b.cfg.AccessKey, scope, s3SignedNames, signature,
+	))
+}
+
+// uriEncode escapes everything but unreserved characters and slashes, as
+// required for canonical S3 paths.
+func uriEncode(s string) string {
+	var builder strings.Builder
+	for _, c := range []byte(s) {
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			builder.WriteByte(c)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", c)
+		}
+	}
+	return builder.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
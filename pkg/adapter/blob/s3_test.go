@@ -0,0 +1,101 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"locpack-backend/pkg/cfg"
+)
+
+type s3Request struct {
+	method        string
+	path          string
+	contentType   string
+	body          string
+	authorization string
+	date          string
+}
+
+func newTestS3(t *testing.T, status int) (*s3BlobImpl, *[]s3Request) {
+	var requests []s3Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, s3Request{
+			method:        r.Method,
+			path:          r.URL.EscapedPath(),
+			contentType:   r.Header.Get("Content-Type"),
+			body:          string(body),
+			authorization: r.Header.Get("Authorization"),
+			date:          r.Header.Get("X-Amz-Date"),
+		})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := newS3(&cfg.Blob{
+		Endpoint:  server.URL,
+		Region:    "eu-central-1",
+		Bucket:    "media",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	require.NoError(t, err)
+
+	s3 := b.(*s3BlobImpl)
+	s3.now = func() time.Time { return time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC) }
+	return s3, &requests
+}
+
+func TestS3Blob_MissingConfig(t *testing.T) {
+	_, err := newS3(&cfg.Blob{Endpoint: "http://localhost:9000"})
+	assert.Error(t, err)
+}
+
+func TestS3Blob_Put(t *testing.T) {
+	b, requests := newTestS3(t, http.StatusOK)
+
+	err := b.Put(context.Background(), "places/p1/m 1.jpg", "image/jpeg", []byte("data"))
+	require.NoError(t, err)
+
+	require.Len(t, *requests, 1)
+	r := (*requests)[0]
+	assert.Equal(t, http.MethodPut, r.method)
+	assert.Equal(t, "/media/places/p1/m%201.jpg", r.path)
+	assert.Equal(t, "image/jpeg", r.contentType)
+	assert.Equal(t, "data", r.body)
+	assert.Equal(t, "20240501T120000Z", r.date)
+	assert.Regexp(t, regexp.MustCompile(
+		`^AWS4-HMAC-SHA256 Credential=access/20240501/eu-central-1/s3/aws4_request, `+
+			`SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=[0-9a-f]{64}$`,
+	), r.authorization)
+}
+
+func TestS3Blob_Put_Error(t *testing.T) {
+	b, _ := newTestS3(t, http.StatusForbidden)
+
+	err := b.Put(context.Background(), "places/p1/m1.jpg", "image/jpeg", []byte("data"))
+	assert.Error(t, err)
+}
+
+func TestS3Blob_Delete_Missing(t *testing.T) {
+	b, requests := newTestS3(t, http.StatusNotFound)
+
+	err := b.Delete(context.Background(), "places/p1/m1.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodDelete, (*requests)[0].method)
+}
+
+func TestS3Blob_URL(t *testing.T) {
+	b, _ := newTestS3(t, http.StatusOK)
+	assert.Equal(t, b.endpoint.String()+"/media/places/p1/m1.jpg", b.URL("places/p1/m1.jpg"))
+
+	b.cfg.PublicURL = "https://cdn.example.com"
+	assert.Equal(t, "https://cdn.example.com/places/p1/m1.jpg", b.URL("places/p1/m1.jpg"))
+}
@@ -10,16 +10,12 @@ import (
 )
 
 func New(cfg *cfg.Database) (adapter.Database, error) {
-	db, err := gorm.Open(postgres.Open(cfg.DSN))
+	db, err := gorm.Open(postgres.Open(cfg.DSN), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
 
-	err = db.AutoMigrate(
-		&entity.User{},
-		&entity.Place{},
-		&entity.Pack{},
-	)
+	err = db.SetupJoinTable(&entity.Pack{}, "Places", &entity.PackPlace{})
 	if err != nil {
 		return nil, err
 	}
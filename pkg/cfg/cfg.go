@@ -1,19 +1,53 @@
 package cfg
 
+import "time"
+
 type Database struct {
-	DSN string `env:"DSN" env-default:"host=localhost user=postgres password=postgres dbname=postgres port=5432"`
+	DSN              string        `env:"DSN" env-default:"host=localhost user=postgres password=postgres dbname=postgres port=5432"`
+	DeletedRetention time.Duration `env:"DELETED_RETENTION" env-default:"720h"`
+	PurgeInterval    time.Duration `env:"PURGE_INTERVAL" env-default:"1h"`
+	AutoMigrate      bool          `env:"AUTO_MIGRATE" env-default:"false"`
 }
 
 type API struct {
-	Address string `env:"ADDRESS" env-default:"localhost:8080"`
-	Mode    string `env:"MODE" env-default:"debug"`
+	Address           string        `env:"ADDRESS" env-default:"localhost:8080"`
+	Mode              string        `env:"MODE" env-default:"debug"`
+	ReadHeaderTimeout time.Duration `env:"READ_HEADER_TIMEOUT" env-default:"10s"`
+	RequestTimeout    time.Duration `env:"REQUEST_TIMEOUT" env-default:"30s"`
+	ShutdownDelay     time.Duration `env:"SHUTDOWN_DELAY" env-default:"0s"`
+	ShutdownTimeout   time.Duration `env:"SHUTDOWN_TIMEOUT" env-default:"30s"`
+	ShareLinkSecret   string        `env:"SHARE_LINK_SECRET"`
 }
 
 type Auth struct {
+	Provider      string `env:"PROVIDER" env-default:"keycloak"`
 	URL           string `env:"URL" env-default:"http://localhost:8081"`
 	Realm         string `env:"REALM" env-default:"master"`
 	AdminUsername string `env:"ADMIN_USERNAME" env-default:"admin"`
 	AdminPassword string `env:"ADMIN_PASSWORD" env-default:"admin"`
 	ClientID      string `env:"CLIENT_ID" env-default:"locpack-backend"`
 	ClientSecret  string `env:"CLIENT_SECRET" env-default:"puKuYwCPLb7jUmbzrKMJCCCZcMLfU4Oy"`
+
+	Issuer              string        `env:"ISSUER"`
+	Audience            string        `env:"AUDIENCE"`
+	JWKSRefreshInterval time.Duration `env:"JWKS_REFRESH_INTERVAL" env-default:"15m"`
+	ClockSkew           time.Duration `env:"CLOCK_SKEW" env-default:"30s"`
+
+	JWTSecret       string        `env:"JWT_SECRET"`
+	AccessTokenTTL  time.Duration `env:"ACCESS_TOKEN_TTL" env-default:"15m"`
+	RefreshTokenTTL time.Duration `env:"REFRESH_TOKEN_TTL" env-default:"720h"`
+}
+
+type Blob struct {
+	Provider  string `env:"PROVIDER" env-default:"local"`
+	PublicURL string `env:"PUBLIC_URL"`
+
+	Dir       string `env:"DIR" env-default:"./media"`
+	ServePath string `env:"SERVE_PATH" env-default:"/media"`
+
+	Endpoint  string `env:"ENDPOINT"`
+	Region    string `env:"REGION" env-default:"us-east-1"`
+	Bucket    string `env:"BUCKET"`
+	AccessKey string `env:"ACCESS_KEY"`
+	SecretKey string `env:"SECRET_KEY"`
 }
@@ -0,0 +1,10 @@
+package activity_type
+
+import "locpack-backend/pkg/types"
+
+const (
+	PackCreated   types.ActivityType = "PACK_CREATED"
+	PlaceAdded    types.ActivityType = "PLACE_ADDED"
+	PlaceVisited  types.ActivityType = "PLACE_VISITED"
+	PackCompleted types.ActivityType = "PACK_COMPLETED"
+)
@@ -0,0 +1,8 @@
+package collaborator_role
+
+import "locpack-backend/pkg/types"
+
+const (
+	Editor types.CollaboratorRole = "EDITOR"
+	Viewer types.CollaboratorRole = "VIEWER"
+)
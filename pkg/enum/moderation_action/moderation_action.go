@@ -0,0 +1,17 @@
+package moderation_action
+
+import "locpack-backend/pkg/types"
+
+const (
+	HidePlace    types.ModerationAction = "HIDE_PLACE"
+	UnhidePlace  types.ModerationAction = "UNHIDE_PLACE"
+	DeletePlace  types.ModerationAction = "DELETE_PLACE"
+	MergePlace   types.ModerationAction = "MERGE_PLACE"
+	DeleteReview types.ModerationAction = "DELETE_REVIEW"
+	HidePack     types.ModerationAction = "HIDE_PACK"
+	UnhidePack   types.ModerationAction = "UNHIDE_PACK"
+	DeletePack   types.ModerationAction = "DELETE_PACK"
+	TransferPack types.ModerationAction = "TRANSFER_PACK"
+	BanUser      types.ModerationAction = "BAN_USER"
+	UnbanUser    types.ModerationAction = "UNBAN_USER"
+)
@@ -0,0 +1,8 @@
+package pack_progress
+
+import "locpack-backend/pkg/types"
+
+const (
+	InProgress types.PackProgress = "IN_PROGRESS"
+	Completed  types.PackProgress = "COMPLETED"
+)
@@ -6,4 +6,7 @@ const (
 	None     types.PackStatus = "NONE"
 	Followed types.PackStatus = "FOLLOWED"
 	Created  types.PackStatus = "CREATED"
+	Invited  types.PackStatus = "INVITED"
+	Editor   types.PackStatus = "EDITOR"
+	Viewer   types.PackStatus = "VIEWER"
 )
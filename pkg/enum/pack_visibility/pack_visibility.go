@@ -0,0 +1,9 @@
+package pack_visibility
+
+import "locpack-backend/pkg/types"
+
+const (
+	Private  types.PackVisibility = "PRIVATE"
+	Unlisted types.PackVisibility = "UNLISTED"
+	Public   types.PackVisibility = "PUBLIC"
+)
@@ -0,0 +1,8 @@
+package place_sort
+
+import "locpack-backend/pkg/types"
+
+const (
+	Relevance types.PlaceSort = "RELEVANCE"
+	Rating    types.PlaceSort = "RATING"
+)
@@ -0,0 +1,6 @@
+package role
+
+const (
+	User      = "user"
+	Moderator = "moderator"
+)
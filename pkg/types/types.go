@@ -2,6 +2,18 @@ package types
 
 type PackStatus = string
 
+type PackVisibility = string
+
+type ModerationAction = string
+
+type CollaboratorRole = string
+
+type ActivityType = string
+
+type PlaceSort = string
+
+type PackProgress = string
+
 type AccessToken struct {
 	Value        string
 	RefreshToken string
@@ -0,0 +1,46 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Cursor is the position of the last item of a page, from which the next
+// page starts. Lists are sorted by their scores, if any, then by time and by
+// public ID, all descending, so that the ID tells apart items with the same
+// keys.
+type Cursor struct {
+	Scores []float64 `json:"s,omitempty"`
+	Time   time.Time `json:"t"`
+	ID     string    `json:"i"`
+}
+
+func Encode(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decode returns the cursor encoded by Encode, or nil for the first page.
+func Decode(cursor string) (*Cursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cursor
+	err = json.Unmarshal(raw, &c)
+	if err != nil {
+		return nil, err
+	}
+	if c.ID == "" {
+		return nil, errors.New("cursor has no ID")
+	}
+
+	return &c, nil
+}
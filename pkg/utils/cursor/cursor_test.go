@@ -0,0 +1,79 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	id := "a1b2c3d4"
+	createdAt := time.Date(2026, 5, 17, 9, 30, 15, 123456000, time.UTC)
+
+	tests := []struct {
+		name   string
+		cursor Cursor
+	}{
+		{name: "time and ID", cursor: Cursor{Time: createdAt, ID: id}},
+		{name: "scores", cursor: Cursor{Scores: []float64{4.333333333333333, 3, 0.0607927}, Time: createdAt, ID: id}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Decode(Encode(tt.cursor))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.cursor.Scores, got.Scores)
+			assert.True(t, tt.cursor.Time.Equal(got.Time))
+			assert.Equal(t, tt.cursor.ID, got.ID)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := []struct {
+		name    string
+		cursor  string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "first page", cursor: "", wantNil: true},
+		{name: "bad base64", cursor: "!!!", wantErr: true},
+		{name: "offset cursor", cursor: encode("20"), wantErr: true},
+		{name: "bad JSON", cursor: encode(`{"t":`), wantErr: true},
+		{name: "bad time", cursor: encode(`{"t":"yesterday","i":"a1b2c3d4"}`), wantErr: true},
+		{name: "bad ID", cursor: encode(`{"t":"2026-05-17T09:30:15Z","i":42}`), wantErr: true},
+		{name: "empty ID", cursor: encode(`{"t":"2026-05-17T09:30:15Z","i":""}`), wantErr: true},
+		{name: "missing ID", cursor: encode(`{"t":"2026-05-17T09:30:15Z"}`), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Decode(tt.cursor)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantNil || tt.wantErr {
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Package imaging prepares uploaded photos for publishing. Images are
+// decoded and encoded again, which drops every metadata segment, including
+// EXIF location data; the EXIF orientation is applied to the pixels first so
+// that photos are not shown rotated.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+
+	jpegQuality = 85
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooManyPixels     = errors.New("image has too many pixels")
+)
+
+// Image is an encoded image without metadata.
+type Image struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// DetectContentType sniffs the content type of data, ignoring whatever the
+// client claimed.
+func DetectContentType(data []byte) string {
+	return http.DetectContentType(data)
+}
+
+// Process decodes a JPEG or PNG image of at most maxPixels pixels, and
+// returns it re-encoded together with a thumbnail that fits in a square of
+// thumbnailSize pixels. Both keep the format of the original.
+func Process(data []byte, maxPixels int, thumbnailSize int) (Image, Image, error) {
+	contentType := DetectContentType(data)
+	if contentType != ContentTypeJPEG && contentType != ContentTypePNG {
+		return Image{}, Image{}, ErrUnsupportedFormat
+	}
+
+	// Check the dimensions before decoding, so that small files declaring
+	// huge images are not expanded in memory.
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, Image{}, err
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return Image{}, Image{}, ErrTooManyPixels
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, Image{}, err
+	}
+
+	img := toNRGBA(decoded)
+	if contentType == ContentTypeJPEG {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	original, err := encode(img, contentType)
+	if err != nil {
+		return Image{}, Image{}, err
+	}
+
+	thumbnail, err := encode(fit(img, thumbnailSize), contentType)
+	if err != nil {
+		return Image{}, Image{}, err
+	}
+
+	return original, thumbnail, nil
+}
+
+func encode(img *image.NRGBA, contentType string) (Image, error) {
+	var buffer bytes.Buffer
+	var err error
+	if contentType == ContentTypePNG {
+		err = png.Encode(&buffer, img)
+	} else {
+		err = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return Image{}, err
+	}
+
+	bounds := img.Bounds()
+	return Image{
+		Data:        buffer.Bytes(),
+		ContentType: contentType,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}, nil
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+	return dst
+}
+
+// fit scales the image down with a box filter, so that its longer side is
+// at most size pixels. Smaller images are returned as they are.
+func fit(img *image.NRGBA, size int) *image.NRGBA {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	if w <= size && h <= size {
+		return img
+	}
+
+	dw, dh := size, h*size/w
+	if h > w {
+		dw, dh = w*size/h, size
+	}
+	dw, dh = max(dw, 1), max(dh, 1)
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy0, sy1 := y*h/dh, max((y+1)*h/dh, y*h/dh+1)
+		for x := 0; x < dw; x++ {
+			sx0, sx1 := x*w/dw, max((x+1)*w/dw, x*w/dw+1)
+
+			var sum [4]int
+			for sy := sy0; sy < sy1; sy++ {
+				offset := img.PixOffset(sx0, sy)
+				for sx := sx0; sx < sx1; sx++ {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(img.Pix[offset+c])
+					}
+					offset += 4
+				}
+			}
+
+			count := (sy1 - sy0) * (sx1 - sx0)
+			offset := dst.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				dst.Pix[offset+c] = uint8(sum[c] / count)
+			}
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,130 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const gpsIFDTag = 0x8825
+
+func testImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+	return img
+}
+
+// withExif inserts an APP1 segment with the orientation and a GPS IFD
+// pointer right after the SOI marker of a JPEG image.
+func withExif(t *testing.T, data []byte, orientation uint16) []byte {
+	t.Helper()
+
+	var tiff bytes.Buffer
+	tiff.WriteString("II")
+	for _, value := range []any{
+		uint16(42), uint32(8), uint16(2),
+		uint16(orientationTag), uint16(3), uint32(1), orientation, uint16(0),
+		uint16(gpsIFDTag), uint16(4), uint32(1), uint32(0),
+		uint32(0),
+	} {
+		require.NoError(t, binary.Write(&tiff, binary.LittleEndian, value))
+	}
+
+	segment := append([]byte(exifHeader), tiff.Bytes()...)
+	app1 := []byte{0xFF, jpegMarkerAPP1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(segment)+2))
+
+	result := append([]byte{}, data[:2]...)
+	result = append(result, app1...)
+	result = append(result, segment...)
+	return append(result, data[2:]...)
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buffer, img, nil))
+	return buffer.Bytes()
+}
+
+func TestProcess_JPEG_StripsExifAndAppliesOrientation(t *testing.T) {
+	data := withExif(t, encodeJPEG(t, testImage(40, 20)), 6)
+	require.Equal(t, 6, jpegOrientation(data))
+
+	original, thumbnail, err := Process(data, 10_000, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, ContentTypeJPEG, original.ContentType)
+	assert.Equal(t, 20, original.Width)
+	assert.Equal(t, 40, original.Height)
+	assert.NotContains(t, string(original.Data), exifHeader)
+	assert.Equal(t, orientationNormal, jpegOrientation(original.Data))
+
+	assert.Equal(t, ContentTypeJPEG, thumbnail.ContentType)
+	assert.Equal(t, 5, thumbnail.Width)
+	assert.Equal(t, 10, thumbnail.Height)
+	assert.NotContains(t, string(thumbnail.Data), exifHeader)
+}
+
+func TestProcess_PNG(t *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, testImage(8, 4)))
+
+	original, thumbnail, err := Process(buffer.Bytes(), 10_000, 16)
+	require.NoError(t, err)
+
+	assert.Equal(t, ContentTypePNG, original.ContentType)
+	assert.Equal(t, 8, original.Width)
+	assert.Equal(t, 4, original.Height)
+	assert.Equal(t, 8, thumbnail.Width)
+	assert.Equal(t, 4, thumbnail.Height)
+}
+
+func TestProcess_UnsupportedFormat(t *testing.T) {
+	_, _, err := Process([]byte("GIF89a not really"), 10_000, 16)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestProcess_TooManyPixels(t *testing.T) {
+	_, _, err := Process(encodeJPEG(t, testImage(200, 100)), 10_000, 16)
+	assert.ErrorIs(t, err, ErrTooManyPixels)
+}
+
+func TestOrient(t *testing.T) {
+	img := testImage(3, 2)
+	corner := img.NRGBAAt(0, 0)
+
+	tests := []struct {
+		orientation int
+		width       int
+		height      int
+		x           int
+		y           int
+	}{
+		{orientation: 2, width: 3, height: 2, x: 2, y: 0},
+		{orientation: 3, width: 3, height: 2, x: 2, y: 1},
+		{orientation: 4, width: 3, height: 2, x: 0, y: 1},
+		{orientation: 5, width: 2, height: 3, x: 0, y: 0},
+		{orientation: 6, width: 2, height: 3, x: 1, y: 0},
+		{orientation: 7, width: 2, height: 3, x: 1, y: 2},
+		{orientation: 8, width: 2, height: 3, x: 0, y: 2},
+	}
+
+	for _, tt := range tests {
+		result := orient(img, tt.orientation)
+		assert.Equal(t, tt.width, result.Bounds().Dx(), tt.orientation)
+		assert.Equal(t, tt.height, result.Bounds().Dy(), tt.orientation)
+		assert.Equal(t, corner, result.NRGBAAt(tt.x, tt.y), tt.orientation)
+	}
+}
@@ -0,0 +1,127 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"image"
+)
+
+const (
+	orientationTag    = 0x0112
+	jpegMarkerSOS     = 0xDA
+	jpegMarkerAPP1    = 0xE1
+	exifHeader        = "Exif\x00\x00"
+	ifdEntrySize      = 12
+	orientationNormal = 1
+)
+
+// jpegOrientation returns the EXIF orientation of a JPEG image, from 1 to 8,
+// or 1 when it is missing or cannot be read.
+func jpegOrientation(data []byte) int {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return orientationNormal
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return orientationNormal
+		}
+		marker := data[i+1]
+		if marker == jpegMarkerSOS {
+			return orientationNormal
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		if length < 2 || i+2+length > len(data) {
+			return orientationNormal
+		}
+
+		segment := data[i+4 : i+2+length]
+		if marker == jpegMarkerAPP1 && len(segment) > len(exifHeader) && string(segment[:len(exifHeader)]) == exifHeader {
+			return exifOrientation(segment[len(exifHeader):])
+		}
+
+		i += 2 + length
+	}
+
+	return orientationNormal
+}
+
+// exifOrientation reads the orientation tag from the first IFD of a TIFF
+// structure.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return orientationNormal
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return orientationNormal
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return orientationNormal
+	}
+
+	count := int(order.Uint16(tiff[offset : offset+2]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*ifdEntrySize
+		if entry+ifdEntrySize > len(tiff) {
+			return orientationNormal
+		}
+		if order.Uint16(tiff[entry:entry+2]) == orientationTag {
+			orientation := int(order.Uint16(tiff[entry+8 : entry+10]))
+			if orientation < 1 || orientation > 8 {
+				return orientationNormal
+			}
+			return orientation
+		}
+	}
+
+	return orientationNormal
+}
+
+// orient transforms the image, so that it looks upright without its EXIF
+// orientation.
+func orient(img *image.NRGBA, orientation int) *image.NRGBA {
+	if orientation == orientationNormal {
+		return img
+	}
+
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], img.Pix[img.PixOffset(sx, sy):img.PixOffset(sx, sy)+4])
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,72 @@
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+const minKeyBytes = 32
+
+var (
+	ErrShortKey         = errors.New("signature key must be at least 32 bytes")
+	ErrInvalidSignature = errors.New("signature is invalid")
+)
+
+// Signer appends an HMAC-SHA256 signature to values, so that tokens handed
+// out to clients cannot be forged or altered.
+type Signer struct {
+	key []byte
+}
+
+func New(key []byte) (*Signer, error) {
+	if len(key) < minKeyBytes {
+		return nil, ErrShortKey
+	}
+	return &Signer{key}, nil
+}
+
+// RandomKey returns a key for signers whose tokens only need to stay valid
+// while the process is running.
+func RandomKey() []byte {
+	key := make([]byte, minKeyBytes)
+	_, _ = rand.Read(key)
+	return key
+}
+
+// Sign returns a URL-safe token that carries value and its signature.
+func (s *Signer) Sign(value []byte) string {
+	return base64.RawURLEncoding.EncodeToString(value) + "." + base64.RawURLEncoding.EncodeToString(s.mac(value))
+}
+
+// Verify returns the value carried by a token created with Sign.
+func (s *Signer) Verify(token string) ([]byte, error) {
+	encodedValue, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidSignature
+	}
+
+	value, err := base64.RawURLEncoding.DecodeString(encodedValue)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+
+	if !hmac.Equal(mac, s.mac(value)) {
+		return nil, ErrInvalidSignature
+	}
+
+	return value, nil
+}
+
+func (s *Signer) mac(value []byte) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write(value)
+	return h.Sum(nil)
+}
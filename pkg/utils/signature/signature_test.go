@@ -0,0 +1,72 @@
+package signature
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(make([]byte, minKeyBytes-1))
+	assert.ErrorIs(t, err, ErrShortKey)
+
+	_, err = New(make([]byte, minKeyBytes))
+	assert.NoError(t, err)
+}
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+
+	signer, err := New(RandomKey())
+	require.NoError(t, err)
+	other, err := New(RandomKey())
+	require.NoError(t, err)
+
+	token := signer.Sign([]byte("a1b2c3d4"))
+	encodedValue, encodedMAC, _ := strings.Cut(token, ".")
+	tamperedValue := base64.RawURLEncoding.EncodeToString([]byte("a1b2c3d5"))
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	require.NoError(t, err)
+	mac[0] ^= 1
+	tamperedMAC := base64.RawURLEncoding.EncodeToString(mac)
+
+	tests := []struct {
+		name    string
+		signer  *Signer
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{name: "round trip", signer: signer, token: token, want: "a1b2c3d4"},
+		{name: "empty value", signer: signer, token: signer.Sign(nil), want: ""},
+		{name: "tampered value", signer: signer, token: tamperedValue + "." + encodedMAC, wantErr: true},
+		{name: "tampered MAC", signer: signer, token: encodedValue + "." + tamperedMAC, wantErr: true},
+		{name: "truncated MAC", signer: signer, token: token[:len(token)-1], wantErr: true},
+		{name: "other key", signer: other, token: token, wantErr: true},
+		{name: "missing separator", signer: signer, token: encodedValue + encodedMAC, wantErr: true},
+		{name: "bad value base64", signer: signer, token: "!!!." + encodedMAC, wantErr: true},
+		{name: "bad MAC base64", signer: signer, token: encodedValue + ".!!!", wantErr: true},
+		{name: "empty token", signer: signer, token: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.signer.Verify(tt.token)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSignature)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, string(got))
+			}
+		})
+	}
+}